@@ -0,0 +1,382 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package bulkwriter generates data files of collection which could be imported by BulkInsert.
+package bulkwriter
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"path"
+	"strconv"
+	"sync"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/milvus-io/milvus-sdk-go/v2/entity"
+	"github.com/milvus-io/milvus-sdk-go/v2/internal/utils/fieldutil"
+)
+
+// FileType is the layout of data files.
+type FileType string
+
+const (
+	// FileTypeJSON is row-based json file, one file per batch.
+	FileTypeJSON FileType = "json"
+	// FileTypeNumpy is column-based numpy files, one directory of .npy file per field for each batch.
+	FileTypeNumpy FileType = "numpy"
+	// FileTypeParquet is column-based parquet file, one file per batch.
+	FileTypeParquet FileType = "parquet"
+)
+
+const (
+	defaultChunkSize = 128 * 1024 * 1024
+	// dynamicFieldName is the field name of dynamic data in data files
+	dynamicFieldName = "$meta"
+)
+
+type options struct {
+	fileType  FileType
+	chunkSize int64
+	prefix    string
+}
+
+// Option is the option to setup BulkWriter.
+type Option func(opt *options)
+
+// WithFileType sets the layout of data files, FileTypeJSON by default.
+func WithFileType(fileType FileType) Option {
+	return func(opt *options) {
+		opt.fileType = fileType
+	}
+}
+
+// WithChunkSize sets the size threshold in bytes of buffered data,
+// a new batch of files is written once the buffer reaches it, 128MB by default.
+func WithChunkSize(size int64) Option {
+	return func(opt *options) {
+		opt.chunkSize = size
+	}
+}
+
+// WithPrefix sets the path prefix of data files in storage.
+func WithPrefix(prefix string) Option {
+	return func(opt *options) {
+		opt.prefix = prefix
+	}
+}
+
+// BulkWriter buffers rows or columns of collection and writes them as data files into Storage,
+// each batch of files could be imported by one BulkInsert call.
+type BulkWriter struct {
+	sch     *entity.Schema
+	storage Storage
+	opts    options
+
+	// fields with data in files, auto id primary key excluded
+	fields       []*entity.Field
+	dynamicField *entity.Field
+
+	mu         sync.Mutex
+	columns    []entity.Column // buffered columns, one for each field
+	rows       int
+	bufferSize int64
+	seq        int
+	batchFiles [][]string
+}
+
+// NewBulkWriter creates BulkWriter of collection schema writing files into storage.
+func NewBulkWriter(sch *entity.Schema, storage Storage, opts ...Option) (*BulkWriter, error) {
+	if sch == nil {
+		return nil, errors.New("schema is nil")
+	}
+	if storage == nil {
+		return nil, errors.New("storage is nil")
+	}
+	w := &BulkWriter{
+		sch:     sch,
+		storage: storage,
+		opts: options{
+			fileType:  FileTypeJSON,
+			chunkSize: defaultChunkSize,
+		},
+	}
+	for _, opt := range opts {
+		opt(&w.opts)
+	}
+	switch w.opts.fileType {
+	case FileTypeJSON, FileTypeNumpy, FileTypeParquet:
+	default:
+		return nil, fmt.Errorf("file type %s not supported", w.opts.fileType)
+	}
+	if w.opts.chunkSize <= 0 {
+		return nil, fmt.Errorf("invalid chunk size %d", w.opts.chunkSize)
+	}
+
+	for _, field := range sch.Fields {
+		if field.PrimaryKey && field.AutoID {
+			continue
+		}
+		if field.IsDynamic {
+			w.dynamicField = field
+			continue
+		}
+		if err := checkField(field, w.opts.fileType); err != nil {
+			return nil, err
+		}
+		w.fields = append(w.fields, field)
+	}
+	if sch.EnableDynamicField {
+		if w.dynamicField == nil {
+			w.dynamicField = entity.NewField().WithName(dynamicFieldName).WithDataType(entity.FieldTypeJSON).WithIsDynamic(true)
+		}
+		w.fields = append(w.fields, w.dynamicField)
+	} else {
+		w.dynamicField = nil
+	}
+	if err := w.resetBuffer(); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+func (w *BulkWriter) resetBuffer() error {
+	columns := make([]entity.Column, 0, len(w.fields))
+	for _, field := range w.fields {
+		column, err := fieldutil.NewColumn(field)
+		if err != nil {
+			return err
+		}
+		columns = append(columns, column)
+	}
+	w.columns = columns
+	w.rows = 0
+	w.bufferSize = 0
+	return nil
+}
+
+// AppendRow appends one row of field name to value, values are validated against the field type and dim.
+// Keys not in schema are put into dynamic field when it's enabled,
+// missing values of nullable fields or fields with default value are null.
+func (w *BulkWriter) AppendRow(ctx context.Context, row map[string]interface{}) error {
+	dynamicValues := make(map[string]interface{})
+	for key, v := range row {
+		ok, err := w.hasField(key)
+		if err != nil {
+			return err
+		}
+		if ok {
+			continue
+		}
+		if w.dynamicField == nil {
+			return fmt.Errorf("field %s does not exist in collection %s", key, w.sch.CollectionName)
+		}
+		dynamicValues[key] = v
+	}
+
+	values := make([]interface{}, 0, len(w.fields))
+	var size int64
+	for _, field := range w.fields {
+		var v interface{}
+		var err error
+		if field == w.dynamicField {
+			v, err = fieldutil.DynamicValue(row[field.Name], dynamicValues)
+		} else {
+			v, err = checkValue(field, row[field.Name])
+		}
+		if err != nil {
+			return err
+		}
+		values = append(values, v)
+		size += valueSize(v)
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for i, column := range w.columns {
+		if err := column.AppendValue(values[i]); err != nil {
+			return errors.Wrapf(err, "field %s", column.Name())
+		}
+	}
+	w.rows++
+	w.bufferSize += size
+	return w.commitIfFull(ctx)
+}
+
+// hasField checks whether name is a field in files, error returned for auto id primary key
+// since its values are generated by server.
+func (w *BulkWriter) hasField(name string) (bool, error) {
+	if w.dynamicField != nil && name == w.dynamicField.Name {
+		return true, nil
+	}
+	for _, field := range w.sch.Fields {
+		if field.Name != name {
+			continue
+		}
+		if field.PrimaryKey && field.AutoID {
+			return false, fmt.Errorf("auto id primary key %s shall not be provided", name)
+		}
+		return true, nil
+	}
+	return false, nil
+}
+
+// AppendColumns appends columns of the same length, columns are matched with fields by name
+// and shall have the same type and dim as the fields.
+// Columns not in schema are put into dynamic field row by row when it's enabled.
+func (w *BulkWriter) AppendColumns(ctx context.Context, columns ...entity.Column) error {
+	if len(columns) == 0 {
+		return errors.New("no column provided")
+	}
+	rows := columns[0].Len()
+	nameColumns := make(map[string]entity.Column, len(columns))
+	var dynamicColumns []entity.Column
+	for _, column := range columns {
+		if column.Len() != rows {
+			return fmt.Errorf("column %s length(%d) not match other columns(%d)", column.Name(), column.Len(), rows)
+		}
+		ok, err := w.hasField(column.Name())
+		if err != nil {
+			return err
+		}
+		if !ok {
+			if w.dynamicField == nil {
+				return fmt.Errorf("field %s does not exist in collection %s", column.Name(), w.sch.CollectionName)
+			}
+			dynamicColumns = append(dynamicColumns, column)
+			continue
+		}
+		nameColumns[column.Name()] = column
+	}
+
+	appending := make([]entity.Column, 0, len(w.fields))
+	var size int64
+	for _, field := range w.fields {
+		column, ok := nameColumns[field.Name]
+		var err error
+		if field == w.dynamicField {
+			column, err = dynamicColumn(field, column, dynamicColumns, rows)
+		} else if ok {
+			err = checkColumn(field, column)
+		} else {
+			column, err = nullColumn(field, rows)
+		}
+		if err != nil {
+			return err
+		}
+		size += columnSize(column)
+		appending = append(appending, column)
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for i, column := range appending {
+		// buffered columns are created by fieldutil.NewColumn
+		concatenated, err := w.columns[i].(entity.SliceableColumn).Concat(column)
+		if err != nil {
+			return err
+		}
+		w.columns[i] = concatenated
+	}
+	w.rows += rows
+	w.bufferSize += size
+	return w.commitIfFull(ctx)
+}
+
+func (w *BulkWriter) commitIfFull(ctx context.Context) error {
+	if w.bufferSize < w.opts.chunkSize {
+		return nil
+	}
+	return w.commit(ctx)
+}
+
+// Commit writes the buffered data as a new batch of files, it does nothing if no data buffered.
+func (w *BulkWriter) Commit(ctx context.Context) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.commit(ctx)
+}
+
+func (w *BulkWriter) commit(ctx context.Context) error {
+	if w.rows == 0 {
+		return nil
+	}
+	w.seq++
+	var files map[string][]byte
+	var err error
+	switch w.opts.fileType {
+	case FileTypeJSON:
+		files, err = w.writeJSON()
+	case FileTypeNumpy:
+		files, err = w.writeNumpy()
+	case FileTypeParquet:
+		files, err = w.writeParquet()
+	}
+	if err != nil {
+		return err
+	}
+
+	paths := make([]string, 0, len(files))
+	// keep the file order of fields
+	for _, p := range w.filePaths() {
+		data, ok := files[p]
+		if !ok {
+			continue
+		}
+		if err := w.storage.Put(ctx, p, bytes.NewReader(data), int64(len(data))); err != nil {
+			return errors.Wrapf(err, "failed to put file %s", p)
+		}
+		paths = append(paths, p)
+	}
+	w.batchFiles = append(w.batchFiles, paths)
+	return w.resetBuffer()
+}
+
+// filePaths returns the file paths of current batch.
+func (w *BulkWriter) filePaths() []string {
+	batch := strconv.Itoa(w.seq)
+	switch w.opts.fileType {
+	case FileTypeNumpy:
+		paths := make([]string, 0, len(w.fields))
+		for _, field := range w.fields {
+			paths = append(paths, path.Join(w.opts.prefix, batch, field.Name+".npy"))
+		}
+		return paths
+	case FileTypeParquet:
+		return []string{path.Join(w.opts.prefix, batch+".parquet")}
+	default:
+		return []string{path.Join(w.opts.prefix, batch+".json")}
+	}
+}
+
+// BatchFiles returns the paths of files written, each batch could be imported by one BulkInsert call.
+func (w *BulkWriter) BatchFiles() [][]string {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	result := make([][]string, 0, len(w.batchFiles))
+	for _, files := range w.batchFiles {
+		result = append(result, append([]string(nil), files...))
+	}
+	return result
+}
+
+// BufferedRows returns the count of rows not committed yet.
+func (w *BulkWriter) BufferedRows() int {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.rows
+}
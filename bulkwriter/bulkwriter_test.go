@@ -0,0 +1,289 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bulkwriter
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/apache/arrow/go/v12/arrow/array"
+	"github.com/apache/arrow/go/v12/arrow/memory"
+	"github.com/apache/arrow/go/v12/parquet/file"
+	"github.com/apache/arrow/go/v12/parquet/pqarrow"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-sdk-go/v2/entity"
+)
+
+func testSchema() *entity.Schema {
+	return entity.NewSchema().WithName("films").WithDynamicFieldEnabled(true).
+		WithField(entity.NewField().WithName("id").WithDataType(entity.FieldTypeInt64).WithIsPrimaryKey(true).WithIsAutoID(true)).
+		WithField(entity.NewField().WithName("year").WithDataType(entity.FieldTypeInt32)).
+		WithField(entity.NewField().WithName("title").WithDataType(entity.FieldTypeVarChar).WithMaxLength(16)).
+		WithField(entity.NewField().WithName("vector").WithDataType(entity.FieldTypeFloatVector).WithDim(2))
+}
+
+func readFile(t *testing.T, dir string, path string) []byte {
+	data, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(path)))
+	require.NoError(t, err)
+	return data
+}
+
+func TestNewBulkWriter(t *testing.T) {
+	storage := NewLocalStorage(t.TempDir())
+	_, err := NewBulkWriter(nil, storage)
+	assert.Error(t, err)
+	_, err = NewBulkWriter(testSchema(), nil)
+	assert.Error(t, err)
+	_, err = NewBulkWriter(testSchema(), storage, WithFileType("csv"))
+	assert.Error(t, err)
+	_, err = NewBulkWriter(testSchema(), storage, WithChunkSize(0))
+	assert.Error(t, err)
+
+	sparse := entity.NewSchema().WithField(entity.NewField().WithName("sparse").WithDataType(entity.FieldTypeSparseFloatVector))
+	_, err = NewBulkWriter(sparse, storage, WithFileType(FileTypeNumpy))
+	assert.Error(t, err)
+	_, err = NewBulkWriter(sparse, storage, WithFileType(FileTypeParquet))
+	assert.NoError(t, err)
+
+	noDim := entity.NewSchema().WithField(entity.NewField().WithName("vector").WithDataType(entity.FieldTypeFloatVector))
+	_, err = NewBulkWriter(noDim, storage)
+	assert.Error(t, err)
+}
+
+func TestAppendRowValidation(t *testing.T) {
+	ctx := context.Background()
+	w, err := NewBulkWriter(testSchema().WithDynamicFieldEnabled(false), NewLocalStorage(t.TempDir()))
+	require.NoError(t, err)
+
+	valid := map[string]interface{}{"year": 1994, "title": "Forrest Gump", "vector": []float32{0.1, 0.2}}
+	assert.NoError(t, w.AppendRow(ctx, valid))
+
+	cases := map[string]map[string]interface{}{
+		"auto_id":        {"id": int64(1), "year": 1994, "title": "a", "vector": []float32{0.1, 0.2}},
+		"missing":        {"year": 1994, "vector": []float32{0.1, 0.2}},
+		"type_not_match": {"year": "1994", "title": "a", "vector": []float32{0.1, 0.2}},
+		"out_of_range":   {"year": int64(1) << 40, "title": "a", "vector": []float32{0.1, 0.2}},
+		"not_integer":    {"year": 1994.5, "title": "a", "vector": []float32{0.1, 0.2}},
+		"too_long":       {"year": 1994, "title": "The Shawshank Redemption", "vector": []float32{0.1, 0.2}},
+		"dim_not_match":  {"year": 1994, "title": "a", "vector": []float32{0.1, 0.2, 0.3}},
+		"unknown_field":  {"year": 1994, "title": "a", "vector": []float32{0.1, 0.2}, "extra": 1},
+	}
+	for name, row := range cases {
+		t.Run(name, func(t *testing.T) {
+			assert.Error(t, w.AppendRow(ctx, row))
+		})
+	}
+	assert.Equal(t, 1, w.BufferedRows())
+}
+
+func TestWriteJSON(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	sparse, err := entity.NewSparseFloatVector([]uint32{1, 10}, []float32{0.5, 0.25})
+	require.NoError(t, err)
+	sch := testSchema().
+		WithField(entity.NewField().WithName("tags").WithDataType(entity.FieldTypeArray).WithElementType(entity.FieldTypeVarChar).WithMaxCapacity(4)).
+		WithField(entity.NewField().WithName("info").WithDataType(entity.FieldTypeJSON)).
+		WithField(entity.NewField().WithName("binary").WithDataType(entity.FieldTypeBinaryVector).WithDim(16)).
+		WithField(entity.NewField().WithName("fp16").WithDataType(entity.FieldTypeFloat16Vector).WithDim(2)).
+		WithField(entity.NewField().WithName("sparse").WithDataType(entity.FieldTypeSparseFloatVector)).
+		WithField(entity.NewField().WithName("rating").WithDataType(entity.FieldTypeFloat).WithNullable(true))
+	w, err := NewBulkWriter(sch, NewLocalStorage(dir), WithPrefix("staging"))
+	require.NoError(t, err)
+
+	err = w.AppendRow(ctx, map[string]interface{}{
+		"year":   float64(1994), // decoded from json
+		"title":  "Forrest Gump",
+		"vector": []float32{0.1, 0.2},
+		"tags":   []string{"drama"},
+		"info":   map[string]interface{}{"lang": "en"},
+		"binary": []byte{1, 2},
+		"fp16":   []float32{0.5, 1},
+		"sparse": sparse,
+		"genre":  "drama",
+	})
+	require.NoError(t, err)
+	err = w.AppendColumns(ctx,
+		entity.NewColumnInt32("year", []int32{1972}),
+		entity.NewColumnVarChar("title", []string{"The Godfather"}),
+		entity.NewColumnFloatVector("vector", 2, [][]float32{{0.3, 0.4}}),
+		entity.NewColumnVarCharArray("tags", [][]string{{"crime", "drama"}}),
+		entity.NewColumnJSONBytes("info", [][]byte{[]byte(`{"lang":"it"}`)}),
+		entity.NewColumnBinaryVector("binary", 16, [][]byte{{3, 4}}),
+		entity.NewColumnFloat16VectorFromFloat32("fp16", 2, [][]float32{{1, 2}}),
+		entity.NewColumnSparseFloatVector("sparse", []entity.SparseFloatVector{sparse}),
+		entity.NewColumnFloat("rating", []float32{9.2}),
+		entity.NewColumnVarChar("genre", []string{"crime"}),
+	)
+	require.NoError(t, err)
+	require.NoError(t, w.Commit(ctx))
+	assert.Equal(t, 0, w.BufferedRows())
+	require.Equal(t, [][]string{{"staging/1.json"}}, w.BatchFiles())
+
+	var content struct {
+		Rows []map[string]interface{} `json:"rows"`
+	}
+	require.NoError(t, json.Unmarshal(readFile(t, dir, "staging/1.json"), &content))
+	require.Len(t, content.Rows, 2)
+	first, second := content.Rows[0], content.Rows[1]
+	assert.NotContains(t, first, "id")
+	assert.EqualValues(t, 1994, first["year"])
+	assert.Equal(t, []interface{}{"drama"}, first["tags"])
+	assert.Equal(t, map[string]interface{}{"lang": "en"}, first["info"])
+	assert.Equal(t, []interface{}{float64(1), float64(2)}, first["binary"])
+	assert.Equal(t, []interface{}{0.5, float64(1)}, first["fp16"])
+	assert.Equal(t, map[string]interface{}{"indices": []interface{}{float64(1), float64(10)}, "values": []interface{}{0.5, 0.25}}, first["sparse"])
+	assert.Nil(t, first["rating"])
+	assert.Equal(t, map[string]interface{}{"genre": "drama"}, first["$meta"])
+	assert.Equal(t, "The Godfather", second["title"])
+	assert.Equal(t, map[string]interface{}{"genre": "crime"}, second["$meta"])
+}
+
+func TestAppendColumnsValidation(t *testing.T) {
+	ctx := context.Background()
+	w, err := NewBulkWriter(testSchema(), NewLocalStorage(t.TempDir()))
+	require.NoError(t, err)
+
+	cases := map[string][]entity.Column{
+		"no_column": nil,
+		"length_not_match": {
+			entity.NewColumnInt32("year", []int32{1994, 1972}),
+			entity.NewColumnVarChar("title", []string{"a"}),
+			entity.NewColumnFloatVector("vector", 2, [][]float32{{0.1, 0.2}}),
+		},
+		"type_not_match": {
+			entity.NewColumnInt64("year", []int64{1994}),
+			entity.NewColumnVarChar("title", []string{"a"}),
+			entity.NewColumnFloatVector("vector", 2, [][]float32{{0.1, 0.2}}),
+		},
+		"dim_not_match": {
+			entity.NewColumnInt32("year", []int32{1994}),
+			entity.NewColumnVarChar("title", []string{"a"}),
+			entity.NewColumnFloatVector("vector", 1, [][]float32{{0.1}}),
+		},
+		"missing": {
+			entity.NewColumnInt32("year", []int32{1994}),
+			entity.NewColumnFloatVector("vector", 2, [][]float32{{0.1, 0.2}}),
+		},
+		"too_long": {
+			entity.NewColumnInt32("year", []int32{1994}),
+			entity.NewColumnVarChar("title", []string{"The Shawshank Redemption"}),
+			entity.NewColumnFloatVector("vector", 2, [][]float32{{0.1, 0.2}}),
+		},
+		"auto_id": {
+			entity.NewColumnInt64("id", []int64{1}),
+			entity.NewColumnInt32("year", []int32{1994}),
+			entity.NewColumnVarChar("title", []string{"a"}),
+			entity.NewColumnFloatVector("vector", 2, [][]float32{{0.1, 0.2}}),
+		},
+	}
+	for name, columns := range cases {
+		t.Run(name, func(t *testing.T) {
+			assert.Error(t, w.AppendColumns(ctx, columns...))
+		})
+	}
+	assert.Equal(t, 0, w.BufferedRows())
+}
+
+func TestRollFiles(t *testing.T) {
+	ctx := context.Background()
+	// each row takes 4(year) + 1(title) + 8(vector) + 2($meta) bytes
+	w, err := NewBulkWriter(testSchema(), NewLocalStorage(t.TempDir()), WithChunkSize(30))
+	require.NoError(t, err)
+	for i := 0; i < 5; i++ {
+		require.NoError(t, w.AppendRow(ctx, map[string]interface{}{"year": i, "title": "a", "vector": []float32{0.1, 0.2}}))
+	}
+	assert.Equal(t, [][]string{{"1.json"}, {"2.json"}}, w.BatchFiles())
+	assert.Equal(t, 1, w.BufferedRows())
+	require.NoError(t, w.Commit(ctx))
+	require.NoError(t, w.Commit(ctx))
+	assert.Len(t, w.BatchFiles(), 3)
+}
+
+func TestWriteNumpy(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	w, err := NewBulkWriter(testSchema(), NewLocalStorage(dir), WithFileType(FileTypeNumpy))
+	require.NoError(t, err)
+	require.NoError(t, w.AppendColumns(ctx,
+		entity.NewColumnInt32("year", []int32{1994, 1972}),
+		entity.NewColumnVarChar("title", []string{"Léon", "Up"}),
+		entity.NewColumnFloatVector("vector", 2, [][]float32{{0.1, 0.2}, {0.3, 0.4}}),
+	))
+	require.NoError(t, w.Commit(ctx))
+	require.Equal(t, [][]string{{"1/year.npy", "1/title.npy", "1/vector.npy", "1/$meta.npy"}}, w.BatchFiles())
+
+	parse := func(data []byte) (string, []byte) {
+		require.Equal(t, "\x93NUMPY", string(data[:6]))
+		headerLen := int(binary.LittleEndian.Uint16(data[8:10]))
+		assert.Zero(t, (10+headerLen)%64)
+		return string(data[10 : 10+headerLen]), data[10+headerLen:]
+	}
+
+	header, body := parse(readFile(t, dir, "1/year.npy"))
+	assert.Contains(t, header, "'descr': '<i4', 'fortran_order': False, 'shape': (2,), }")
+	assert.Equal(t, []byte{0xca, 0x07, 0, 0, 0xb4, 0x07, 0, 0}, body)
+
+	header, body = parse(readFile(t, dir, "1/title.npy"))
+	assert.Contains(t, header, "'descr': '<U4'")
+	assert.Len(t, body, 2*4*4)
+	assert.Equal(t, uint32('é'), binary.LittleEndian.Uint32(body[4:]))
+
+	header, body = parse(readFile(t, dir, "1/vector.npy"))
+	assert.Contains(t, header, "'descr': '<f4', 'fortran_order': False, 'shape': (2, 2), }")
+	vectors := make([]float32, 4)
+	require.NoError(t, binary.Read(bytes.NewReader(body), binary.LittleEndian, vectors))
+	assert.Equal(t, []float32{0.1, 0.2, 0.3, 0.4}, vectors)
+
+	header, _ = parse(readFile(t, dir, "1/$meta.npy"))
+	assert.Contains(t, header, "'descr': '<U2'")
+}
+
+func TestWriteParquet(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	w, err := NewBulkWriter(testSchema(), NewLocalStorage(dir), WithFileType(FileTypeParquet))
+	require.NoError(t, err)
+	require.NoError(t, w.AppendRow(ctx, map[string]interface{}{"year": 1994, "title": "Forrest Gump", "vector": []float32{0.1, 0.2}, "genre": "drama"}))
+	require.NoError(t, w.Commit(ctx))
+	require.Equal(t, [][]string{{"1.parquet"}}, w.BatchFiles())
+
+	reader, err := file.NewParquetReader(bytes.NewReader(readFile(t, dir, "1.parquet")))
+	require.NoError(t, err)
+	fileReader, err := pqarrow.NewFileReader(reader, pqarrow.ArrowReadProperties{}, memory.DefaultAllocator)
+	require.NoError(t, err)
+	table, err := fileReader.ReadTable(ctx)
+	require.NoError(t, err)
+	defer table.Release()
+
+	assert.EqualValues(t, 1, table.NumRows())
+	require.EqualValues(t, 4, table.NumCols())
+	assert.Equal(t, "year", table.Column(0).Name())
+	assert.Equal(t, []int32{1994}, table.Column(0).Data().Chunk(0).(*array.Int32).Int32Values())
+	assert.Equal(t, "Forrest Gump", table.Column(1).Data().Chunk(0).(*array.String).Value(0))
+	vectors := table.Column(2).Data().Chunk(0).(*array.List)
+	assert.Equal(t, []float32{0.1, 0.2}, vectors.ListValues().(*array.Float32).Float32Values())
+	assert.Equal(t, "$meta", table.Column(3).Name())
+	assert.Equal(t, `{"genre":"drama"}`, table.Column(3).Data().Chunk(0).(*array.String).Value(0))
+}
@@ -0,0 +1,96 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bulkwriter
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/milvus-io/milvus-sdk-go/v2/entity"
+)
+
+// writeJSON writes buffered rows as {"rows": [{field: value}, ...]}.
+func (w *BulkWriter) writeJSON() (map[string][]byte, error) {
+	buf := &bytes.Buffer{}
+	buf.WriteString(`{"rows":[`)
+	row := make(map[string]interface{}, len(w.columns))
+	for i := 0; i < w.rows; i++ {
+		for _, column := range w.columns {
+			v, err := jsonFileValue(column, i)
+			if err != nil {
+				return nil, errors.Wrapf(err, "field %s", column.Name())
+			}
+			row[column.Name()] = v
+		}
+		bs, err := json.Marshal(row)
+		if err != nil {
+			return nil, err
+		}
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		buf.Write(bs)
+	}
+	buf.WriteString(`]}`)
+	return map[string][]byte{w.filePaths()[0]: buf.Bytes()}, nil
+}
+
+// jsonFileValue returns the value at idx in the form of json data file,
+// binary vectors are arrays of bytes, half float vectors are arrays of floats
+// and sparse vectors are objects of indices and values.
+func jsonFileValue(column entity.Column, idx int) (interface{}, error) {
+	v, err := column.Get(idx)
+	if err != nil || v == nil {
+		return nil, err
+	}
+	switch column.Type() {
+	case entity.FieldTypeJSON:
+		return json.RawMessage(v.([]byte)), nil
+	case entity.FieldTypeBinaryVector:
+		vector := v.([]byte)
+		values := make([]int, 0, len(vector))
+		for _, b := range vector {
+			values = append(values, int(b))
+		}
+		return values, nil
+	case entity.FieldTypeFloat16Vector:
+		return entity.Float16Vector(v.([]byte)).ToFloat32Vector(), nil
+	case entity.FieldTypeBFloat16Vector:
+		return entity.BFloat16Vector(v.([]byte)).ToFloat32Vector(), nil
+	case entity.FieldTypeSparseFloatVector:
+		return sparseObject(v.(entity.SparseFloatVector)), nil
+	default:
+		return v, nil
+	}
+}
+
+// sparseObject returns the json object form of sparse vector.
+func sparseObject(vector entity.SparseFloatVector) map[string]interface{} {
+	indices := make([]uint32, 0, vector.Len())
+	values := make([]float32, 0, vector.Len())
+	for i := 0; i < vector.Len(); i++ {
+		position, value, _ := vector.Get(i)
+		indices = append(indices, position)
+		values = append(values, value)
+	}
+	return map[string]interface{}{
+		"indices": indices,
+		"values":  values,
+	}
+}
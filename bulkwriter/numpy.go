@@ -0,0 +1,166 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bulkwriter
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/milvus-io/milvus-sdk-go/v2/entity"
+)
+
+// writeNumpy writes each buffered column as a .npy file.
+func (w *BulkWriter) writeNumpy() (map[string][]byte, error) {
+	paths := w.filePaths()
+	files := make(map[string][]byte, len(w.columns))
+	for i, column := range w.columns {
+		data, err := numpyFile(column)
+		if err != nil {
+			return nil, errors.Wrapf(err, "field %s", column.Name())
+		}
+		files[paths[i]] = data
+	}
+	return files, nil
+}
+
+// numpyFile encodes column as numpy array, vectors are 2-dim arrays
+// and half float vectors are uint8 arrays of the raw bytes.
+func numpyFile(column entity.Column) ([]byte, error) {
+	if nc, ok := column.(entity.NullableColumn); ok {
+		for _, valid := range nc.ValidData() {
+			if !valid {
+				return nil, errors.New("numpy file does not support null value")
+			}
+		}
+	}
+	buf := &bytes.Buffer{}
+	n := column.Len()
+	switch c := column.(type) {
+	case *entity.ColumnBool:
+		writeNumpyHeader(buf, "|b1", n)
+		for _, v := range c.Data() {
+			if v {
+				buf.WriteByte(1)
+			} else {
+				buf.WriteByte(0)
+			}
+		}
+	case *entity.ColumnInt8:
+		writeNumpyHeader(buf, "|i1", n)
+		binary.Write(buf, binary.LittleEndian, c.Data())
+	case *entity.ColumnInt16:
+		writeNumpyHeader(buf, "<i2", n)
+		binary.Write(buf, binary.LittleEndian, c.Data())
+	case *entity.ColumnInt32:
+		writeNumpyHeader(buf, "<i4", n)
+		binary.Write(buf, binary.LittleEndian, c.Data())
+	case *entity.ColumnInt64:
+		writeNumpyHeader(buf, "<i8", n)
+		binary.Write(buf, binary.LittleEndian, c.Data())
+	case *entity.ColumnFloat:
+		writeNumpyHeader(buf, "<f4", n)
+		binary.Write(buf, binary.LittleEndian, c.Data())
+	case *entity.ColumnDouble:
+		writeNumpyHeader(buf, "<f8", n)
+		binary.Write(buf, binary.LittleEndian, c.Data())
+	case *entity.ColumnVarChar:
+		writeNumpyStrings(buf, c.Data())
+	case *entity.ColumnString:
+		writeNumpyStrings(buf, c.Data())
+	case *entity.ColumnJSONBytes:
+		values := make([]string, 0, n)
+		for _, v := range c.Data() {
+			values = append(values, string(v))
+		}
+		writeNumpyStrings(buf, values)
+	case *entity.ColumnFloatVector:
+		writeNumpyHeader(buf, "<f4", n, c.Dim())
+		for _, v := range c.Data() {
+			binary.Write(buf, binary.LittleEndian, v)
+		}
+	case *entity.ColumnBinaryVector:
+		writeNumpyHeader(buf, "|u1", n, c.Dim()/8)
+		for _, v := range c.Data() {
+			buf.Write(v)
+		}
+	case *entity.ColumnFloat16Vector:
+		writeNumpyHeader(buf, "|u1", n, c.Dim()*2)
+		for _, v := range c.Data() {
+			buf.Write(v)
+		}
+	case *entity.ColumnBFloat16Vector:
+		writeNumpyHeader(buf, "|u1", n, c.Dim()*2)
+		for _, v := range c.Data() {
+			buf.Write(v)
+		}
+	default:
+		return nil, fmt.Errorf("column type %s not supported in numpy file", column.Type().Name())
+	}
+	return buf.Bytes(), nil
+}
+
+// writeNumpyHeader writes the magic, version 1.0 and header of npy format,
+// the header is padded so that the data starts at multiple of 64 bytes.
+func writeNumpyHeader(buf *bytes.Buffer, descr string, shape ...int) {
+	var shapeStr string
+	if len(shape) == 1 {
+		shapeStr = fmt.Sprintf("(%d,)", shape[0])
+	} else {
+		dims := make([]string, 0, len(shape))
+		for _, d := range shape {
+			dims = append(dims, fmt.Sprint(d))
+		}
+		shapeStr = "(" + strings.Join(dims, ", ") + ")"
+	}
+	header := fmt.Sprintf("{'descr': '%s', 'fortran_order': False, 'shape': %s, }", descr, shapeStr)
+	// magic string(6) + version(2) + header length(2) + header + newline
+	padding := (64 - (10+len(header)+1)%64) % 64
+	header += strings.Repeat(" ", padding) + "\n"
+
+	buf.WriteString("\x93NUMPY")
+	buf.Write([]byte{1, 0})
+	binary.Write(buf, binary.LittleEndian, uint16(len(header)))
+	buf.WriteString(header)
+}
+
+// writeNumpyStrings writes strings as fixed length unicode array, each code point takes 4 bytes.
+func writeNumpyStrings(buf *bytes.Buffer, values []string) {
+	maxLen := 1
+	for _, v := range values {
+		if l := utf8.RuneCountInString(v); l > maxLen {
+			maxLen = l
+		}
+	}
+	writeNumpyHeader(buf, fmt.Sprintf("<U%d", maxLen), len(values))
+	codePoints := make([]uint32, maxLen)
+	for _, v := range values {
+		i := 0
+		for _, r := range v {
+			codePoints[i] = uint32(r)
+			i++
+		}
+		for ; i < maxLen; i++ {
+			codePoints[i] = 0
+		}
+		binary.Write(buf, binary.LittleEndian, codePoints)
+	}
+}
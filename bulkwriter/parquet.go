@@ -0,0 +1,121 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bulkwriter
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/apache/arrow/go/v12/arrow"
+	"github.com/apache/arrow/go/v12/arrow/array"
+	"github.com/apache/arrow/go/v12/arrow/memory"
+	"github.com/apache/arrow/go/v12/parquet"
+	"github.com/apache/arrow/go/v12/parquet/pqarrow"
+	"github.com/cockroachdb/errors"
+
+	"github.com/milvus-io/milvus-sdk-go/v2/entity"
+)
+
+// writeParquet writes buffered columns as one parquet file.
+func (w *BulkWriter) writeParquet() (map[string][]byte, error) {
+	mem := memory.DefaultAllocator
+	fields := make([]arrow.Field, 0, len(w.columns))
+	arrays := make([]arrow.Array, 0, len(w.columns))
+	defer func() {
+		for _, arr := range arrays {
+			arr.Release()
+		}
+	}()
+	for i, column := range w.columns {
+		arr, err := parquetArray(mem, column)
+		if err != nil {
+			return nil, errors.Wrapf(err, "field %s", column.Name())
+		}
+		arrays = append(arrays, arr)
+		field := w.fields[i]
+		nullable := arr.NullN() > 0 || field.Nullable || field.DefaultValue != nil
+		fields = append(fields, arrow.Field{Name: column.Name(), Type: arr.DataType(), Nullable: nullable})
+	}
+	record := array.NewRecord(arrow.NewSchema(fields, nil), arrays, int64(w.rows))
+	defer record.Release()
+
+	buf := &bytes.Buffer{}
+	writer, err := pqarrow.NewFileWriter(record.Schema(), buf, parquet.NewWriterProperties(), pqarrow.DefaultWriterProps())
+	if err != nil {
+		return nil, err
+	}
+	if err := writer.Write(record); err != nil {
+		writer.Close()
+		return nil, err
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+	return map[string][]byte{w.filePaths()[0]: buf.Bytes()}, nil
+}
+
+// parquetArray converts column into arrow array of type parquet supports, vectors are lists,
+// half float vectors are lists of the raw bytes and sparse vectors are json text.
+func parquetArray(mem memory.Allocator, column entity.Column) (arrow.Array, error) {
+	arr, err := parquetColumnArray(mem, column)
+	if err != nil {
+		return nil, err
+	}
+	fsl, ok := arr.(*array.FixedSizeList)
+	if !ok {
+		return arr, nil
+	}
+	defer arr.Release()
+	return fixedSizeListToList(fsl), nil
+}
+
+// fixedSizeListToList converts FixedSizeList into List sharing the values.
+func fixedSizeListToList(fsl *array.FixedSizeList) arrow.Array {
+	listType := fsl.DataType().(*arrow.FixedSizeListType)
+	size := listType.Len()
+	offsets := make([]int32, fsl.Len()+1)
+	for i := range offsets {
+		offsets[i] = int32(fsl.Data().Offset()+i) * size
+	}
+	data := array.NewData(arrow.ListOf(listType.Elem()), fsl.Len(),
+		[]*memory.Buffer{nil, memory.NewBufferBytes(arrow.Int32Traits.CastToBytes(offsets))},
+		[]arrow.ArrayData{fsl.ListValues().Data()}, 0, 0)
+	defer data.Release()
+	return array.MakeFromData(data)
+}
+
+func parquetColumnArray(mem memory.Allocator, column entity.Column) (arrow.Array, error) {
+	switch c := column.(type) {
+	case *entity.ColumnFloat16Vector:
+		// binary vector dim is bit count, each half float takes 16 bits
+		return entity.ColumnToArrow(mem, entity.NewColumnBinaryVector(c.Name(), c.Dim()*16, c.Data()))
+	case *entity.ColumnBFloat16Vector:
+		return entity.ColumnToArrow(mem, entity.NewColumnBinaryVector(c.Name(), c.Dim()*16, c.Data()))
+	case *entity.ColumnSparseFloatVector:
+		values := make([][]byte, 0, c.Len())
+		for _, vector := range c.Data() {
+			bs, err := json.Marshal(sparseObject(vector))
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, bs)
+		}
+		return entity.ColumnToArrow(mem, entity.NewColumnJSONBytes(c.Name(), values))
+	default:
+		return entity.ColumnToArrow(mem, column)
+	}
+}
@@ -0,0 +1,62 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bulkwriter
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// Storage persists the data files written by BulkWriter, such as local directory or object storage.
+type Storage interface {
+	// Put stores size bytes read from data as file at path, path is slash separated and relative to storage root.
+	Put(ctx context.Context, path string, data io.Reader, size int64) error
+}
+
+var _ Storage = (*LocalStorage)(nil)
+
+// LocalStorage stores data files under local directory.
+type LocalStorage struct {
+	dir string
+}
+
+// NewLocalStorage creates LocalStorage with root directory dir, which is created when needed.
+func NewLocalStorage(dir string) *LocalStorage {
+	return &LocalStorage{dir: dir}
+}
+
+// Put writes data into file at path under the root directory, existing file is overwritten.
+func (s *LocalStorage) Put(ctx context.Context, path string, data io.Reader, _ int64) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	target := filepath.Join(s.dir, filepath.FromSlash(path))
+	if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+		return err
+	}
+	f, err := os.Create(target)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(f, data); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
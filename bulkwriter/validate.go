@@ -0,0 +1,420 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bulkwriter
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+
+	"github.com/milvus-io/milvus-sdk-go/v2/entity"
+	"github.com/milvus-io/milvus-sdk-go/v2/internal/utils/fieldutil"
+)
+
+// checkField checks the field could be written into files of fileType.
+func checkField(field *entity.Field, fileType FileType) error {
+	switch field.DataType {
+	case entity.FieldTypeBool, entity.FieldTypeInt8, entity.FieldTypeInt16, entity.FieldTypeInt32, entity.FieldTypeInt64,
+		entity.FieldTypeFloat, entity.FieldTypeDouble, entity.FieldTypeString, entity.FieldTypeVarChar:
+		return nil
+	case entity.FieldTypeJSON:
+	case entity.FieldTypeFloatVector, entity.FieldTypeBinaryVector, entity.FieldTypeFloat16Vector, entity.FieldTypeBFloat16Vector:
+		if _, err := fieldutil.Dim(field); err != nil {
+			return err
+		}
+	case entity.FieldTypeSparseFloatVector, entity.FieldTypeArray:
+		if fileType == FileTypeNumpy {
+			return fmt.Errorf("field %s of type %s not supported in numpy file", field.Name, field.DataType.Name())
+		}
+	default:
+		return fmt.Errorf("field %s of type %s not supported", field.Name, field.DataType.Name())
+	}
+	if field.Nullable {
+		return fmt.Errorf("field %s of type %s does not support null value", field.Name, field.DataType.Name())
+	}
+	return nil
+}
+
+func errValueType(field *entity.Field, v interface{}) error {
+	return fmt.Errorf("value type %T not match field %s of type %s", v, field.Name, field.DataType.Name())
+}
+
+// checkValue validates value of field and converts it into the value type of field column,
+// nil returned for null value.
+func checkValue(field *entity.Field, v interface{}) (interface{}, error) {
+	if v == nil {
+		if field.Nullable || field.DefaultValue != nil {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("value of field %s is missing", field.Name)
+	}
+	switch field.DataType {
+	case entity.FieldTypeBool:
+		if b, ok := v.(bool); ok {
+			return b, nil
+		}
+	case entity.FieldTypeInt8:
+		n, err := toInt(field, v, math.MinInt8, math.MaxInt8)
+		return int8(n), err
+	case entity.FieldTypeInt16:
+		n, err := toInt(field, v, math.MinInt16, math.MaxInt16)
+		return int16(n), err
+	case entity.FieldTypeInt32:
+		n, err := toInt(field, v, math.MinInt32, math.MaxInt32)
+		return int32(n), err
+	case entity.FieldTypeInt64:
+		return toInt(field, v, math.MinInt64, math.MaxInt64)
+	case entity.FieldTypeFloat:
+		f, err := toFloat(field, v)
+		return float32(f), err
+	case entity.FieldTypeDouble:
+		return toFloat(field, v)
+	case entity.FieldTypeString, entity.FieldTypeVarChar:
+		s, ok := v.(string)
+		if !ok {
+			break
+		}
+		if maxLength := fieldutil.TypeParamLimit(field, entity.TypeParamMaxLength); maxLength >= 0 && len(s) > maxLength {
+			return nil, fmt.Errorf("value length %d of field %s exceeds max length %d", len(s), field.Name, maxLength)
+		}
+		return s, nil
+	case entity.FieldTypeJSON:
+		return jsonValue(field, v)
+	case entity.FieldTypeFloatVector:
+		switch vector := v.(type) {
+		case []float32:
+			return vector, checkDim(field, len(vector), 1)
+		case entity.FloatVector:
+			return []float32(vector), checkDim(field, len(vector), 1)
+		}
+	case entity.FieldTypeBinaryVector:
+		switch vector := v.(type) {
+		case []byte:
+			return vector, checkDim(field, len(vector)*8, 1)
+		case entity.BinaryVector:
+			return []byte(vector), checkDim(field, len(vector)*8, 1)
+		}
+	case entity.FieldTypeFloat16Vector:
+		switch vector := v.(type) {
+		case []byte:
+			return vector, checkDim(field, len(vector), 2)
+		case entity.Float16Vector:
+			return []byte(vector), checkDim(field, len(vector), 2)
+		case []float32:
+			return []byte(entity.FloatVector(vector).ToFloat16Vector()), checkDim(field, len(vector), 1)
+		}
+	case entity.FieldTypeBFloat16Vector:
+		switch vector := v.(type) {
+		case []byte:
+			return vector, checkDim(field, len(vector), 2)
+		case entity.BFloat16Vector:
+			return []byte(vector), checkDim(field, len(vector), 2)
+		case []float32:
+			return []byte(entity.FloatVector(vector).ToBFloat16Vector()), checkDim(field, len(vector), 1)
+		}
+	case entity.FieldTypeSparseFloatVector:
+		if vector, ok := v.(entity.SparseFloatVector); ok {
+			return vector, nil
+		}
+	case entity.FieldTypeArray:
+		return arrayValue(field, v)
+	}
+	return nil, errValueType(field, v)
+}
+
+// checkDim checks the element count of vector value, each dim takes width elements.
+func checkDim(field *entity.Field, n int, width int) error {
+	dim, err := fieldutil.Dim(field)
+	if err != nil {
+		return err
+	}
+	if n != dim*width {
+		return fmt.Errorf("vector dim %d not match field %s dim %d", n/width, field.Name, dim)
+	}
+	return nil
+}
+
+// toInt converts integer value, or integral float value decoded from json, into int64 in [min, max].
+func toInt(field *entity.Field, v interface{}, min, max int64) (int64, error) {
+	var n int64
+	switch v := v.(type) {
+	case int:
+		n = int64(v)
+	case int8:
+		n = int64(v)
+	case int16:
+		n = int64(v)
+	case int32:
+		n = int64(v)
+	case int64:
+		n = v
+	case uint8:
+		n = int64(v)
+	case uint16:
+		n = int64(v)
+	case uint32:
+		n = int64(v)
+	case float64:
+		if v != math.Trunc(v) || v < math.MinInt64 || v >= math.MaxInt64 {
+			return 0, fmt.Errorf("value %v of field %s is not integer", v, field.Name)
+		}
+		n = int64(v)
+	case json.Number:
+		i, err := v.Int64()
+		if err != nil {
+			return 0, fmt.Errorf("value %s of field %s is not integer", v, field.Name)
+		}
+		n = i
+	default:
+		return 0, errValueType(field, v)
+	}
+	if n < min || n > max {
+		return 0, fmt.Errorf("value %d of field %s out of range [%d, %d]", n, field.Name, min, max)
+	}
+	return n, nil
+}
+
+// toFloat converts numeric value into float64.
+func toFloat(field *entity.Field, v interface{}) (float64, error) {
+	switch v := v.(type) {
+	case float32:
+		return float64(v), nil
+	case float64:
+		return v, nil
+	case json.Number:
+		f, err := v.Float64()
+		if err != nil {
+			return 0, fmt.Errorf("value %s of field %s is not number", v, field.Name)
+		}
+		return f, nil
+	}
+	n, err := toInt(field, v, math.MinInt64, math.MaxInt64)
+	return float64(n), err
+}
+
+// jsonValue returns json text of value, []byte and json.RawMessage are treated as json text already.
+func jsonValue(field *entity.Field, v interface{}) ([]byte, error) {
+	var bs []byte
+	switch v := v.(type) {
+	case []byte:
+		bs = v
+	case json.RawMessage:
+		bs = v
+	default:
+		var err error
+		bs, err = json.Marshal(v)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal value of field %s: %w", field.Name, err)
+		}
+	}
+	if !json.Valid(bs) {
+		return nil, fmt.Errorf("value of field %s is not valid json", field.Name)
+	}
+	return bs, nil
+}
+
+// arrayValue checks the element type and capacity of array value.
+func arrayValue(field *entity.Field, v interface{}) (interface{}, error) {
+	n := -1
+	switch field.ElementType {
+	case entity.FieldTypeBool:
+		if values, ok := v.([]bool); ok {
+			n = len(values)
+		}
+	case entity.FieldTypeInt8:
+		if values, ok := v.([]int8); ok {
+			n = len(values)
+		}
+	case entity.FieldTypeInt16:
+		if values, ok := v.([]int16); ok {
+			n = len(values)
+		}
+	case entity.FieldTypeInt32:
+		if values, ok := v.([]int32); ok {
+			n = len(values)
+		}
+	case entity.FieldTypeInt64:
+		if values, ok := v.([]int64); ok {
+			n = len(values)
+		}
+	case entity.FieldTypeFloat:
+		if values, ok := v.([]float32); ok {
+			n = len(values)
+		}
+	case entity.FieldTypeDouble:
+		if values, ok := v.([]float64); ok {
+			n = len(values)
+		}
+	case entity.FieldTypeString, entity.FieldTypeVarChar:
+		if values, ok := v.([]string); ok {
+			n = len(values)
+		}
+	}
+	if n < 0 {
+		return nil, fmt.Errorf("value type %T not match array field %s of element type %s", v, field.Name, field.ElementType.Name())
+	}
+	if err := fieldutil.CheckCapacity(field, n); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// checkColumn checks column type, dim and values against field.
+func checkColumn(field *entity.Field, column entity.Column) error {
+	if column.Type() != field.DataType {
+		return fmt.Errorf("column %s type %s not match field type %s", column.Name(), column.Type().Name(), field.DataType.Name())
+	}
+	switch c := column.(type) {
+	case entity.ArrayColumn:
+		if c.ElementType() != field.ElementType {
+			return fmt.Errorf("column %s element type %s not match field element type %s", column.Name(), c.ElementType().Name(), field.ElementType.Name())
+		}
+	case *entity.ColumnSparseFloatVector:
+	case interface{ Dim() int }:
+		dim, err := fieldutil.Dim(field)
+		if err != nil {
+			return err
+		}
+		if c.Dim() != dim {
+			return fmt.Errorf("column %s dim %d not match field dim %d", column.Name(), c.Dim(), dim)
+		}
+		return nil
+	}
+
+	switch field.DataType {
+	case entity.FieldTypeString, entity.FieldTypeVarChar, entity.FieldTypeJSON, entity.FieldTypeArray:
+	default:
+		if nc, ok := column.(entity.NullableColumn); ok && !field.Nullable && field.DefaultValue == nil {
+			for _, valid := range nc.ValidData() {
+				if !valid {
+					return fmt.Errorf("column %s contains null value", column.Name())
+				}
+			}
+		}
+		return nil
+	}
+	// check the value constraints of each row
+	for i := 0; i < column.Len(); i++ {
+		v, err := column.Get(i)
+		if err != nil {
+			return err
+		}
+		if _, err := checkValue(field, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// nullColumn returns column of rows null values for missing field.
+func nullColumn(field *entity.Field, rows int) (entity.Column, error) {
+	if !field.Nullable && field.DefaultValue == nil {
+		return nil, fmt.Errorf("column of field %s is missing", field.Name)
+	}
+	column, err := fieldutil.NewColumn(field)
+	if err != nil {
+		return nil, err
+	}
+	nc, ok := column.(entity.NullableColumn)
+	if !ok {
+		return nil, fmt.Errorf("field %s of type %s does not support null value", field.Name, field.DataType.Name())
+	}
+	for i := 0; i < rows; i++ {
+		nc.AppendNull()
+	}
+	return nc, nil
+}
+
+// dynamicColumn merges dynamic field column and columns not in schema into dynamic field column.
+func dynamicColumn(field *entity.Field, column entity.Column, extras []entity.Column, rows int) (entity.Column, error) {
+	values := make([][]byte, 0, rows)
+	for i := 0; i < rows; i++ {
+		var v interface{}
+		if column != nil {
+			var err error
+			if v, err = column.Get(i); err != nil {
+				return nil, err
+			}
+		}
+		extra := make(map[string]interface{}, len(extras))
+		for _, c := range extras {
+			value, err := c.Get(i)
+			if err != nil {
+				return nil, err
+			}
+			extra[c.Name()] = value
+		}
+		bs, err := fieldutil.DynamicValue(v, extra)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, bs)
+	}
+	return entity.NewColumnJSONBytes(field.Name, values).WithIsDynamic(true), nil
+}
+
+// valueSize estimates the size in bytes of value.
+func valueSize(v interface{}) int64 {
+	switch v := v.(type) {
+	case bool, int8:
+		return 1
+	case int16:
+		return 2
+	case int32, float32:
+		return 4
+	case int64, float64:
+		return 8
+	case string:
+		return int64(len(v))
+	case []byte:
+		return int64(len(v))
+	case []float32:
+		return int64(len(v) * 4)
+	case entity.SparseFloatVector:
+		return int64(v.Len() * 8)
+	case []bool:
+		return int64(len(v))
+	case []int8:
+		return int64(len(v))
+	case []int16:
+		return int64(len(v) * 2)
+	case []int32:
+		return int64(len(v) * 4)
+	case []int64:
+		return int64(len(v) * 8)
+	case []float64:
+		return int64(len(v) * 8)
+	case []string:
+		var size int64
+		for _, s := range v {
+			size += int64(len(s))
+		}
+		return size
+	default:
+		return 0
+	}
+}
+
+// columnSize estimates the size in bytes of column.
+func columnSize(column entity.Column) int64 {
+	var size int64
+	for i := 0; i < column.Len(); i++ {
+		v, _ := column.Get(i)
+		size += valueSize(v)
+	}
+	return size
+}
@@ -18,7 +18,9 @@ import (
 )
 
 // GetVersion returns milvus server version information.
-func (c *GrpcClient) GetVersion(ctx context.Context) (string, error) {
+func (c *GrpcClient) GetVersion(ctx context.Context) (_ string, err error) {
+	ctx, span := c.startSpan(ctx, "GetVersion")
+	defer func() { endSpan(span, err) }()
 	if c.Service == nil {
 		return "", ErrClientNotReady
 	}
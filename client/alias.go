@@ -23,7 +23,9 @@ import (
 )
 
 // CreateAlias creates an alias for collection
-func (c *GrpcClient) CreateAlias(ctx context.Context, collName string, alias string) error {
+func (c *GrpcClient) CreateAlias(ctx context.Context, collName string, alias string) (err error) {
+	ctx, span := c.startSpan(ctx, "CreateAlias", AttrCollection.String(collName))
+	defer func() { endSpan(span, err) }()
 	if c.Service == nil {
 		return ErrClientNotReady
 	}
@@ -38,7 +40,7 @@ func (c *GrpcClient) CreateAlias(ctx context.Context, collName string, alias str
 	if err != nil {
 		return err
 	}
-	err = handleRespStatus(resp)
+	err = handleRespStatus(ctx, collName, resp)
 	if err != nil {
 		return err
 	}
@@ -46,7 +48,9 @@ func (c *GrpcClient) CreateAlias(ctx context.Context, collName string, alias str
 }
 
 // DropAlias drops the specified Alias
-func (c *GrpcClient) DropAlias(ctx context.Context, alias string) error {
+func (c *GrpcClient) DropAlias(ctx context.Context, alias string) (err error) {
+	ctx, span := c.startSpan(ctx, "DropAlias")
+	defer func() { endSpan(span, err) }()
 	if c.Service == nil {
 		return ErrClientNotReady
 	}
@@ -60,15 +64,19 @@ func (c *GrpcClient) DropAlias(ctx context.Context, alias string) error {
 	if err != nil {
 		return err
 	}
-	err = handleRespStatus(resp)
+	err = handleRespStatus(ctx, "", resp)
 	if err != nil {
 		return err
 	}
+	// alias now points to another collection or nothing
+	c.invalidateCollection(ctx, alias)
 	return nil
 }
 
 // AlterAlias changes collection alias to provided alias
-func (c *GrpcClient) AlterAlias(ctx context.Context, collName string, alias string) error {
+func (c *GrpcClient) AlterAlias(ctx context.Context, collName string, alias string) (err error) {
+	ctx, span := c.startSpan(ctx, "AlterAlias", AttrCollection.String(collName))
+	defer func() { endSpan(span, err) }()
 	if c.Service == nil {
 		return ErrClientNotReady
 	}
@@ -83,9 +91,11 @@ func (c *GrpcClient) AlterAlias(ctx context.Context, collName string, alias stri
 	if err != nil {
 		return err
 	}
-	err = handleRespStatus(resp)
+	err = handleRespStatus(ctx, collName, resp)
 	if err != nil {
 		return err
 	}
+	// alias now points to another collection or nothing
+	c.invalidateCollection(ctx, alias)
 	return nil
 }
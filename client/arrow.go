@@ -0,0 +1,77 @@
+// Copyright (C) 2019-2021 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package client
+
+import (
+	"context"
+
+	"github.com/apache/arrow/go/v12/arrow"
+	"github.com/apache/arrow/go/v12/arrow/array"
+	"github.com/apache/arrow/go/v12/arrow/memory"
+
+	"github.com/milvus-io/milvus-sdk-go/v2/entity"
+)
+
+// ToArrowRecord converts result set into arrow record with one arrow column per column,
+// nullability of arrow fields follows the field definition in sch, which could be nil.
+// Numeric scalar columns share memory with the record, the caller shall release the record.
+func (rs ResultSet) ToArrowRecord(sch *entity.Schema) (arrow.Record, error) {
+	fields := make(map[string]*entity.Field)
+	if sch != nil {
+		for _, field := range sch.Fields {
+			fields[field.Name] = field
+		}
+	}
+
+	arrowFields := make([]arrow.Field, 0, len(rs))
+	arrays := make([]arrow.Array, 0, len(rs))
+	defer func() {
+		// record retains the arrays
+		for _, arr := range arrays {
+			arr.Release()
+		}
+	}()
+	for _, column := range rs {
+		arr, err := entity.ColumnToArrow(memory.DefaultAllocator, column)
+		if err != nil {
+			return nil, err
+		}
+		arrays = append(arrays, arr)
+		nullable := arr.NullN() > 0
+		if field, ok := fields[column.Name()]; ok && field.Nullable {
+			nullable = true
+		}
+		arrowFields = append(arrowFields, arrow.Field{Name: column.Name(), Type: arr.DataType(), Nullable: nullable})
+	}
+	return array.NewRecord(arrow.NewSchema(arrowFields, nil), arrays, int64(rs.Len())), nil
+}
+
+// InsertArrow inserts arrow record into collection, record columns are matched with fields by name,
+// columns not in schema are inserted into dynamic field when it's enabled.
+// Vectors are expected as FixedSizeList, sparse vectors as Map of index to value and arrays as List.
+// Columns are converted without copy when memory layouts match.
+func (c *GrpcClient) InsertArrow(ctx context.Context, collName string, partitionName string, record arrow.Record) (_ entity.Column, err error) {
+	ctx, span := c.startSpan(ctx, "InsertArrow", AttrCollection.String(collName), partitionCount(partitionName))
+	defer func() { endSpan(span, err) }()
+	return c.insert(ctx, collName, partitionName, func(sch *entity.Schema) ([]entity.Column, error) {
+		return entity.ColumnsFromArrow(record, sch)
+	})
+}
+
+// UpsertArrow upserts arrow record into collection, record columns are converted as InsertArrow does.
+func (c *GrpcClient) UpsertArrow(ctx context.Context, collName string, partitionName string, record arrow.Record) (_ entity.Column, err error) {
+	ctx, span := c.startSpan(ctx, "UpsertArrow", AttrCollection.String(collName), partitionCount(partitionName))
+	defer func() { endSpan(span, err) }()
+	return c.upsert(ctx, collName, partitionName, func(sch *entity.Schema) ([]entity.Column, error) {
+		return entity.ColumnsFromArrow(record, sch)
+	})
+}
@@ -0,0 +1,144 @@
+// Copyright (C) 2019-2021 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/apache/arrow/go/v12/arrow"
+	common "github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	server "github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	schema "github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-sdk-go/v2/entity"
+)
+
+func TestResultSetToArrowRecord(t *testing.T) {
+	sch := entity.NewSchema().
+		WithField(entity.NewField().WithName("id").WithDataType(entity.FieldTypeInt64).WithIsPrimaryKey(true)).
+		WithField(entity.NewField().WithName("name").WithDataType(entity.FieldTypeVarChar).WithNullable(true)).
+		WithField(entity.NewField().WithName("vector").WithDataType(entity.FieldTypeFloatVector).WithDim(2))
+	rs := ResultSet{
+		entity.NewColumnInt64("id", []int64{1, 2}),
+		entity.NewColumnVarChar("name", []string{"a", "b"}),
+		entity.NewColumnFloatVector("vector", 2, [][]float32{{0.1, 0.2}, {0.3, 0.4}}),
+	}
+
+	record, err := rs.ToArrowRecord(sch)
+	require.NoError(t, err)
+	defer record.Release()
+	assert.EqualValues(t, 2, record.NumRows())
+	assert.EqualValues(t, 3, record.NumCols())
+	assert.False(t, record.Schema().Field(0).Nullable)
+	assert.True(t, record.Schema().Field(1).Nullable)
+	assert.Equal(t, arrow.FixedSizeListOf(2, arrow.PrimitiveTypes.Float32), record.Schema().Field(2).Type)
+
+	columns, err := entity.ColumnsFromArrow(record, sch)
+	require.NoError(t, err)
+	ids, err := GetColumnOf[int64](columns, "id")
+	require.NoError(t, err)
+	assert.Equal(t, []int64{1, 2}, ids.Data())
+
+	_, err = ResultSet{entity.NewColumnDynamic(entity.NewColumnJSONBytes("$meta", [][]byte{[]byte(`{"a":1}`)}), "a")}.ToArrowRecord(nil)
+	assert.NoError(t, err)
+}
+
+func (s *InsertSuite) TestInsertArrow() {
+	c := s.client
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	sch := entity.NewSchema().WithName(testCollectionName).WithDynamicFieldEnabled(true).
+		WithField(entity.NewField().WithIsPrimaryKey(true).WithName("ID").WithDataType(entity.FieldTypeInt64)).
+		WithField(entity.NewField().WithName("vector").WithDataType(entity.FieldTypeFloatVector).WithDim(2))
+	record, err := ResultSet{
+		entity.NewColumnInt64("ID", []int64{1, 2}),
+		entity.NewColumnFloatVector("vector", 2, [][]float32{{0.1, 0.2}, {0.3, 0.4}}),
+		entity.NewColumnVarChar("extra", []string{"a", "b"}),
+	}.ToArrowRecord(sch)
+	s.Require().NoError(err)
+	defer record.Release()
+
+	result := &server.MutationResult{
+		Status: &common.Status{},
+		IDs: &schema.IDs{
+			IdField: &schema.IDs_IntId{
+				IntId: &schema.LongArray{
+					Data: []int64{1, 2},
+				},
+			},
+		},
+	}
+	checkFieldsData := func(fieldsData []*schema.FieldData, n int) {
+		s.Require().Equal(n, len(fieldsData))
+		for _, fd := range fieldsData {
+			switch fd.GetFieldName() {
+			case "ID":
+				s.Equal([]int64{1, 2}, fd.GetScalars().GetLongData().GetData())
+			case "vector":
+				s.Equal([]float32{0.1, 0.2, 0.3, 0.4}, fd.GetVectors().GetFloatVector().GetData())
+			default:
+				s.True(fd.GetIsDynamic())
+			}
+		}
+	}
+
+	s.Run("insert", func() {
+		defer s.resetMock()
+		s.setupDescribeCollection(testCollectionName, sch)
+		s.mock.EXPECT().Insert(mock.Anything, mock.AnythingOfType("*milvuspb.InsertRequest")).
+			Run(func(ctx context.Context, req *server.InsertRequest) {
+				s.EqualValues(2, req.GetNumRows())
+				checkFieldsData(req.GetFieldsData(), 3)
+			}).Return(result, nil)
+
+		ids, err := c.InsertArrow(ctx, testCollectionName, "", record)
+		s.NoError(err)
+		s.Equal(2, ids.Len())
+	})
+
+	s.Run("upsert", func() {
+		defer s.resetMock()
+		s.setupDescribeCollection(testCollectionName, sch)
+		s.mock.EXPECT().Upsert(mock.Anything, mock.AnythingOfType("*milvuspb.UpsertRequest")).
+			Run(func(ctx context.Context, req *server.UpsertRequest) {
+				s.EqualValues(2, req.GetNumRows())
+				checkFieldsData(req.GetFieldsData(), 2)
+			}).Return(result, nil)
+
+		// upsert does not support dynamic columns
+		upsertRecord, err := ResultSet{
+			entity.NewColumnInt64("ID", []int64{1, 2}),
+			entity.NewColumnFloatVector("vector", 2, [][]float32{{0.1, 0.2}, {0.3, 0.4}}),
+		}.ToArrowRecord(sch)
+		s.Require().NoError(err)
+		defer upsertRecord.Release()
+
+		ids, err := c.UpsertArrow(ctx, testCollectionName, "", upsertRecord)
+		s.NoError(err)
+		s.Equal(2, ids.Len())
+	})
+
+	s.Run("type_not_match", func() {
+		defer s.resetMock()
+		s.setupDescribeCollection(testCollectionName, entity.NewSchema().WithName(testCollectionName).
+			WithField(entity.NewField().WithIsPrimaryKey(true).WithName("ID").WithDataType(entity.FieldTypeVarChar)).
+			WithField(entity.NewField().WithName("vector").WithDataType(entity.FieldTypeFloatVector).WithDim(2)))
+
+		_, err := c.InsertArrow(ctx, testCollectionName, "", record)
+		s.Error(err)
+	})
+}
@@ -8,7 +8,9 @@ import (
 )
 
 // CreateCredential create new user and password
-func (c *GrpcClient) CreateCredential(ctx context.Context, username string, password string) error {
+func (c *GrpcClient) CreateCredential(ctx context.Context, username string, password string) (err error) {
+	ctx, span := c.startSpan(ctx, "CreateCredential")
+	defer func() { endSpan(span, err) }()
 	if c.Service == nil {
 		return ErrClientNotReady
 	}
@@ -20,7 +22,7 @@ func (c *GrpcClient) CreateCredential(ctx context.Context, username string, pass
 	if err != nil {
 		return err
 	}
-	err = handleRespStatus(resp)
+	err = handleRespStatus(ctx, "", resp)
 	if err != nil {
 		return err
 	}
@@ -28,7 +30,9 @@ func (c *GrpcClient) CreateCredential(ctx context.Context, username string, pass
 }
 
 // UpdateCredential update password for a user
-func (c *GrpcClient) UpdateCredential(ctx context.Context, username string, oldPassword string, newPassword string) error {
+func (c *GrpcClient) UpdateCredential(ctx context.Context, username string, oldPassword string, newPassword string) (err error) {
+	ctx, span := c.startSpan(ctx, "UpdateCredential")
+	defer func() { endSpan(span, err) }()
 	if c.Service == nil {
 		return ErrClientNotReady
 	}
@@ -41,7 +45,7 @@ func (c *GrpcClient) UpdateCredential(ctx context.Context, username string, oldP
 	if err != nil {
 		return err
 	}
-	err = handleRespStatus(resp)
+	err = handleRespStatus(ctx, "", resp)
 	if err != nil {
 		return err
 	}
@@ -49,7 +53,9 @@ func (c *GrpcClient) UpdateCredential(ctx context.Context, username string, oldP
 }
 
 // DeleteCredential delete a user
-func (c *GrpcClient) DeleteCredential(ctx context.Context, username string) error {
+func (c *GrpcClient) DeleteCredential(ctx context.Context, username string) (err error) {
+	ctx, span := c.startSpan(ctx, "DeleteCredential")
+	defer func() { endSpan(span, err) }()
 	if c.Service == nil {
 		return ErrClientNotReady
 	}
@@ -60,7 +66,7 @@ func (c *GrpcClient) DeleteCredential(ctx context.Context, username string) erro
 	if err != nil {
 		return err
 	}
-	err = handleRespStatus(resp)
+	err = handleRespStatus(ctx, "", resp)
 	if err != nil {
 		return err
 	}
@@ -68,7 +74,9 @@ func (c *GrpcClient) DeleteCredential(ctx context.Context, username string) erro
 }
 
 // ListCredUsers list all usernames
-func (c *GrpcClient) ListCredUsers(ctx context.Context) ([]string, error) {
+func (c *GrpcClient) ListCredUsers(ctx context.Context) (_ []string, err error) {
+	ctx, span := c.startSpan(ctx, "ListCredUsers")
+	defer func() { endSpan(span, err) }()
 	if c.Service == nil {
 		return nil, ErrClientNotReady
 	}
@@ -77,7 +85,7 @@ func (c *GrpcClient) ListCredUsers(ctx context.Context) ([]string, error) {
 	if err != nil {
 		return nil, err
 	}
-	err = handleRespStatus(resp.Status)
+	err = handleRespStatus(ctx, "", resp.Status)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,406 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/balancer"
+	"google.golang.org/grpc/balancer/base"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/resolver"
+	"google.golang.org/grpc/resolver/manual"
+	"google.golang.org/grpc/status"
+)
+
+// LoadBalancePolicy is the policy used to pick an endpoint for each request
+// when multiple endpoints are configured.
+type LoadBalancePolicy string
+
+const (
+	// PickFirst sends all requests to the first available endpoint and
+	// fails over to the next one only when it becomes unavailable.
+	PickFirst LoadBalancePolicy = "pick_first"
+	// RoundRobin spreads requests over all available endpoints in turn.
+	RoundRobin LoadBalancePolicy = "round_robin"
+	// LeastRequest sends each request to the endpoint with the least outstanding requests.
+	LeastRequest LoadBalancePolicy = "least_request"
+)
+
+const (
+	// DefaultEjectionDuration is the default duration an unavailable endpoint is excluded from picking.
+	DefaultEjectionDuration = 5 * time.Second
+
+	endpointResolverScheme = "milvus"
+	endpointBalancerName   = "milvus_endpoint"
+)
+
+func init() {
+	balancer.Register(endpointBalancerBuilder{})
+}
+
+var (
+	// endpointPools holds all the living endpoint pools, keyed by the resolver scheme.
+	endpointPools   sync.Map
+	endpointPoolSeq uint64
+)
+
+type endpointCtxKey struct{}
+
+// withEndpoint pins the request in ctx to the provided endpoint.
+func withEndpoint(ctx context.Context, ep *endpoint) context.Context {
+	return context.WithValue(ctx, endpointCtxKey{}, ep)
+}
+
+func endpointFromContext(ctx context.Context) (*endpoint, bool) {
+	ep, ok := ctx.Value(endpointCtxKey{}).(*endpoint)
+	return ep, ok
+}
+
+// endpointBalancerBuilder builds base balancer which reports ready endpoints to the endpoint pool.
+type endpointBalancerBuilder struct{}
+
+func (endpointBalancerBuilder) Name() string {
+	return endpointBalancerName
+}
+
+func (endpointBalancerBuilder) Build(cc balancer.ClientConn, opts balancer.BuildOptions) balancer.Balancer {
+	pb := &endpointPickerBuilder{}
+	if pool, ok := endpointPools.Load(opts.Target.Scheme); ok {
+		pb.pool = pool.(*endpointPool)
+	}
+	return base.NewBalancerBuilder(endpointBalancerName, pb, base.Config{}).Build(cc, opts)
+}
+
+// endpointPickerBuilder builds pickers which route each request to the endpoint pinned in its context.
+type endpointPickerBuilder struct {
+	pool *endpointPool
+}
+
+func (b *endpointPickerBuilder) Build(info base.PickerBuildInfo) balancer.Picker {
+	ready := make(map[string]struct{}, len(info.ReadySCs))
+	p := &endpointPicker{
+		subConns: make(map[string]balancer.SubConn, len(info.ReadySCs)),
+	}
+	for sc, sci := range info.ReadySCs {
+		ready[sci.Address.Addr] = struct{}{}
+		p.subConns[sci.Address.Addr] = sc
+		p.ready = append(p.ready, sc)
+	}
+	if b.pool != nil {
+		b.pool.setReady(ready)
+	}
+	if len(info.ReadySCs) == 0 {
+		return base.NewErrPicker(balancer.ErrNoSubConnAvailable)
+	}
+	return p
+}
+
+type endpointPicker struct {
+	subConns map[string]balancer.SubConn
+	ready    []balancer.SubConn
+}
+
+func (p *endpointPicker) Pick(info balancer.PickInfo) (balancer.PickResult, error) {
+	ep, ok := endpointFromContext(info.Ctx)
+	if !ok {
+		// requests not going through the endpoint pool, use any ready endpoint
+		return balancer.PickResult{SubConn: p.ready[rand.Intn(len(p.ready))]}, nil
+	}
+	sc, ok := p.subConns[ep.addr]
+	if !ok {
+		return balancer.PickResult{}, status.Errorf(codes.Unavailable, "milvus endpoint %s is not ready", ep.addr)
+	}
+	return balancer.PickResult{SubConn: sc}, nil
+}
+
+// endpoint is one of the remote milvus proxies known by the client.
+type endpoint struct {
+	addr        string
+	serverName  string
+	outstanding int64 // number of in-flight requests
+	ejectedTill int64 // unix nano timestamp, endpoint is not picked before it unless no other choice
+
+	mu         sync.Mutex
+	identifier string // identifier assigned by this endpoint in the `Connect` handshake
+}
+
+func (ep *endpoint) getIdentifier() string {
+	ep.mu.Lock()
+	defer ep.mu.Unlock()
+	return ep.identifier
+}
+
+func (ep *endpoint) ejected(now time.Time) bool {
+	return atomic.LoadInt64(&ep.ejectedTill) > now.UnixNano()
+}
+
+// handshakeFunc performs the `Connect` handshake against the endpoint pinned in ctx and returns the identifier.
+type handshakeFunc func(ctx context.Context) (string, error)
+
+// endpointPool picks the endpoint for each request according to the LoadBalancePolicy,
+// ejects the unavailable ones and fails over to the rest.
+type endpointPool struct {
+	next uint64 // round robin counter, keep it first for 64-bit alignment
+
+	policy      LoadBalancePolicy
+	ejection    time.Duration
+	disableConn bool
+	handshake   handshakeFunc
+	retry       *RetryPolicy
+	logger      clientLogger
+	scheme      string
+	resolver    *manual.Resolver
+	ready       atomic.Value // map[string]struct{}, addresses of ready endpoints reported by balancer
+
+	endpoints []*endpoint
+	active    int32 // index of current endpoint for pick first policy
+}
+
+func newEndpointPool(cfg *Config, addrs []string, handshake handshakeFunc) *endpointPool {
+	p := &endpointPool{
+		policy:      cfg.LoadBalancePolicy,
+		ejection:    cfg.EjectionDuration,
+		disableConn: cfg.DisableConn,
+		handshake:   handshake,
+		retry:       cfg.RetryPolicy,
+		logger:      newClientLogger(cfg.Logger),
+		scheme:      fmt.Sprintf("%s-%d", endpointResolverScheme, atomic.AddUint64(&endpointPoolSeq, 1)),
+		endpoints:   make([]*endpoint, 0, len(addrs)),
+	}
+	p.resolver = manual.NewBuilderWithScheme(p.scheme)
+	p.ready.Store(map[string]struct{}{})
+	if p.policy == "" {
+		p.policy = PickFirst
+	}
+	if p.retry == nil {
+		p.retry = DefaultRetryPolicy()
+	}
+	if p.ejection <= 0 {
+		p.ejection = DefaultEjectionDuration
+	}
+	state := resolver.State{Addresses: make([]resolver.Address, 0, len(addrs))}
+	for _, addr := range addrs {
+		ep := &endpoint{addr: addr, serverName: hostOf(addr)}
+		p.endpoints = append(p.endpoints, ep)
+		state.Addresses = append(state.Addresses, resolver.Address{Addr: ep.addr, ServerName: ep.serverName})
+	}
+	p.resolver.InitialState(state)
+	endpointPools.Store(p.scheme, p)
+	return p
+}
+
+// close unregisters the pool.
+func (p *endpointPool) close() {
+	endpointPools.Delete(p.scheme)
+}
+
+// target returns the dial target resolved by the pool resolver.
+func (p *endpointPool) target() string {
+	return fmt.Sprintf("%s:///%s", p.scheme, p.endpoints[0].serverName)
+}
+
+func (p *endpointPool) setReady(ready map[string]struct{}) {
+	p.ready.Store(ready)
+}
+
+func (p *endpointPool) isReady(ep *endpoint) bool {
+	_, ok := p.ready.Load().(map[string]struct{})[ep.addr]
+	return ok
+}
+
+// dialOptions returns the grpc options routing requests through the pool.
+// The interceptor shall be chained after the metadata one since it appends the endpoint identifier.
+func (p *endpointPool) dialOptions() []grpc.DialOption {
+	return []grpc.DialOption{
+		grpc.WithResolvers(p.resolver),
+		grpc.WithDefaultServiceConfig(fmt.Sprintf(`{"loadBalancingConfig":[{"%s":{}}]}`, endpointBalancerName)),
+		grpc.WithChainUnaryInterceptor(p.unaryInterceptor()),
+	}
+}
+
+// pick returns the endpoint for next request, endpoints in tried are skipped.
+// Ready endpoints are preferred, and ejected endpoints are only picked when all the others are ejected as well.
+func (p *endpointPool) pick(tried map[*endpoint]struct{}) *endpoint {
+	now := time.Now()
+	// candidate tiers: ready, ready but ejected, not ready
+	tiers := make([][]int, 3)
+	for idx, ep := range p.endpoints {
+		if _, ok := tried[ep]; ok {
+			continue
+		}
+		switch {
+		case !p.isReady(ep):
+			tiers[2] = append(tiers[2], idx)
+		case ep.ejected(now):
+			tiers[1] = append(tiers[1], idx)
+		default:
+			tiers[0] = append(tiers[0], idx)
+		}
+	}
+	var candidates []int
+	for _, tier := range tiers {
+		if len(tier) > 0 {
+			candidates = tier
+			break
+		}
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	switch p.policy {
+	case RoundRobin:
+		n := atomic.AddUint64(&p.next, 1)
+		return p.endpoints[candidates[(n-1)%uint64(len(candidates))]]
+	case LeastRequest:
+		picked := p.endpoints[candidates[0]]
+		for _, idx := range candidates[1:] {
+			if atomic.LoadInt64(&p.endpoints[idx].outstanding) < atomic.LoadInt64(&picked.outstanding) {
+				picked = p.endpoints[idx]
+			}
+		}
+		return picked
+	default:
+		active := int(atomic.LoadInt32(&p.active))
+		for _, idx := range candidates {
+			if idx == active {
+				return p.endpoints[idx]
+			}
+		}
+		atomic.StoreInt32(&p.active, int32(candidates[0]))
+		return p.endpoints[candidates[0]]
+	}
+}
+
+// eject excludes ep from picking for a while, handshake will be performed again
+// when it is picked next time since the remote may have restarted.
+func (p *endpointPool) eject(ctx context.Context, ep *endpoint, err error) {
+	p.logger.eject(ctx, ep.addr, p.ejection, err)
+	atomic.StoreInt64(&ep.ejectedTill, time.Now().Add(p.ejection).UnixNano())
+	ep.mu.Lock()
+	ep.identifier = ""
+	ep.mu.Unlock()
+}
+
+// ensureHandshake performs the `Connect` handshake with ep if not done yet.
+func (p *endpointPool) ensureHandshake(ctx context.Context, ep *endpoint) error {
+	if p.disableConn {
+		return nil
+	}
+	ep.mu.Lock()
+	defer ep.mu.Unlock()
+	if ep.identifier != "" {
+		return nil
+	}
+	// failover is handled by the pool, skip the retry interceptor for pinned handshake
+	identifier, err := p.handshake(WithoutRetry(withEndpoint(ctx, ep)))
+	if err != nil {
+		return err
+	}
+	ep.identifier = identifier
+	return nil
+}
+
+// acquire picks an available endpoint which has finished handshake, endpoints failed are ejected and added into tried.
+func (p *endpointPool) acquire(ctx context.Context, tried map[*endpoint]struct{}) (*endpoint, error) {
+	var lastErr error
+	for {
+		ep := p.pick(tried)
+		if ep == nil {
+			if lastErr == nil {
+				lastErr = status.Error(codes.Unavailable, "no available milvus endpoint")
+			}
+			return nil, lastErr
+		}
+		tried[ep] = struct{}{}
+		err := p.ensureHandshake(ctx, ep)
+		if err == nil {
+			return ep, nil
+		}
+		if status.Code(err) != codes.Unavailable {
+			return nil, err
+		}
+		p.eject(ctx, ep, err)
+		lastErr = err
+	}
+}
+
+// connect resets all the endpoint identifiers and performs handshake with the next endpoint.
+// Other endpoints will perform handshake lazily when they are picked.
+func (p *endpointPool) connect(ctx context.Context) error {
+	for _, ep := range p.endpoints {
+		ep.mu.Lock()
+		ep.identifier = ""
+		ep.mu.Unlock()
+	}
+	_, err := p.acquire(ctx, make(map[*endpoint]struct{}))
+	return err
+}
+
+func (p *endpointPool) unaryInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		// already pinned, handshake request for example
+		if _, ok := endpointFromContext(ctx); ok {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+
+		tried := make(map[*endpoint]struct{})
+		for {
+			ep, err := p.acquire(ctx, tried)
+			if err != nil {
+				return err
+			}
+			err = p.invoke(ctx, ep, method, req, reply, cc, invoker, opts...)
+			if status.Code(err) != codes.Unavailable {
+				return err
+			}
+			// fail over to other endpoints
+			p.eject(ctx, ep, err)
+			if !p.failover(ctx, method) {
+				return err
+			}
+		}
+	}
+}
+
+// failover returns whether the request failed with unavailable could be sent to another endpoint.
+// Non-idempotent requests may have reached the server, they fail over only if the retry rule allows.
+func (p *endpointPool) failover(ctx context.Context, method string) bool {
+	if methodClassOf(method) != MethodClassNonIdempotent {
+		return true
+	}
+	return retryRuleOf(ctx, p.retry, method).retryableCode(codes.Unavailable)
+}
+
+func (p *endpointPool) invoke(ctx context.Context, ep *endpoint, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	atomic.AddInt64(&ep.outstanding, 1)
+	defer atomic.AddInt64(&ep.outstanding, -1)
+
+	ctx = withEndpoint(ctx, ep)
+	if identifier := ep.getIdentifier(); identifier != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "identifier", identifier)
+	}
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
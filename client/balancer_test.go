@@ -0,0 +1,319 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	common "github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	server "github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-sdk-go/v2/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+type mockEndpoint struct {
+	addr string
+	lis  *bufconn.Listener
+	svr  *grpc.Server
+	mock *mocks.MilvusServiceServer
+
+	mu          sync.Mutex
+	identifiers []string // identifiers received by GetVersion
+}
+
+func (e *mockEndpoint) received() []string {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return append([]string(nil), e.identifiers...)
+}
+
+type BalancerSuite struct {
+	suite.Suite
+
+	endpoints []*mockEndpoint
+}
+
+func (s *BalancerSuite) SetupTest() {
+	s.endpoints = nil
+	for i := 0; i < 3; i++ {
+		ep := &mockEndpoint{
+			addr: fmt.Sprintf("bufnet-ep%d:19530", i),
+			lis:  bufconn.Listen(bufSize),
+			svr:  grpc.NewServer(),
+			mock: &mocks.MilvusServiceServer{},
+		}
+		server.RegisterMilvusServiceServer(ep.svr, ep.mock)
+		ep.mock.EXPECT().Connect(mock.Anything, mock.Anything).Return(&server.ConnectResponse{
+			Status:     &common.Status{},
+			Identifier: int64(100 + i),
+		}, nil).Maybe()
+		ep.mock.EXPECT().GetVersion(mock.Anything, mock.Anything).Run(func(ctx context.Context, _ *server.GetVersionRequest) {
+			md, _ := metadata.FromIncomingContext(ctx)
+			ep.mu.Lock()
+			defer ep.mu.Unlock()
+			ep.identifiers = append(ep.identifiers, md.Get("identifier")...)
+		}).Return(&server.GetVersionResponse{Status: &common.Status{}, Version: ep.addr}, nil).Maybe()
+		go ep.svr.Serve(ep.lis)
+		s.endpoints = append(s.endpoints, ep)
+	}
+}
+
+func (s *BalancerSuite) TearDownTest() {
+	for _, ep := range s.endpoints {
+		ep.svr.Stop()
+		ep.lis.Close()
+	}
+}
+
+func (s *BalancerSuite) dialer(_ context.Context, addr string) (net.Conn, error) {
+	for _, ep := range s.endpoints {
+		if ep.addr == addr {
+			return ep.lis.Dial()
+		}
+	}
+	return nil, fmt.Errorf("unknown address %s", addr)
+}
+
+func (s *BalancerSuite) newClient(policy LoadBalancePolicy) Client {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	endpoints := make([]string, 0, len(s.endpoints))
+	for _, ep := range s.endpoints {
+		endpoints = append(endpoints, ep.addr)
+	}
+	c, err := NewClient(ctx, Config{
+		Endpoints:         endpoints,
+		LoadBalancePolicy: policy,
+		// shall be overridden by the identifier assigned by each endpoint
+		Identifier: "provided",
+		DialOptions: []grpc.DialOption{
+			grpc.WithBlock(),
+			grpc.WithInsecure(),
+			grpc.WithContextDialer(s.dialer),
+		},
+	})
+	s.Require().NoError(err)
+	return c
+}
+
+func (s *BalancerSuite) TestRoundRobin() {
+	c := s.newClient(RoundRobin)
+	defer c.Close()
+
+	// wait all sub connections ready
+	s.Eventually(func() bool {
+		version, err := c.GetVersion(context.Background())
+		return err == nil && version == s.endpoints[2].addr
+	}, 5*time.Second, 10*time.Millisecond)
+
+	for i := 0; i < 30; i++ {
+		_, err := c.GetVersion(context.Background())
+		s.Require().NoError(err)
+	}
+	for i, ep := range s.endpoints {
+		received := ep.received()
+		s.GreaterOrEqual(len(received), 10)
+		// each endpoint receives the identifier assigned by itself
+		for _, identifier := range received {
+			s.Equal(strconv.Itoa(100+i), identifier)
+		}
+	}
+}
+
+func (s *BalancerSuite) TestPickFirstFailover() {
+	c := s.newClient(PickFirst)
+	defer c.Close()
+
+	// stick to the first available endpoint
+	active, err := c.GetVersion(context.Background())
+	s.Require().NoError(err)
+	for i := 0; i < 5; i++ {
+		version, err := c.GetVersion(context.Background())
+		s.Require().NoError(err)
+		s.Equal(active, version)
+	}
+
+	for _, ep := range s.endpoints {
+		if ep.addr == active {
+			ep.svr.Stop()
+		}
+	}
+	var failover string
+	s.Eventually(func() bool {
+		version, err := c.GetVersion(context.Background())
+		failover = version
+		return err == nil && version != active
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// stick to the new endpoint, identifier refreshed by handshake
+	for i := 0; i < 5; i++ {
+		version, err := c.GetVersion(context.Background())
+		s.Require().NoError(err)
+		s.Equal(failover, version)
+	}
+	for i, ep := range s.endpoints {
+		if ep.addr != failover {
+			continue
+		}
+		s.NotEmpty(ep.received())
+		for _, identifier := range ep.received() {
+			s.Equal(strconv.Itoa(100+i), identifier)
+		}
+	}
+}
+
+func (s *BalancerSuite) TestNonIdempotentNoFailover() {
+	c := s.newClient(PickFirst)
+	defer c.Close()
+
+	var mu sync.Mutex
+	inserts, upserts := 0, 0
+	for _, ep := range s.endpoints {
+		ep.mock.EXPECT().Insert(mock.Anything, mock.Anything).Run(func(_ context.Context, _ *server.InsertRequest) {
+			mu.Lock()
+			defer mu.Unlock()
+			inserts++
+		}).Return(nil, status.Error(codes.Unavailable, "connection lost")).Maybe()
+		ep.mock.EXPECT().Upsert(mock.Anything, mock.Anything).Run(func(_ context.Context, _ *server.UpsertRequest) {
+			mu.Lock()
+			defer mu.Unlock()
+			upserts++
+		}).Return(nil, status.Error(codes.Unavailable, "connection lost")).Maybe()
+	}
+	service := c.(*GrpcClient).Service
+
+	// insert may have been applied, it is not sent to other endpoints
+	_, err := service.Insert(context.Background(), &server.InsertRequest{})
+	s.Equal(codes.Unavailable, status.Code(err))
+	s.Equal(1, inserts)
+
+	// idempotent write fails over to all endpoints
+	_, err = service.Upsert(WithoutRetry(context.Background()), &server.UpsertRequest{})
+	s.Equal(codes.Unavailable, status.Code(err))
+	s.Equal(len(s.endpoints), upserts)
+
+	// retry rule retrying on unavailable allows insert to fail over
+	inserts = 0
+	rule := RetryRule{MaxAttempts: 1, RetryableCodes: []codes.Code{codes.Unavailable}}
+	_, err = service.Insert(WithRetryRule(context.Background(), rule), &server.InsertRequest{})
+	s.Equal(codes.Unavailable, status.Code(err))
+	s.Equal(len(s.endpoints), inserts)
+}
+
+func (s *BalancerSuite) TestLeastRequest() {
+	cfg := &Config{LoadBalancePolicy: LeastRequest}
+	pool := newEndpointPool(cfg, []string{"a:19530", "b:19530", "c:19530"}, nil)
+	defer pool.close()
+	pool.setReady(map[string]struct{}{"a:19530": {}, "b:19530": {}, "c:19530": {}})
+
+	pool.endpoints[0].outstanding = 3
+	pool.endpoints[1].outstanding = 1
+	pool.endpoints[2].outstanding = 2
+	s.Equal(pool.endpoints[1], pool.pick(map[*endpoint]struct{}{}))
+
+	// ejected endpoint is skipped
+	pool.eject(context.Background(), pool.endpoints[1], nil)
+	s.Equal(pool.endpoints[2], pool.pick(map[*endpoint]struct{}{}))
+
+	// tried endpoints are skipped, ejected ones are picked only if no other choice
+	s.Equal(pool.endpoints[1], pool.pick(map[*endpoint]struct{}{
+		pool.endpoints[0]: {},
+		pool.endpoints[2]: {},
+	}))
+	s.Nil(pool.pick(map[*endpoint]struct{}{
+		pool.endpoints[0]: {},
+		pool.endpoints[1]: {},
+		pool.endpoints[2]: {},
+	}))
+
+	// endpoints not ready are picked only if no ready one
+	pool.setReady(map[string]struct{}{"c:19530": {}})
+	s.Equal(pool.endpoints[2], pool.pick(map[*endpoint]struct{}{}))
+	s.Equal(pool.endpoints[1], pool.pick(map[*endpoint]struct{}{
+		pool.endpoints[2]: {},
+	}))
+}
+
+func (s *BalancerSuite) TestConnectFailure() {
+	countPools := func() int {
+		count := 0
+		endpointPools.Range(func(_, _ interface{}) bool {
+			count++
+			return true
+		})
+		return count
+	}
+	pools := countPools()
+	for _, ep := range s.endpoints {
+		ep.svr.Stop()
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	endpoints := make([]string, 0, len(s.endpoints))
+	for _, ep := range s.endpoints {
+		endpoints = append(endpoints, ep.addr)
+	}
+	_, err := NewClient(ctx, Config{
+		Endpoints: endpoints,
+		DialOptions: []grpc.DialOption{
+			grpc.WithBlock(),
+			grpc.WithInsecure(),
+			grpc.WithContextDialer(s.dialer),
+		},
+	})
+	s.Error(err)
+	// endpoint pool of failed client is released
+	s.Equal(pools, countPools())
+}
+
+func TestBalancer(t *testing.T) {
+	suite.Run(t, new(BalancerSuite))
+}
+
+func TestConfigEndpoints(t *testing.T) {
+	c := &Config{
+		Address:   "https://localhost:19530/db",
+		Endpoints: []string{"localhost:19531", "http://localhost:19532"},
+	}
+	assert.NoError(t, c.parse())
+	assert.Equal(t, []string{"localhost:19530", "localhost:19531", "localhost:19532"}, c.getParsedEndpoints())
+	assert.Equal(t, "localhost:19530", c.getParsedAddress())
+	assert.Equal(t, "db", c.DBName)
+	assert.True(t, c.EnableTLSAuth)
+
+	// address can be omitted when endpoints provided
+	c = &Config{Endpoints: []string{"localhost:19531", "localhost:19532"}}
+	assert.NoError(t, c.parse())
+	assert.Equal(t, "localhost:19531", c.getParsedAddress())
+
+	c = &Config{Endpoints: []string{"localhost:19531", "https://localhost:port"}}
+	assert.Error(t, c.parse())
+}
@@ -14,8 +14,10 @@ package client
 
 import (
 	"context"
+	"fmt"
 	"time"
 
+	"github.com/apache/arrow/go/v12/arrow"
 	"google.golang.org/grpc"
 
 	"github.com/milvus-io/milvus-sdk-go/v2/entity"
@@ -37,6 +39,9 @@ type Client interface {
 	// 2. goroutine B call UsingDatabase(ctx, "DB2").
 	// 3. goroutine A access DB2 after 2.
 	UsingDatabase(ctx context.Context, dbName string) error
+	// WithDatabase returns a Client using dbName, which shares the connection with this one.
+	// The database of this client is left unchanged.
+	WithDatabase(dbName string) Client
 
 	// -- database --
 	// ListDatabases list all database in milvus cluster.
@@ -68,6 +73,10 @@ type Client interface {
 	RenameCollection(ctx context.Context, collName, newName string) error
 	// AlterCollection changes collection attributes.
 	AlterCollection(ctx context.Context, collName string, attrs ...entity.CollectionAttribute) error
+	// InvalidateCollection removes the cached meta of the collection.
+	// It is added along with the per client meta cache, which replaces the package level MetaCache,
+	// implementations of Client outside this package need to add it.
+	InvalidateCollection(ctx context.Context, collName string) error
 
 	// CreateAlias creates an alias for collection
 	CreateAlias(ctx context.Context, collName string, alias string) error
@@ -131,6 +140,10 @@ type Client interface {
 	DeleteByPks(ctx context.Context, collName string, partitionName string, ids entity.Column) error
 	// Upsert column-based data of collection, returns id column values
 	Upsert(ctx context.Context, collName string, partitionName string, columns ...entity.Column) (entity.Column, error)
+	// InsertArrow inserts arrow record into collection, returns id column values
+	InsertArrow(ctx context.Context, collName string, partitionName string, record arrow.Record) (entity.Column, error)
+	// UpsertArrow upserts arrow record into collection, returns id column values
+	UpsertArrow(ctx context.Context, collName string, partitionName string, record arrow.Record) (entity.Column, error)
 	// Search search with bool expression
 	Search(ctx context.Context, collName string, partitions []string,
 		expr string, outputFields []string, vectors []entity.Vector, vectorField string, metricType entity.MetricType, topK int, sp entity.SearchParam, opts ...SearchQueryOptionFunc) ([]SearchResult, error)
@@ -176,10 +189,18 @@ type Client interface {
 	ListRoles(ctx context.Context) ([]entity.Role, error)
 	// ListUsers lists the user objects in system.
 	ListUsers(ctx context.Context) ([]entity.User, error)
-	// Grant adds object privileged for role.
-	Grant(ctx context.Context, role string, objectType entity.PriviledgeObjectType, object string) error
-	// Revoke removes privilege from role.
-	Revoke(ctx context.Context, role string, objectType entity.PriviledgeObjectType, object string) error
+	// SelectUser returns the user with the names of roles assigned to it.
+	SelectUser(ctx context.Context, username string) (entity.User, error)
+	// SelectRole returns the role with the names of users assigned to it.
+	SelectRole(ctx context.Context, role string) (entity.Role, error)
+	// Grant adds the privilege on object for role.
+	Grant(ctx context.Context, role string, objectType entity.PriviledgeObjectType, object string, privilege entity.Privilege) error
+	// Revoke removes the privilege on object from role.
+	Revoke(ctx context.Context, role string, objectType entity.PriviledgeObjectType, object string, privilege entity.Privilege) error
+	// ListGrants lists all the privileges granted to role.
+	ListGrants(ctx context.Context, role string) ([]entity.Grant, error)
+	// SelectGrant lists the privileges granted to role on the object.
+	SelectGrant(ctx context.Context, role string, objectType entity.PriviledgeObjectType, object string) ([]entity.Grant, error)
 
 	// GetLoadingProgress get the collection or partitions loading progress
 	GetLoadingProgress(ctx context.Context, collectionName string, partitionNames []string) (int64, error)
@@ -228,6 +249,108 @@ func (rs ResultSet) GetColumn(fieldName string) entity.Column {
 	return nil
 }
 
+// Len returns the row count of result set.
+func (rs ResultSet) Len() int {
+	if len(rs) == 0 {
+		return 0
+	}
+	return rs[0].Len()
+}
+
+// sliceable returns column as entity.SliceableColumn, which columns implemented outside entity may not be.
+func sliceable(column entity.Column) (entity.SliceableColumn, error) {
+	c, ok := column.(entity.SliceableColumn)
+	if !ok {
+		return nil, fmt.Errorf("column %s of type %T does not support row selection", column.Name(), column)
+	}
+	return c, nil
+}
+
+// Slice returns result set of rows in [begin, end), the columns share memory with rs.
+// It panics if any column does not implement entity.SliceableColumn.
+func (rs ResultSet) Slice(begin, end int) ResultSet {
+	result := make(ResultSet, 0, len(rs))
+	for _, column := range rs {
+		c, err := sliceable(column)
+		if err != nil {
+			panic(err)
+		}
+		result = append(result, c.Slice(begin, end))
+	}
+	return result
+}
+
+// Take returns result set of rows at indices, which could be used to reorder rows.
+func (rs ResultSet) Take(indices []int) (ResultSet, error) {
+	result := make(ResultSet, 0, len(rs))
+	for _, column := range rs {
+		c, err := sliceable(column)
+		if err != nil {
+			return nil, err
+		}
+		taken, err := c.Take(indices)
+		if err != nil {
+			return nil, fmt.Errorf("failed to take column %s: %w", column.Name(), err)
+		}
+		result = append(result, taken)
+	}
+	return result, nil
+}
+
+// Filter returns result set of rows which keep returns true, keep is evaluated once per row.
+// It panics if any column does not implement entity.SliceableColumn.
+func (rs ResultSet) Filter(keep func(idx int) bool) ResultSet {
+	indices := make([]int, 0, rs.Len())
+	for i := 0; i < rs.Len(); i++ {
+		if keep(i) {
+			indices = append(indices, i)
+		}
+	}
+	// indices are always in range, error means column not sliceable
+	result, err := rs.Take(indices)
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+// Concat returns result set of rows in rs followed by rows in other, columns are matched by name.
+// Empty rs returns other, so paged query results could be accumulated from nil ResultSet.
+func (rs ResultSet) Concat(other ResultSet) (ResultSet, error) {
+	if len(rs) == 0 {
+		return append(ResultSet(nil), other...), nil
+	}
+	if len(rs) != len(other) {
+		return nil, fmt.Errorf("result set column count not match, %d vs %d", len(rs), len(other))
+	}
+	result := make(ResultSet, 0, len(rs))
+	for _, column := range rs {
+		otherColumn := other.GetColumn(column.Name())
+		if otherColumn == nil {
+			return nil, fmt.Errorf("column %s not found in result set to concat", column.Name())
+		}
+		c, err := sliceable(column)
+		if err != nil {
+			return nil, err
+		}
+		merged, err := c.Concat(otherColumn)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, merged)
+	}
+	return result, nil
+}
+
+// GetColumnOf returns the scalar column with provided field name as typed column.
+func GetColumnOf[T entity.ScalarType](rs ResultSet, fieldName string) (*entity.ColumnOf[T], error) {
+	column := rs.GetColumn(fieldName)
+	if column == nil {
+		return nil, fmt.Errorf("column %s not found in result set", fieldName)
+	}
+	return entity.ColumnAs[T](column)
+}
+
 // Check if GrpcClient implement Client.
 var _ Client = &GrpcClient{}
 
@@ -240,18 +363,37 @@ func NewClient(ctx context.Context, config Config) (Client, error) {
 
 	c := &GrpcClient{
 		config: &config,
+		cache:  newMetaCache(config.MetaCacheTTL),
+		done:   make(chan struct{}),
 	}
 
 	// Parse remote address.
 	addr := c.config.getParsedAddress()
 
 	// Parse grpc options
-	options := c.config.getDialOption()
+	options := append([]grpc.DialOption{
+		grpc.WithChainUnaryInterceptor(c.closedInterceptor()),
+	}, c.config.getDialOption(c.currentDatabase)...)
+
+	// Balance requests over all endpoints if more than one provided.
+	if endpoints := c.config.getParsedEndpoints(); len(endpoints) > 1 {
+		c.endpoints = newEndpointPool(c.config, endpoints, c.handshake)
+		addr = c.endpoints.target()
+		options = append(options, c.endpoints.dialOptions()...)
+	}
 
 	// Connect the grpc server.
+	if config.DialTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, config.DialTimeout)
+		defer cancel()
+	}
 	if err := c.connect(ctx, addr, options...); err != nil {
+		// release the connection and endpoint pool of failed client
+		c.Close()
 		return nil, err
 	}
+	go c.supervise(c.Conn, c.Conn.GetState())
 
 	return c, nil
 }
@@ -78,7 +78,9 @@ func (s *MockSuiteBase) TearDownTest() {
 }
 
 func (s *MockSuiteBase) resetMock() {
-	MetaCache.reset()
+	if s.client != nil {
+		s.client.(*GrpcClient).cache.reset()
+	}
 	if s.mock != nil {
 		s.mock.Calls = nil
 		s.mock.ExpectedCalls = nil
@@ -295,6 +297,7 @@ type TestInjection func(context.Context, proto.Message) (proto.Message, error)
 
 // mock Milvus Server
 type MockServer struct {
+	server.UnimplementedMilvusServiceServer
 	sync.RWMutex
 	Injections map[ServiceMethod]TestInjection
 }
@@ -111,11 +111,12 @@ func testClient(ctx context.Context, t *testing.T) Client {
 }
 
 func TestHandleRespStatus(t *testing.T) {
-	assert.NotNil(t, handleRespStatus(nil))
-	assert.Nil(t, handleRespStatus(&common.Status{
+	ctx := context.Background()
+	assert.NotNil(t, handleRespStatus(ctx, "coll", nil))
+	assert.Nil(t, handleRespStatus(ctx, "coll", &common.Status{
 		ErrorCode: common.ErrorCode_Success,
 	}))
-	assert.NotNil(t, handleRespStatus(&common.Status{
+	assert.NotNil(t, handleRespStatus(ctx, "coll", &common.Status{
 		ErrorCode: common.ErrorCode_UnexpectedError,
 	}))
 }
@@ -149,6 +150,7 @@ func TestGrpcClientNil(t *testing.T) {
 			mt := m.Type                                   // type of function
 			if m.Name == "Close" || m.Name == "Connect" || // skip connect & close
 				m.Name == "UsingDatabase" || // skip use database
+				m.Name == "WithDatabase" || // skip database view
 				m.Name == "Search" || // type alias MetricType treated as string
 				m.Name == "CalcDistance" ||
 				m.Name == "ManualCompaction" || // time.Duration hard to detect in reflect
@@ -173,7 +175,7 @@ func TestGrpcClientNil(t *testing.T) {
 
 				switch inT.Kind() {
 				case reflect.String: // pass empty
-					ins = append(ins, reflect.ValueOf(""))
+					ins = append(ins, reflect.Zero(inT))
 				case reflect.Int:
 					ins = append(ins, reflect.ValueOf(0))
 				case reflect.Int64:
@@ -192,6 +194,8 @@ func TestGrpcClientNil(t *testing.T) {
 						ins = append(ins, reflect.ValueOf(&ValidStruct{}))
 					case inT.Implements(colType):
 						ins = append(ins, reflect.ValueOf(entity.NewColumnInt64("id", []int64{})))
+					default:
+						ins = append(ins, reflect.Zero(inT))
 					}
 				default:
 					ins = append(ins, reflect.Zero(inT))
@@ -335,3 +339,93 @@ func randStr(n int) string {
 
 	return sb.String()
 }
+
+func TestGetColumnOf(t *testing.T) {
+	rs := ResultSet{
+		entity.NewColumnInt64("id", []int64{1, 2}),
+		entity.NewColumnVarChar("name", []string{"a", "b"}),
+	}
+
+	ids, err := GetColumnOf[int64](rs, "id")
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{1, 2}, ids.Data())
+
+	names, err := GetColumnOf[string](rs, "name")
+	assert.NoError(t, err)
+	assert.Equal(t, "b", names.Value(1))
+
+	_, err = GetColumnOf[string](rs, "id")
+	assert.Error(t, err)
+
+	_, err = GetColumnOf[int64](rs, "not_exist")
+	assert.Error(t, err)
+}
+
+func TestResultSetOperations(t *testing.T) {
+	rs := ResultSet{
+		entity.NewColumnInt64("id", []int64{1, 2, 3, 4}),
+		entity.NewColumnVarChar("name", []string{"a", "b", "c", "d"}),
+	}
+	assert.Equal(t, 4, rs.Len())
+	assert.Equal(t, 0, ResultSet(nil).Len())
+
+	sliced := rs.Slice(1, 3)
+	assert.Equal(t, 2, sliced.Len())
+	ids, err := GetColumnOf[int64](sliced, "id")
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{2, 3}, ids.Data())
+
+	taken, err := rs.Take([]int{3, 1})
+	assert.NoError(t, err)
+	names, err := GetColumnOf[string](taken, "name")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"d", "b"}, names.Data())
+	_, err = rs.Take([]int{4})
+	assert.Error(t, err)
+
+	calls := 0
+	filtered := rs.Filter(func(idx int) bool {
+		calls++
+		return idx%2 == 0
+	})
+	assert.Equal(t, 4, calls)
+	names, err = GetColumnOf[string](filtered, "name")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "c"}, names.Data())
+
+	var all ResultSet
+	for _, page := range []ResultSet{rs.Slice(0, 2), rs.Slice(2, 4)} {
+		all, err = all.Concat(page)
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, 4, all.Len())
+	ids, err = GetColumnOf[int64](all, "id")
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{1, 2, 3, 4}, ids.Data())
+
+	// reordered columns are matched by name
+	merged, err := rs.Concat(ResultSet{rs[1], rs[0]})
+	assert.NoError(t, err)
+	assert.Equal(t, 8, merged.Len())
+
+	_, err = rs.Concat(ResultSet{rs[0]})
+	assert.Error(t, err)
+	_, err = rs.Concat(ResultSet{rs[0], entity.NewColumnVarChar("other", nil)})
+	assert.Error(t, err)
+	_, err = rs.Concat(ResultSet{rs[0], entity.NewColumnInt64("name", nil)})
+	assert.Error(t, err)
+
+	// columns implemented outside entity may not support row selection
+	custom := ResultSet{rs[0], plainColumn{rs[1]}}
+	_, err = custom.Take([]int{0})
+	assert.Error(t, err)
+	_, err = ResultSet{plainColumn{rs[0]}}.Concat(ResultSet{rs[0]})
+	assert.Error(t, err)
+	assert.Panics(t, func() { custom.Slice(0, 1) })
+	assert.Panics(t, func() { custom.Filter(func(int) bool { return true }) })
+}
+
+// plainColumn hides the row selection methods of column.
+type plainColumn struct {
+	entity.Column
+}
@@ -28,24 +28,22 @@ import (
 // handles response status
 // if status is nil returns ErrStatusNil
 // if status.ErrorCode is common.ErrorCode_Success, returns nil
-// otherwise, try use Reason into ErrServiceFailed
-// if Reason is empty, returns ErrServiceFailed with default string
-func handleRespStatus(status *common.Status) error {
+// otherwise, returns *ServerError with the method, collection and request id of the request
+func handleRespStatus(ctx context.Context, collection string, status *common.Status) error {
 	if status == nil {
 		return ErrStatusNil
 	}
 	if status.ErrorCode != common.ErrorCode_Success {
-		if status.GetReason() != "" {
-			return ErrServiceFailed(errors.New(status.GetReason()))
-		}
-		return ErrServiceFailed(errors.New("Service failed"))
+		return newServerError(ctx, collection, status)
 	}
 	return nil
 }
 
 // ListCollections list collections from connection
 // Note that schema info are not provided in collection list
-func (c *GrpcClient) ListCollections(ctx context.Context) ([]*entity.Collection, error) {
+func (c *GrpcClient) ListCollections(ctx context.Context) (_ []*entity.Collection, err error) {
+	ctx, span := c.startSpan(ctx, "ListCollections")
+	defer func() { endSpan(span, err) }()
 	if c.Service == nil {
 		return []*entity.Collection{}, ErrClientNotReady
 	}
@@ -57,7 +55,7 @@ func (c *GrpcClient) ListCollections(ctx context.Context) ([]*entity.Collection,
 	if err != nil {
 		return []*entity.Collection{}, err
 	}
-	err = handleRespStatus(resp.GetStatus())
+	err = handleRespStatus(ctx, "", resp.GetStatus())
 	if err != nil {
 		return []*entity.Collection{}, err
 	}
@@ -76,20 +74,23 @@ func (c *GrpcClient) ListCollections(ctx context.Context) ([]*entity.Collection,
 }
 
 // CreateCollection create collection with specified schema
-func (c *GrpcClient) CreateCollection(ctx context.Context, collSchema *entity.Schema, shardNum int32, opts ...CreateCollectionOption) error {
+func (c *GrpcClient) CreateCollection(ctx context.Context, collSchema *entity.Schema, shardNum int32, opts ...CreateCollectionOption) (err error) {
+	ctx, span := c.startSpan(ctx, "CreateCollection")
+	defer func() { endSpan(span, err) }()
 	if c.Service == nil {
 		return ErrClientNotReady
 	}
 	if err := validateSchema(collSchema); err != nil {
 		return err
 	}
+	setSpanAttributes(ctx, AttrCollection.String(collSchema.CollectionName))
 
 	has, err := c.HasCollection(ctx, collSchema.CollectionName)
 	if err != nil {
 		return err
 	}
 	if has {
-		return fmt.Errorf("collection %s already exist", collSchema.CollectionName)
+		return fmt.Errorf("collection %s %w", collSchema.CollectionName, ErrAlreadyExists)
 	}
 	sch := collSchema.ProtoMessage()
 	bs, err := proto.Marshal(sch)
@@ -114,7 +115,7 @@ func (c *GrpcClient) CreateCollection(ctx context.Context, collSchema *entity.Sc
 	if err != nil {
 		return err
 	}
-	err = handleRespStatus(resp)
+	err = handleRespStatus(ctx, collSchema.CollectionName, resp)
 	if err != nil {
 		return err
 	}
@@ -151,7 +152,16 @@ func validateSchema(sch *entity.Schema) error {
 			}
 			autoID = true
 		}
-		if field.DataType == entity.FieldTypeFloatVector || field.DataType == entity.FieldTypeBinaryVector {
+		if field.PrimaryKey && (field.Nullable || field.DefaultValue != nil) {
+			return errors.New("primary key cannot be nullable or have default value")
+		}
+		if field.Nullable && !isNullableField(field.DataType) {
+			return fmt.Errorf("field %s of type %s cannot be nullable", field.Name, field.DataType.Name())
+		}
+		if field.DefaultValue != nil && field.ProtoMessage().GetDefaultValue() == nil {
+			return fmt.Errorf("default value type %T not match field %s data type %s", field.DefaultValue, field.Name, field.DataType.Name())
+		}
+		if isVectorField(field.DataType) {
 			vectors++
 		}
 	}
@@ -161,6 +171,28 @@ func validateSchema(sch *entity.Schema) error {
 	return nil
 }
 
+// isVectorField returns whether the field type is one of the vector types.
+func isVectorField(dataType entity.FieldType) bool {
+	switch dataType {
+	case entity.FieldTypeFloatVector, entity.FieldTypeBinaryVector,
+		entity.FieldTypeFloat16Vector, entity.FieldTypeBFloat16Vector, entity.FieldTypeSparseFloatVector:
+		return true
+	default:
+		return false
+	}
+}
+
+// isNullableField returns whether the columns of field type could carry null values.
+func isNullableField(dataType entity.FieldType) bool {
+	switch dataType {
+	case entity.FieldTypeBool, entity.FieldTypeInt8, entity.FieldTypeInt16, entity.FieldTypeInt32, entity.FieldTypeInt64,
+		entity.FieldTypeFloat, entity.FieldTypeDouble, entity.FieldTypeString, entity.FieldTypeVarChar:
+		return true
+	default:
+		return false
+	}
+}
+
 func (c *GrpcClient) checkCollectionExists(ctx context.Context, collName string) error {
 	has, err := c.HasCollection(ctx, collName)
 	if err != nil {
@@ -173,7 +205,9 @@ func (c *GrpcClient) checkCollectionExists(ctx context.Context, collName string)
 }
 
 // DescribeCollection describe the collection by name
-func (c *GrpcClient) DescribeCollection(ctx context.Context, collName string) (*entity.Collection, error) {
+func (c *GrpcClient) DescribeCollection(ctx context.Context, collName string) (_ *entity.Collection, err error) {
+	ctx, span := c.startSpan(ctx, "DescribeCollection", AttrCollection.String(collName))
+	defer func() { endSpan(span, err) }()
 	if c.Service == nil {
 		return nil, ErrClientNotReady
 	}
@@ -184,7 +218,7 @@ func (c *GrpcClient) DescribeCollection(ctx context.Context, collName string) (*
 	if err != nil {
 		return nil, err
 	}
-	err = handleRespStatus(resp.GetStatus())
+	err = c.handleCollectionRespStatus(ctx, collName, resp.GetStatus())
 	if err != nil {
 		return nil, err
 	}
@@ -204,12 +238,14 @@ func (c *GrpcClient) DescribeCollection(ctx context.Context, collName string) (*
 		Schema:           collection.Schema,
 		ConsistencyLevel: collection.ConsistencyLevel,
 	}
-	MetaCache.setCollectionInfo(collName, &colInfo)
+	c.cache.setCollectionInfo(c.metaKey(ctx, collName), &colInfo)
 	return collection, nil
 }
 
 // DropCollection drop collection by name
-func (c *GrpcClient) DropCollection(ctx context.Context, collName string) error {
+func (c *GrpcClient) DropCollection(ctx context.Context, collName string) (err error) {
+	ctx, span := c.startSpan(ctx, "DropCollection", AttrCollection.String(collName))
+	defer func() { endSpan(span, err) }()
 	if c.Service == nil {
 		return ErrClientNotReady
 	}
@@ -224,15 +260,17 @@ func (c *GrpcClient) DropCollection(ctx context.Context, collName string) error
 	if err != nil {
 		return err
 	}
-	err = handleRespStatus(resp)
+	err = handleRespStatus(ctx, collName, resp)
 	if err == nil {
-		MetaCache.setCollectionInfo(collName, nil)
+		c.invalidateCollection(ctx, collName)
 	}
 	return err
 }
 
 // HasCollection check whether collection name exists
-func (c *GrpcClient) HasCollection(ctx context.Context, collName string) (bool, error) {
+func (c *GrpcClient) HasCollection(ctx context.Context, collName string) (_ bool, err error) {
+	ctx, span := c.startSpan(ctx, "HasCollection", AttrCollection.String(collName))
+	defer func() { endSpan(span, err) }()
 	if c.Service == nil {
 		return false, ErrClientNotReady
 	}
@@ -247,14 +285,16 @@ func (c *GrpcClient) HasCollection(ctx context.Context, collName string) (bool,
 	if err != nil {
 		return false, err
 	}
-	if err := handleRespStatus(resp.GetStatus()); err != nil {
+	if err := handleRespStatus(ctx, collName, resp.GetStatus()); err != nil {
 		return false, err
 	}
 	return resp.GetValue(), nil
 }
 
 // GetCollectionStatistcis show collection statistics
-func (c *GrpcClient) GetCollectionStatistics(ctx context.Context, collName string) (map[string]string, error) {
+func (c *GrpcClient) GetCollectionStatistics(ctx context.Context, collName string) (_ map[string]string, err error) {
+	ctx, span := c.startSpan(ctx, "GetCollectionStatistics", AttrCollection.String(collName))
+	defer func() { endSpan(span, err) }()
 	if c.Service == nil {
 		return nil, ErrClientNotReady
 	}
@@ -270,14 +310,16 @@ func (c *GrpcClient) GetCollectionStatistics(ctx context.Context, collName strin
 	if err != nil {
 		return nil, err
 	}
-	if err := handleRespStatus(resp.GetStatus()); err != nil {
+	if err := handleRespStatus(ctx, collName, resp.GetStatus()); err != nil {
 		return nil, err
 	}
 	return entity.KvPairsMap(resp.GetStats()), nil
 }
 
 // ShowCollection show collection status, used to check whether it is loaded or not
-func (c *GrpcClient) ShowCollection(ctx context.Context, collName string) (*entity.Collection, error) {
+func (c *GrpcClient) ShowCollection(ctx context.Context, collName string) (_ *entity.Collection, err error) {
+	ctx, span := c.startSpan(ctx, "ShowCollection", AttrCollection.String(collName))
+	defer func() { endSpan(span, err) }()
 	if c.Service == nil {
 		return nil, ErrClientNotReady
 	}
@@ -294,7 +336,7 @@ func (c *GrpcClient) ShowCollection(ctx context.Context, collName string) (*enti
 	if err != nil {
 		return nil, err
 	}
-	if err := handleRespStatus(resp.GetStatus()); err != nil {
+	if err := handleRespStatus(ctx, collName, resp.GetStatus()); err != nil {
 		return nil, err
 	}
 
@@ -308,7 +350,9 @@ func (c *GrpcClient) ShowCollection(ctx context.Context, collName string) (*enti
 }
 
 // RenameCollection performs renaming for provided collection.
-func (c *GrpcClient) RenameCollection(ctx context.Context, collName, newName string) error {
+func (c *GrpcClient) RenameCollection(ctx context.Context, collName, newName string) (err error) {
+	ctx, span := c.startSpan(ctx, "RenameCollection", AttrCollection.String(collName))
+	defer func() { endSpan(span, err) }()
 	if c.Service == nil {
 		return ErrClientNotReady
 	}
@@ -325,11 +369,18 @@ func (c *GrpcClient) RenameCollection(ctx context.Context, collName, newName str
 	if err != nil {
 		return err
 	}
-	return handleRespStatus(resp)
+	if err := handleRespStatus(ctx, collName, resp); err != nil {
+		return err
+	}
+	c.invalidateCollection(ctx, collName)
+	c.invalidateCollection(ctx, newName)
+	return nil
 }
 
 // LoadCollection load collection into memory
-func (c *GrpcClient) LoadCollection(ctx context.Context, collName string, async bool, opts ...LoadCollectionOption) error {
+func (c *GrpcClient) LoadCollection(ctx context.Context, collName string, async bool, opts ...LoadCollectionOption) (err error) {
+	ctx, span := c.startSpan(ctx, "LoadCollection", AttrCollection.String(collName))
+	defer func() { endSpan(span, err) }()
 	if c.Service == nil {
 		return ErrClientNotReady
 	}
@@ -351,7 +402,7 @@ func (c *GrpcClient) LoadCollection(ctx context.Context, collName string, async
 	if err != nil {
 		return err
 	}
-	if err := handleRespStatus(resp); err != nil {
+	if err := handleRespStatus(ctx, collName, resp); err != nil {
 		return err
 	}
 
@@ -378,7 +429,9 @@ func (c *GrpcClient) LoadCollection(ctx context.Context, collName string, async
 }
 
 // ReleaseCollection release loaded collection
-func (c *GrpcClient) ReleaseCollection(ctx context.Context, collName string) error {
+func (c *GrpcClient) ReleaseCollection(ctx context.Context, collName string) (err error) {
+	ctx, span := c.startSpan(ctx, "ReleaseCollection", AttrCollection.String(collName))
+	defer func() { endSpan(span, err) }()
 	if c.Service == nil {
 		return ErrClientNotReady
 	}
@@ -394,11 +447,13 @@ func (c *GrpcClient) ReleaseCollection(ctx context.Context, collName string) err
 	if err != nil {
 		return err
 	}
-	return handleRespStatus(resp)
+	return handleRespStatus(ctx, collName, resp)
 }
 
 // GetReplicas gets the replica groups as well as their querynodes and shards information
-func (c *GrpcClient) GetReplicas(ctx context.Context, collName string) ([]*entity.ReplicaGroup, error) {
+func (c *GrpcClient) GetReplicas(ctx context.Context, collName string) (_ []*entity.ReplicaGroup, err error) {
+	ctx, span := c.startSpan(ctx, "GetReplicas", AttrCollection.String(collName))
+	defer func() { endSpan(span, err) }()
 	if c.Service == nil {
 		return nil, ErrClientNotReady
 	}
@@ -416,7 +471,7 @@ func (c *GrpcClient) GetReplicas(ctx context.Context, collName string) ([]*entit
 	if err != nil {
 		return nil, err
 	}
-	if err = handleRespStatus(resp.GetStatus()); err != nil {
+	if err = handleRespStatus(ctx, collName, resp.GetStatus()); err != nil {
 		return nil, err
 	}
 
@@ -441,7 +496,9 @@ func (c *GrpcClient) GetReplicas(ctx context.Context, collName string) ([]*entit
 }
 
 // GetLoadingProgress get the collection or partitions loading progress
-func (c *GrpcClient) GetLoadingProgress(ctx context.Context, collName string, partitionNames []string) (int64, error) {
+func (c *GrpcClient) GetLoadingProgress(ctx context.Context, collName string, partitionNames []string) (_ int64, err error) {
+	ctx, span := c.startSpan(ctx, "GetLoadingProgress", AttrCollection.String(collName), partitionCount(partitionNames...))
+	defer func() { endSpan(span, err) }()
 	if c.Service == nil {
 		return 0, ErrClientNotReady
 	}
@@ -462,7 +519,9 @@ func (c *GrpcClient) GetLoadingProgress(ctx context.Context, collName string, pa
 }
 
 // GetLoadState get the collection or partitions load state
-func (c *GrpcClient) GetLoadState(ctx context.Context, collName string, partitionNames []string) (entity.LoadState, error) {
+func (c *GrpcClient) GetLoadState(ctx context.Context, collName string, partitionNames []string) (_ entity.LoadState, err error) {
+	ctx, span := c.startSpan(ctx, "GetLoadState", AttrCollection.String(collName), partitionCount(partitionNames...))
+	defer func() { endSpan(span, err) }()
 	if c.Service == nil {
 		return 0, ErrClientNotReady
 	}
@@ -483,7 +542,9 @@ func (c *GrpcClient) GetLoadState(ctx context.Context, collName string, partitio
 }
 
 // AlterCollection changes the collection attribute.
-func (c *GrpcClient) AlterCollection(ctx context.Context, collName string, attrs ...entity.CollectionAttribute) error {
+func (c *GrpcClient) AlterCollection(ctx context.Context, collName string, attrs ...entity.CollectionAttribute) (err error) {
+	ctx, span := c.startSpan(ctx, "AlterCollection", AttrCollection.String(collName))
+	defer func() { endSpan(span, err) }()
 	if c.Service == nil {
 		return ErrClientNotReady
 	}
@@ -519,5 +580,5 @@ func (c *GrpcClient) AlterCollection(ctx context.Context, collName string, attrs
 	if err != nil {
 		return err
 	}
-	return handleRespStatus(resp)
+	return handleRespStatus(ctx, collName, resp)
 }
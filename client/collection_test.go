@@ -97,6 +97,18 @@ func (s *CollectionSuite) TestListCollections() {
 			}
 		})
 	}
+
+	s.Run("server_error", func() {
+		s.resetMock()
+		defer s.resetMock()
+		s.mock.EXPECT().ShowCollections(mock.Anything, mock.AnythingOfType("*milvuspb.ShowCollectionsRequest")).
+			Return(&server.ShowCollectionsResponse{Status: &common.Status{ErrorCode: common.ErrorCode_UnexpectedError}}, nil)
+
+		_, err := c.ListCollections(ctx)
+		var serverErr *ServerError
+		s.Require().True(errors.As(err, &serverErr))
+		s.Equal("ShowCollections", serverErr.Method)
+	})
 }
 
 func (s *CollectionSuite) TestCreateCollection() {
@@ -189,6 +201,40 @@ func (s *CollectionSuite) TestCreateCollection() {
 					WithField(entity.NewField().WithName("int64_2").WithDataType(entity.FieldTypeInt64).WithIsAutoID(true)).
 					WithField(entity.NewField().WithName("vector").WithDataType(entity.FieldTypeFloatVector).WithDim(128)),
 			},
+			{
+				name: "nullable_pk",
+				schema: entity.NewSchema().WithName(testCollectionName).
+					WithField(entity.NewField().WithName("int64").WithDataType(entity.FieldTypeInt64).WithIsPrimaryKey(true).WithNullable(true)).
+					WithField(entity.NewField().WithName("vector").WithDataType(entity.FieldTypeFloatVector).WithDim(128)),
+			},
+			{
+				name: "nullable_json",
+				schema: entity.NewSchema().WithName(testCollectionName).
+					WithField(entity.NewField().WithName("int64").WithDataType(entity.FieldTypeInt64).WithIsPrimaryKey(true)).
+					WithField(entity.NewField().WithName("json").WithDataType(entity.FieldTypeJSON).WithNullable(true)).
+					WithField(entity.NewField().WithName("vector").WithDataType(entity.FieldTypeFloatVector).WithDim(128)),
+			},
+			{
+				name: "nullable_array",
+				schema: entity.NewSchema().WithName(testCollectionName).
+					WithField(entity.NewField().WithName("int64").WithDataType(entity.FieldTypeInt64).WithIsPrimaryKey(true)).
+					WithField(entity.NewField().WithName("array").WithDataType(entity.FieldTypeArray).
+						WithElementType(entity.FieldTypeInt64).WithMaxCapacity(16).WithNullable(true)).
+					WithField(entity.NewField().WithName("vector").WithDataType(entity.FieldTypeFloatVector).WithDim(128)),
+			},
+			{
+				name: "nullable_vector",
+				schema: entity.NewSchema().WithName(testCollectionName).
+					WithField(entity.NewField().WithName("int64").WithDataType(entity.FieldTypeInt64).WithIsPrimaryKey(true)).
+					WithField(entity.NewField().WithName("vector").WithDataType(entity.FieldTypeFloatVector).WithDim(128).WithNullable(true)),
+			},
+			{
+				name: "default_value_type_not_match",
+				schema: entity.NewSchema().WithName(testCollectionName).
+					WithField(entity.NewField().WithName("int64").WithDataType(entity.FieldTypeInt64).WithIsPrimaryKey(true)).
+					WithField(entity.NewField().WithName("age").WithDataType(entity.FieldTypeInt32).WithDefaultValue(18)).
+					WithField(entity.NewField().WithName("vector").WithDataType(entity.FieldTypeFloatVector).WithDim(128)),
+			},
 			{
 				name: "bad_pk_type",
 				schema: entity.NewSchema().
@@ -215,7 +261,7 @@ func (s *CollectionSuite) TestCreateCollection() {
 			Return(&server.BoolResponse{Status: &common.Status{}, Value: true}, nil)
 
 		err := c.CreateCollection(ctx, ds, shardsNum)
-		s.Error(err)
+		s.ErrorIs(err, ErrAlreadyExists)
 	})
 
 	s.Run("server_returns_error", func() {
@@ -311,6 +357,79 @@ func (s *CollectionSuite) TestRenameCollection() {
 	})
 }
 
+func (s *CollectionSuite) TestMetaCacheInvalidation() {
+	c := s.client.(*GrpcClient)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	sch := entity.NewSchema().WithName(testCollectionName).
+		WithField(entity.NewField().WithName("ID").WithDataType(entity.FieldTypeInt64).WithIsPrimaryKey(true)).
+		WithField(entity.NewField().WithName("vector").WithDataType(entity.FieldTypeFloatVector).WithDim(testVectorDim))
+	describe := func() {
+		s.mock.EXPECT().DescribeCollection(mock.Anything, &server.DescribeCollectionRequest{CollectionName: testCollectionName}).
+			Return(&server.DescribeCollectionResponse{Status: &common.Status{}, Schema: sch.ProtoMessage()}, nil).Once()
+		_, err := c.DescribeCollection(ctx, testCollectionName)
+		s.Require().NoError(err)
+		_, ok := c.cache.getCollectionInfo(c.metaKey(ctx, testCollectionName))
+		s.Require().True(ok)
+	}
+	cached := func() bool {
+		_, ok := c.cache.getCollectionInfo(c.metaKey(ctx, testCollectionName))
+		return ok
+	}
+
+	s.Run("explicit", func() {
+		defer s.resetMock()
+		describe()
+		s.NoError(c.InvalidateCollection(ctx, testCollectionName))
+		s.False(cached())
+	})
+
+	s.Run("drop", func() {
+		defer s.resetMock()
+		describe()
+		s.mock.EXPECT().HasCollection(mock.Anything, &server.HasCollectionRequest{CollectionName: testCollectionName}).Return(&server.BoolResponse{Status: &common.Status{}, Value: true}, nil)
+		s.mock.EXPECT().DropCollection(mock.Anything, &server.DropCollectionRequest{CollectionName: testCollectionName}).Return(&common.Status{}, nil)
+		s.NoError(c.DropCollection(ctx, testCollectionName))
+		s.False(cached())
+	})
+
+	s.Run("rename", func() {
+		defer s.resetMock()
+		describe()
+		s.mock.EXPECT().HasCollection(mock.Anything, &server.HasCollectionRequest{CollectionName: testCollectionName}).Return(&server.BoolResponse{Status: &common.Status{}, Value: true}, nil)
+		s.mock.EXPECT().RenameCollection(mock.Anything, &server.RenameCollectionRequest{OldName: testCollectionName, NewName: "new_name"}).Return(&common.Status{}, nil)
+		s.NoError(c.RenameCollection(ctx, testCollectionName, "new_name"))
+		s.False(cached())
+	})
+
+	s.Run("schema_mismatch", func() {
+		defer s.resetMock()
+		describe()
+		// session timestamp of earlier writes is kept
+		key := c.metaKey(ctx, testCollectionName)
+		c.cache.setSessionTs(key, 100)
+		defer c.cache.invalidate(key)
+		s.mock.EXPECT().Query(mock.Anything, mock.Anything).
+			Return(&server.QueryResults{Status: &common.Status{ErrorCode: common.ErrorCode_UnexpectedError, Code: codeCollectionSchemaMismatch, Reason: "collection schema mismatch"}}, nil)
+		_, err := c.Query(ctx, testCollectionName, nil, "ID > 0", []string{"ID"})
+		s.Error(err)
+		s.False(cached())
+		ts, ok := c.cache.getSessionTs(key)
+		s.True(ok)
+		s.EqualValues(100, ts)
+	})
+
+	s.Run("other_database", func() {
+		defer s.resetMock()
+		describe()
+		key := c.metaKey(ctx, testCollectionName)
+		key.db = "other"
+		_, ok := c.cache.getCollectionInfo(key)
+		s.False(ok)
+	})
+}
+
 func (s *CollectionSuite) TestAlterCollection() {
 	c := s.client
 	ctx, cancel := context.WithCancel(context.Background())
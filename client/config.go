@@ -1,20 +1,21 @@
 package client
 
 import (
-	"context"
 	"crypto/tls"
 	"fmt"
-	"math"
+	"log/slog"
+	"net"
 	"net/url"
 	"regexp"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/cockroachdb/errors"
-	grpc_retry "github.com/grpc-ecosystem/go-grpc-middleware/retry"
+	"github.com/milvus-io/milvus-sdk-go/v2/entity"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/backoff"
-	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/keepalive"
@@ -43,17 +44,46 @@ var DefaultGrpcOpts = []grpc.DialOption{
 
 // Config for milvus client.
 type Config struct {
-	Address       string // Remote address, "localhost:19530".
-	Username      string // Username for auth.
-	Password      string // Password for auth.
-	DBName        string // DBName for this client.
-	Identifier    string // Identifier for this connection
-	EnableTLSAuth bool   // Enable TLS Auth for transport security.
-	APIKey        string // API key
+	Address           string            // Remote address, "localhost:19530".
+	Endpoints         []string          // Extra remote addresses, client side load balancing is enabled when more than one address is provided.
+	LoadBalancePolicy LoadBalancePolicy // Policy to pick endpoint for each request, PickFirst by default.
+	EjectionDuration  time.Duration     // Duration an unavailable endpoint is ejected from picking, DefaultEjectionDuration by default.
+	Username          string            // Username for auth.
+	Password          string            // Password for auth.
+	DBName            string            // DBName for this client.
+	Identifier        string            // Identifier for this connection
+	EnableTLSAuth     bool              // Enable TLS Auth for transport security.
+	TLS               *TLSConfig        // TLS config for transport security, implies EnableTLSAuth.
+	RetryPolicy       *RetryPolicy      // Retry policy for failed requests, DefaultRetryPolicy() if not provided.
+	MetaCacheTTL      time.Duration     // Time to live of cached collection meta, never expires if not positive.
+	DialTimeout       time.Duration     // Timeout to establish the connection in NewClient, no timeout if not positive.
+	RequestTimeout    time.Duration     // Default timeout for requests without deadline in context, no timeout if not positive.
+	APIKey            string            // API key
+	// KeepAlive overrides the keepalive parameters in DefaultGrpcOpts if provided.
+	KeepAlive *keepalive.ClientParameters
+	// ConsistencyLevel for search and query without consistency level option, overrides the collection's one if provided.
+	ConsistencyLevel *entity.ConsistencyLevel
+	// MethodTimeouts overrides RequestTimeout by grpc method name, e.g. "LoadCollection", "Flush".
+	MethodTimeouts map[string]time.Duration
+	// TracerProvider creates span for each request if provided, trace context is propagated to server.
+	TracerProvider trace.TracerProvider
+	// Metrics records latency and failures of requests if provided, see NewOTelMetrics.
+	Metrics Metrics
+	// Logger writes structured logs of retries, reconnects, slow requests and payload sizes if provided,
+	// log level set by WithDebugLogLevel and similar helpers overrides the handler level for the call.
+	Logger *slog.Logger
+	// SlowRequestThreshold logs requests taking longer than it at warn level, disabled if not positive.
+	SlowRequestThreshold time.Duration
+	// CredentialProvider provides rotating credential for each request, Username/Password/APIKey are ignored if provided.
+	CredentialProvider CredentialProvider
 
 	DialOptions []grpc.DialOption // Dial options for GRPC.
 
-	parsedAddress *url.URL
+	parsedAddress   *url.URL
+	parsedEndpoints []*url.URL
+	identifier      atomic.Value // identifier assigned by server, may be updated by reconnection
+	tlsCreds        credentials.TransportCredentials
+	credentials     *credentialCache
 
 	DisableConn bool
 }
@@ -61,11 +91,34 @@ type Config struct {
 // Copy a new config, dialOption may shared with old config.
 func (c *Config) Copy() Config {
 	newConfig := Config{
-		Address:       c.Address,
-		Username:      c.Username,
-		Password:      c.Password,
-		DBName:        c.DBName,
-		EnableTLSAuth: c.EnableTLSAuth,
+		Address:           c.Address,
+		LoadBalancePolicy: c.LoadBalancePolicy,
+		EjectionDuration:  c.EjectionDuration,
+		Username:          c.Username,
+		Password:          c.Password,
+		DBName:            c.DBName,
+		EnableTLSAuth:     c.EnableTLSAuth,
+		TLS:               c.TLS,
+		RetryPolicy:       c.RetryPolicy,
+		MetaCacheTTL:      c.MetaCacheTTL,
+		DialTimeout:       c.DialTimeout,
+		RequestTimeout:    c.RequestTimeout,
+		KeepAlive:         c.KeepAlive,
+		ConsistencyLevel:  c.ConsistencyLevel,
+
+		CredentialProvider: c.CredentialProvider,
+		TracerProvider:     c.TracerProvider,
+		Metrics:            c.Metrics,
+
+		Logger:               c.Logger,
+		SlowRequestThreshold: c.SlowRequestThreshold,
+	}
+	newConfig.Endpoints = append([]string(nil), c.Endpoints...)
+	if c.MethodTimeouts != nil {
+		newConfig.MethodTimeouts = make(map[string]time.Duration, len(c.MethodTimeouts))
+		for method, timeout := range c.MethodTimeouts {
+			newConfig.MethodTimeouts[method] = timeout
+		}
 	}
 	newConfig.DialOptions = make([]grpc.DialOption, 0, len(c.DialOptions))
 	newConfig.DialOptions = append(newConfig.DialOptions, c.DialOptions...)
@@ -73,30 +126,59 @@ func (c *Config) Copy() Config {
 }
 
 func (c *Config) parse() error {
+	addresses := make([]string, 0, len(c.Endpoints)+1)
+	if c.Address != "" || len(c.Endpoints) == 0 {
+		addresses = append(addresses, c.Address)
+	}
+	addresses = append(addresses, c.Endpoints...)
+
+	c.parsedEndpoints = make([]*url.URL, 0, len(addresses))
+	for _, address := range addresses {
+		remoteURL, err := parseRemoteAddress(address)
+		if err != nil {
+			return err
+		}
+		c.parsedEndpoints = append(c.parsedEndpoints, remoteURL)
+		// Always enable tls auth for https remote url.
+		if remoteURL.Scheme == "https" {
+			c.EnableTLSAuth = true
+		}
+	}
+	if c.TLS != nil {
+		creds, err := newTLSCredentials(*c.TLS)
+		if err != nil {
+			return err
+		}
+		c.EnableTLSAuth = true
+		c.tlsCreds = creds
+	}
+	if c.CredentialProvider != nil {
+		c.credentials = newCredentialCache(c.CredentialProvider)
+	}
+	remoteURL := c.parsedEndpoints[0]
+	// Use DBName in remote url path.
+	if c.DBName == "" {
+		c.DBName = strings.TrimLeft(remoteURL.Path, "/")
+	}
+	c.parsedAddress = remoteURL
+	return nil
+}
+
+func parseRemoteAddress(address string) (*url.URL, error) {
 	// Prepend default fake tcp:// scheme for remote address.
-	address := c.Address
 	if !regexValidScheme.MatchString(address) {
 		address = fmt.Sprintf("tcp://%s", address)
 	}
 
 	remoteURL, err := url.Parse(address)
 	if err != nil {
-		return errors.Wrap(err, "milvus address parse fail")
+		return nil, errors.Wrap(err, "milvus address parse fail")
 	}
 	// Remote Host should never be empty.
 	if remoteURL.Host == "" {
-		return errors.New("empty remote host of milvus address")
+		return nil, errors.New("empty remote host of milvus address")
 	}
-	// Use DBName in remote url path.
-	if c.DBName == "" {
-		c.DBName = strings.TrimLeft(remoteURL.Path, "/")
-	}
-	// Always enable tls auth for https remote url.
-	if remoteURL.Scheme == "https" {
-		c.EnableTLSAuth = true
-	}
-	c.parsedAddress = remoteURL
-	return nil
+	return remoteURL, nil
 }
 
 // Get parsed remote milvus address, should be called after parse was called.
@@ -104,46 +186,79 @@ func (c *Config) getParsedAddress() string {
 	return c.parsedAddress.Host
 }
 
-// useDatabase change the inner db name.
-func (c *Config) useDatabase(dbName string) {
-	c.DBName = dbName
+// Get all parsed remote milvus addresses, should be called after parse was called.
+func (c *Config) getParsedEndpoints() []string {
+	hosts := make([]string, 0, len(c.parsedEndpoints))
+	for _, endpoint := range c.parsedEndpoints {
+		hosts = append(hosts, endpoint.Host)
+	}
+	return hosts
+}
+
+// hostOf returns the host part of address, or the address itself if there is no port.
+func hostOf(address string) string {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return address
+	}
+	return host
 }
 
-// useDatabase change the inner db name.
+// setIdentifier change the identifier assigned by server.
 func (c *Config) setIdentifier(identifier string) {
-	c.Identifier = identifier
+	c.identifier.Store(identifier)
+}
+
+// getIdentifier returns the identifier assigned by server, or the provided one if no handshake performed.
+func (c *Config) getIdentifier() string {
+	if identifier, ok := c.identifier.Load().(string); ok {
+		return identifier
+	}
+	return c.Identifier
 }
 
 // Get parsed grpc dial options, should be called after parse was called.
-func (c *Config) getDialOption() []grpc.DialOption {
+// dbNameGetter returns the database of requests not specifying one in context.
+func (c *Config) getDialOption(dbNameGetter func() string) []grpc.DialOption {
 	options := c.DialOptions
 	if c.DialOptions == nil {
 		// Add default connection options.
 		options = make([]grpc.DialOption, len(DefaultGrpcOpts))
 		copy(options, DefaultGrpcOpts)
 	}
+	if c.KeepAlive != nil {
+		options = append(options, grpc.WithKeepaliveParams(*c.KeepAlive))
+	}
 
 	// Construct dial option.
-	if c.EnableTLSAuth {
+	if c.tlsCreds != nil {
+		options = append(options, grpc.WithTransportCredentials(c.tlsCreds))
+	} else if c.EnableTLSAuth {
 		options = append(options, grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{})))
 	} else {
 		options = append(options, grpc.WithTransportCredentials(insecure.NewCredentials()))
 	}
 
-	options = append(options,
-		grpc.WithChainUnaryInterceptor(grpc_retry.UnaryClientInterceptor(
-			grpc_retry.WithMax(6),
-			grpc_retry.WithBackoff(func(attempt uint) time.Duration {
-				return 60 * time.Millisecond * time.Duration(math.Pow(3, float64(attempt)))
-			}),
-			grpc_retry.WithCodes(codes.Unavailable, codes.ResourceExhausted)),
-			RetryOnRateLimitInterceptor(10, func(ctx context.Context, attempt uint) time.Duration {
-				return 10 * time.Millisecond * time.Duration(math.Pow(3, float64(attempt)))
-			}),
-		))
+	if c.TracerProvider != nil || c.Metrics != nil {
+		options = append(options, grpc.WithChainUnaryInterceptor(telemetryInterceptor(c.TracerProvider, c.Metrics)))
+	}
+	if c.Logger != nil {
+		options = append(options, grpc.WithChainUnaryInterceptor(loggingInterceptor(newClientLogger(c.Logger), c.SlowRequestThreshold)))
+	}
+	options = append(options, grpc.WithChainUnaryInterceptor(serverErrorInterceptor()))
+	options = append(options, grpc.WithChainUnaryInterceptor(timeoutInterceptor(c)))
+
+	retryPolicy := c.RetryPolicy
+	if retryPolicy == nil {
+		retryPolicy = DefaultRetryPolicy()
+	}
+	options = append(options, grpc.WithChainUnaryInterceptor(retryInterceptor(retryPolicy, newClientLogger(c.Logger))))
 
 	options = append(options, grpc.WithChainUnaryInterceptor(
-		createMetaDataUnaryInterceptor(c),
+		createMetaDataUnaryInterceptor(c, dbNameGetter),
 	))
+	if c.credentials != nil {
+		options = append(options, grpc.WithChainUnaryInterceptor(credentialInterceptor(c.credentials)))
+	}
 	return options
 }
@@ -16,6 +16,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"sync/atomic"
 	"time"
 
 	"github.com/cockroachdb/errors"
@@ -28,9 +29,16 @@ import (
 
 // GrpcClient, uses default grpc Service definition to connect with Milvus2.0
 type GrpcClient struct {
-	Conn    *grpc.ClientConn           // grpc connection instance
-	Service server.MilvusServiceClient // Service client stub
-	config  *Config                    // No thread safety
+	Conn      *grpc.ClientConn           // grpc connection instance
+	Service   server.MilvusServiceClient // Service client stub
+	config    *Config                    // No thread safety
+	endpoints *endpointPool              // endpoint pool, only set when multiple endpoints are configured
+	cache     *metaCache                 // collection meta cache owned by this client
+
+	db     atomic.Value  // database used by client, switched by UsingDatabase
+	view   bool          // created by WithDatabase, does not own the connection
+	closed int32         // set to 1 after Close called
+	done   chan struct{} // closed when client is closed, stops the connection supervisor
 }
 
 func (c *GrpcClient) dial(ctx context.Context, addr string, opts ...grpc.DialOption) error {
@@ -61,10 +69,24 @@ func (c *GrpcClient) connect(ctx context.Context, addr string, opts ...grpc.Dial
 }
 
 func (c *GrpcClient) connectInternal(ctx context.Context) error {
-	hostName, err := os.Hostname()
+	if c.endpoints != nil {
+		return c.endpoints.connect(ctx)
+	}
+
+	identifier, err := c.handshake(ctx)
 	if err != nil {
 		return err
 	}
+	c.config.setIdentifier(identifier)
+	return nil
+}
+
+// handshake calls the `Connect` API and returns the identifier assigned by server.
+func (c *GrpcClient) handshake(ctx context.Context) (string, error) {
+	hostName, err := os.Hostname()
+	if err != nil {
+		return "", err
+	}
 
 	req := &server.ConnectRequest{
 		ClientInfo: &common.ClientInfo{
@@ -76,27 +98,40 @@ func (c *GrpcClient) connectInternal(ctx context.Context) error {
 		},
 	}
 
+	ctx = withLastRPC(ctx)
 	resp, err := c.Service.Connect(ctx, req)
 	if err != nil {
 		status, ok := status.FromError(err)
 		if ok {
 			if status.Code() == codes.Unimplemented {
-				return errors.New("this version of sdk is incompatible with server," +
+				return "", errors.New("this version of sdk is incompatible with server," +
 					" please downgrade your sdk or upgrade your server")
 			}
 		}
-		return err
+		return "", err
 	}
-	if err := handleRespStatus(resp.GetStatus()); err != nil {
-		return err
+	if err := handleRespStatus(ctx, "", resp.GetStatus()); err != nil {
+		return "", err
 	}
 
-	c.config.Identifier = strconv.FormatInt(resp.GetIdentifier(), 10)
-	return nil
+	return strconv.FormatInt(resp.GetIdentifier(), 10), nil
 }
 
-// Close close the connection
+// Close close the connection, all requests after Close will return ErrClientClosed.
+// Closing a Client returned by WithDatabase is no-op, the connection is owned by the original one.
 func (c *GrpcClient) Close() error {
+	if c.view {
+		return nil
+	}
+	if !atomic.CompareAndSwapInt32(&c.closed, 0, 1) {
+		return nil
+	}
+	if c.done != nil {
+		close(c.done)
+	}
+	if c.endpoints != nil {
+		c.endpoints.close()
+	}
 	if c.Conn != nil {
 		err := c.Conn.Close()
 		c.Conn = nil
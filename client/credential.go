@@ -0,0 +1,234 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Credential is the credential used to authenticate requests,
+// either the basic Username/Password or the bearer Token is used.
+type Credential struct {
+	Username string
+	Password string
+	Token    string // API key or token, takes precedence over Username/Password.
+	// ExpiresAt is the time the credential shall be refreshed,
+	// zero value means the provider is consulted on each request.
+	ExpiresAt time.Time
+}
+
+// CredentialProvider provides the credential for each request, set in Config to support rotating secrets.
+type CredentialProvider interface {
+	// Credential returns the current credential.
+	Credential(ctx context.Context) (Credential, error)
+	// Invalidate is called once the credential is rejected by server,
+	// a refreshed credential shall be returned by Credential afterwards.
+	Invalidate()
+}
+
+// StaticCredentialProvider provides a fixed credential.
+type StaticCredentialProvider struct {
+	cred Credential
+}
+
+// NewStaticCredentialProvider returns a provider with fixed basic credential.
+func NewStaticCredentialProvider(username, password string) *StaticCredentialProvider {
+	return &StaticCredentialProvider{cred: Credential{Username: username, Password: password}}
+}
+
+// NewStaticTokenProvider returns a provider with fixed token.
+func NewStaticTokenProvider(token string) *StaticCredentialProvider {
+	return &StaticCredentialProvider{cred: Credential{Token: token}}
+}
+
+// Credential implements CredentialProvider.
+func (p *StaticCredentialProvider) Credential(context.Context) (Credential, error) {
+	return p.cred, nil
+}
+
+// Invalidate implements CredentialProvider, fixed credential cannot be refreshed.
+func (p *StaticCredentialProvider) Invalidate() {}
+
+// EnvCredentialProvider reads credential from environment variables on each request.
+type EnvCredentialProvider struct {
+	usernameKey string
+	passwordKey string
+	tokenKey    string
+}
+
+// NewEnvCredentialProvider returns a provider reading credential from provided environment variables,
+// empty key is ignored. Token is used if its variable is set and not empty.
+func NewEnvCredentialProvider(usernameKey, passwordKey, tokenKey string) *EnvCredentialProvider {
+	return &EnvCredentialProvider{usernameKey: usernameKey, passwordKey: passwordKey, tokenKey: tokenKey}
+}
+
+// Credential implements CredentialProvider.
+func (p *EnvCredentialProvider) Credential(context.Context) (Credential, error) {
+	if token := lookupEnv(p.tokenKey); token != "" {
+		return Credential{Token: token}, nil
+	}
+	cred := Credential{Username: lookupEnv(p.usernameKey), Password: lookupEnv(p.passwordKey)}
+	if cred.Username == "" && cred.Password == "" {
+		return cred, errors.Newf("no credential found in environment variables %s/%s/%s", p.usernameKey, p.passwordKey, p.tokenKey)
+	}
+	return cred, nil
+}
+
+// Invalidate implements CredentialProvider, environment variables are read on each request.
+func (p *EnvCredentialProvider) Invalidate() {}
+
+func lookupEnv(key string) string {
+	if key == "" {
+		return ""
+	}
+	return os.Getenv(key)
+}
+
+// FileCredentialProvider reads credential from file, and reloads it once the file is modified.
+// Content of the file is trimmed, "username:password" is used as basic credential, otherwise as token.
+type FileCredentialProvider struct {
+	path string
+
+	mu      sync.Mutex
+	modTime time.Time
+	cred    *Credential
+}
+
+// NewFileCredentialProvider returns a provider reading credential from file in path.
+func NewFileCredentialProvider(path string) (*FileCredentialProvider, error) {
+	p := &FileCredentialProvider{path: path}
+	if _, err := p.Credential(context.Background()); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// Credential implements CredentialProvider.
+// The cached credential is returned if the file is not modified or is in the middle of rotation.
+func (p *FileCredentialProvider) Credential(context.Context) (Credential, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	info, err := os.Stat(p.path)
+	if err == nil && p.cred != nil && info.ModTime().Equal(p.modTime) {
+		return *p.cred, nil
+	}
+	var cred Credential
+	if err == nil {
+		cred, err = p.read()
+	}
+	if err != nil {
+		if p.cred != nil {
+			return *p.cred, nil
+		}
+		return Credential{}, err
+	}
+	p.cred, p.modTime = &cred, info.ModTime()
+	return cred, nil
+}
+
+func (p *FileCredentialProvider) read() (Credential, error) {
+	data, err := os.ReadFile(p.path)
+	if err != nil {
+		return Credential{}, errors.Wrap(err, "failed to read credential file")
+	}
+	content := strings.TrimSpace(string(data))
+	if content == "" {
+		return Credential{}, errors.Newf("empty credential file %s", p.path)
+	}
+	if idx := strings.Index(content, ":"); idx >= 0 {
+		return Credential{Username: content[:idx], Password: content[idx+1:]}, nil
+	}
+	return Credential{Token: content}, nil
+}
+
+// Invalidate implements CredentialProvider, the file is re-read on next request.
+func (p *FileCredentialProvider) Invalidate() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.cred = nil
+}
+
+// credentialCache caches the credential from provider until it expires.
+type credentialCache struct {
+	provider CredentialProvider
+
+	mu   sync.Mutex
+	cred *Credential
+}
+
+func newCredentialCache(provider CredentialProvider) *credentialCache {
+	return &credentialCache{provider: provider}
+}
+
+func (c *credentialCache) get(ctx context.Context) (Credential, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.cred != nil && time.Now().Before(c.cred.ExpiresAt) {
+		return *c.cred, nil
+	}
+	cred, err := c.provider.Credential(ctx)
+	if err != nil {
+		return Credential{}, errors.Wrap(err, "failed to get credential")
+	}
+	c.cred = &cred
+	return cred, nil
+}
+
+func (c *credentialCache) invalidate() {
+	c.mu.Lock()
+	c.cred = nil
+	c.mu.Unlock()
+	c.provider.Invalidate()
+}
+
+// credentialInterceptor appends the credential from cache into context metadata,
+// the request is retried once with refreshed credential if it's rejected as unauthenticated.
+func credentialInterceptor(cache *credentialCache) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		var err error
+		for attempt := 0; attempt < 2; attempt++ {
+			var cred Credential
+			cred, err = cache.get(ctx)
+			if err != nil {
+				return err
+			}
+			err = invoker(withCredential(ctx, cred), method, req, reply, cc, opts...)
+			if status.Code(err) != codes.Unauthenticated {
+				return err
+			}
+			cache.invalidate()
+		}
+		return err
+	}
+}
+
+func withCredential(ctx context.Context, cred Credential) context.Context {
+	if cred.Token != "" {
+		return apiKeyInterceptor(ctx, cred.Token)
+	}
+	return authenticationInterceptor(ctx, cred.Username, cred.Password)
+}
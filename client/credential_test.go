@@ -0,0 +1,198 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/milvus-io/milvus-sdk-go/v2/internal/utils/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type rotatingProvider struct {
+	tokens      []string
+	idx         int
+	calls       int
+	invalidated int
+	ttl         time.Duration
+}
+
+func (p *rotatingProvider) Credential(context.Context) (Credential, error) {
+	p.calls++
+	cred := Credential{Token: p.tokens[p.idx]}
+	if p.ttl > 0 {
+		cred.ExpiresAt = time.Now().Add(p.ttl)
+	}
+	return cred, nil
+}
+
+func (p *rotatingProvider) Invalidate() {
+	p.invalidated++
+	if p.idx < len(p.tokens)-1 {
+		p.idx++
+	}
+}
+
+func authorizationOf(ctx context.Context) string {
+	md, _ := metadata.FromOutgoingContext(ctx)
+	values := md.Get(authorizationHeader)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[len(values)-1]
+}
+
+func TestCredentialInterceptor(t *testing.T) {
+	provider := &rotatingProvider{tokens: []string{"expired", "valid"}}
+	inter := credentialInterceptor(newCredentialCache(provider))
+
+	var received []string
+	invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		auth := authorizationOf(ctx)
+		received = append(received, auth)
+		if auth != crypto.Base64Encode("Bearer: valid") {
+			return status.Error(codes.Unauthenticated, "auth check failure")
+		}
+		return nil
+	}
+
+	// rejected credential refreshed and retried once
+	err := inter(context.Background(), "", nil, nil, nil, invoker)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{crypto.Base64Encode("Bearer: expired"), crypto.Base64Encode("Bearer: valid")}, received)
+	assert.Equal(t, 1, provider.invalidated)
+
+	// provider consulted on each request without expiry
+	calls := provider.calls
+	assert.NoError(t, inter(context.Background(), "", nil, nil, nil, invoker))
+	assert.Equal(t, calls+1, provider.calls)
+
+	// retried only once
+	provider.tokens = []string{"expired"}
+	provider.idx = 0
+	received = nil
+	err = inter(context.Background(), "", nil, nil, nil, invoker)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	assert.Len(t, received, 2)
+}
+
+func TestCredentialCacheExpiry(t *testing.T) {
+	provider := &rotatingProvider{tokens: []string{"token"}, ttl: time.Hour}
+	cache := newCredentialCache(provider)
+
+	for i := 0; i < 3; i++ {
+		cred, err := cache.get(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, "token", cred.Token)
+	}
+	assert.Equal(t, 1, provider.calls)
+
+	cache.invalidate()
+	_, err := cache.get(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, provider.calls)
+}
+
+func TestBasicCredential(t *testing.T) {
+	ctx := withCredential(context.Background(), Credential{Username: "root", Password: "Milvus"})
+	assert.Equal(t, crypto.Base64Encode("root:Milvus"), authorizationOf(ctx))
+
+	cred, err := NewStaticCredentialProvider("root", "Milvus").Credential(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, Credential{Username: "root", Password: "Milvus"}, cred)
+}
+
+func TestEnvCredentialProvider(t *testing.T) {
+	t.Setenv("TEST_MILVUS_USER", "root")
+	t.Setenv("TEST_MILVUS_PASSWORD", "Milvus")
+	provider := NewEnvCredentialProvider("TEST_MILVUS_USER", "TEST_MILVUS_PASSWORD", "TEST_MILVUS_TOKEN")
+
+	cred, err := provider.Credential(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, Credential{Username: "root", Password: "Milvus"}, cred)
+
+	// rotated
+	t.Setenv("TEST_MILVUS_TOKEN", "token")
+	cred, err = provider.Credential(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, Credential{Token: "token"}, cred)
+
+	_, err = NewEnvCredentialProvider("TEST_MILVUS_NOT_EXIST", "", "").Credential(context.Background())
+	assert.Error(t, err)
+}
+
+func TestFileCredentialProvider(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "credential")
+	_, err := NewFileCredentialProvider(path)
+	assert.Error(t, err)
+
+	writeFile(t, path, []byte("root:Milvus\n"))
+	provider, err := NewFileCredentialProvider(path)
+	require.NoError(t, err)
+	cred, err := provider.Credential(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, Credential{Username: "root", Password: "Milvus"}, cred)
+
+	// rotated
+	writeFile(t, path, []byte("token"))
+	future := time.Now().Add(time.Minute)
+	require.NoError(t, os.Chtimes(path, future, future))
+	cred, err = provider.Credential(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, Credential{Token: "token"}, cred)
+
+	// in the middle of rotation, last valid credential is kept
+	require.NoError(t, os.Remove(path))
+	cred, err = provider.Credential(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, Credential{Token: "token"}, cred)
+
+	// invalidated credential is re-read
+	writeFile(t, path, []byte("new_token"))
+	require.NoError(t, os.Chtimes(path, future, future))
+	provider.Invalidate()
+	cred, err = provider.Credential(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, Credential{Token: "new_token"}, cred)
+}
+
+func TestConfigCredentialProvider(t *testing.T) {
+	provider := NewStaticTokenProvider("token")
+	c := &Config{Address: "localhost:19530", Username: "root", Password: "Milvus", CredentialProvider: provider}
+	require.NoError(t, c.parse())
+	require.NotNil(t, c.credentials)
+	assert.Equal(t, provider, c.Copy().CredentialProvider)
+
+	// static credential not appended when provider is set
+	var received context.Context
+	err := createMetaDataUnaryInterceptor(c, func() string { return c.DBName })(context.Background(), "", nil, nil, nil,
+		func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+			received = ctx
+			return nil
+		})
+	require.NoError(t, err)
+	assert.Equal(t, "", authorizationOf(received))
+}
@@ -36,12 +36,14 @@ const (
 
 // Search with bool expression
 func (c *GrpcClient) Search(ctx context.Context, collName string, partitions []string,
-	expr string, outputFields []string, vectors []entity.Vector, vectorField string, metricType entity.MetricType, topK int, sp entity.SearchParam, opts ...SearchQueryOptionFunc) ([]SearchResult, error) {
+	expr string, outputFields []string, vectors []entity.Vector, vectorField string, metricType entity.MetricType, topK int, sp entity.SearchParam, opts ...SearchQueryOptionFunc) (_ []SearchResult, err error) {
+	ctx, span := c.startSpan(ctx, "Search", AttrCollection.String(collName), partitionCount(partitions...), AttrNq.Int(len(vectors)), AttrTopK.Int(topK))
+	defer func() { endSpan(span, err) }()
 	if c.Service == nil {
 		return []SearchResult{}, ErrClientNotReady
 	}
 	var schema *entity.Schema
-	collInfo, ok := MetaCache.getCollectionInfo(collName)
+	collInfo, ok := c.cache.getCollectionInfo(c.metaKey(ctx, collName))
 	if !ok {
 		coll, err := c.DescribeCollection(ctx, collName)
 		if err != nil {
@@ -52,7 +54,7 @@ func (c *GrpcClient) Search(ctx context.Context, collName string, partitions []s
 		schema = collInfo.Schema
 	}
 
-	option, err := makeSearchQueryOption(collName, opts...)
+	option, err := c.makeSearchQueryOption(ctx, collName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -63,11 +65,12 @@ func (c *GrpcClient) Search(ctx context.Context, collName string, partitions []s
 	}
 
 	sr := make([]SearchResult, 0, len(vectors))
+	setConsistencyLevel(ctx, option.ConsistencyLevel)
 	resp, err := c.Service.Search(ctx, req)
 	if err != nil {
 		return nil, err
 	}
-	if err := handleRespStatus(resp.GetStatus()); err != nil {
+	if err := c.handleCollectionRespStatus(ctx, collName, resp.GetStatus()); err != nil {
 		return nil, err
 	}
 	// 3. parse result into result
@@ -151,7 +154,9 @@ func PKs2Expr(backName string, ids entity.Column) string {
 }
 
 // QueryByPks query record by specified primary key(s)
-func (c *GrpcClient) QueryByPks(ctx context.Context, collectionName string, partitionNames []string, ids entity.Column, outputFields []string, opts ...SearchQueryOptionFunc) (ResultSet, error) {
+func (c *GrpcClient) QueryByPks(ctx context.Context, collectionName string, partitionNames []string, ids entity.Column, outputFields []string, opts ...SearchQueryOptionFunc) (_ ResultSet, err error) {
+	ctx, span := c.startSpan(ctx, "QueryByPks", AttrCollection.String(collectionName), partitionCount(partitionNames...))
+	defer func() { endSpan(span, err) }()
 	if c.Service == nil {
 		return nil, ErrClientNotReady
 	}
@@ -169,13 +174,15 @@ func (c *GrpcClient) QueryByPks(ctx context.Context, collectionName string, part
 }
 
 // Query performs query by expression.
-func (c *GrpcClient) Query(ctx context.Context, collectionName string, partitionNames []string, expr string, outputFields []string, opts ...SearchQueryOptionFunc) (ResultSet, error) {
+func (c *GrpcClient) Query(ctx context.Context, collectionName string, partitionNames []string, expr string, outputFields []string, opts ...SearchQueryOptionFunc) (_ ResultSet, err error) {
+	ctx, span := c.startSpan(ctx, "Query", AttrCollection.String(collectionName), partitionCount(partitionNames...))
+	defer func() { endSpan(span, err) }()
 	if c.Service == nil {
 		return nil, ErrClientNotReady
 	}
 
 	var sch *entity.Schema
-	collInfo, ok := MetaCache.getCollectionInfo(collectionName)
+	collInfo, ok := c.cache.getCollectionInfo(c.metaKey(ctx, collectionName))
 	if !ok {
 		coll, err := c.DescribeCollection(ctx, collectionName)
 		if err != nil {
@@ -186,7 +193,7 @@ func (c *GrpcClient) Query(ctx context.Context, collectionName string, partition
 		sch = collInfo.Schema
 	}
 
-	option, err := makeSearchQueryOption(collectionName, opts...)
+	option, err := c.makeSearchQueryOption(ctx, collectionName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -210,11 +217,12 @@ func (c *GrpcClient) Query(ctx context.Context, collectionName string, partition
 		req.QueryParams = append(req.QueryParams, &common.KeyValuePair{Key: ignoreGrowingKey, Value: strconv.FormatBool(option.IgnoreGrowing)})
 	}
 
+	setConsistencyLevel(ctx, option.ConsistencyLevel)
 	resp, err := c.Service.Query(ctx, req)
 	if err != nil {
 		return nil, err
 	}
-	err = handleRespStatus(resp.GetStatus())
+	err = c.handleCollectionRespStatus(ctx, collectionName, resp.GetStatus())
 	if err != nil {
 		return nil, err
 	}
@@ -274,7 +282,7 @@ func prepareSearchRequest(collName string, partitions []string,
 		CollectionName:     collName,
 		PartitionNames:     partitions,
 		Dsl:                expr,
-		PlaceholderGroup:   vector2PlaceholderGroupBytes(vectors),
+		SearchInput:        &server.SearchRequest_PlaceholderGroup{PlaceholderGroup: vector2PlaceholderGroupBytes(vectors)},
 		DslType:            common.DslType_BoolExprV1,
 		OutputFields:       outputFields,
 		SearchParams:       searchParams,
@@ -286,7 +294,9 @@ func prepareSearchRequest(collName string, partitions []string,
 }
 
 // GetPersistentSegmentInfo get persistent segment info
-func (c *GrpcClient) GetPersistentSegmentInfo(ctx context.Context, collName string) ([]*entity.Segment, error) {
+func (c *GrpcClient) GetPersistentSegmentInfo(ctx context.Context, collName string) (_ []*entity.Segment, err error) {
+	ctx, span := c.startSpan(ctx, "GetPersistentSegmentInfo", AttrCollection.String(collName))
+	defer func() { endSpan(span, err) }()
 	if c.Service == nil {
 		return []*entity.Segment{}, ErrClientNotReady
 	}
@@ -298,7 +308,7 @@ func (c *GrpcClient) GetPersistentSegmentInfo(ctx context.Context, collName stri
 	if err != nil {
 		return []*entity.Segment{}, err
 	}
-	if err := handleRespStatus(resp.GetStatus()); err != nil {
+	if err := handleRespStatus(ctx, collName, resp.GetStatus()); err != nil {
 		return []*entity.Segment{}, err
 	}
 	segments := make([]*entity.Segment, 0, len(resp.GetInfos()))
@@ -316,7 +326,9 @@ func (c *GrpcClient) GetPersistentSegmentInfo(ctx context.Context, collName stri
 }
 
 // GetQuerySegmentInfo get query query cluster segment loaded info
-func (c *GrpcClient) GetQuerySegmentInfo(ctx context.Context, collName string) ([]*entity.Segment, error) {
+func (c *GrpcClient) GetQuerySegmentInfo(ctx context.Context, collName string) (_ []*entity.Segment, err error) {
+	ctx, span := c.startSpan(ctx, "GetQuerySegmentInfo", AttrCollection.String(collName))
+	defer func() { endSpan(span, err) }()
 	if c.Service == nil {
 		return []*entity.Segment{}, ErrClientNotReady
 	}
@@ -328,7 +340,7 @@ func (c *GrpcClient) GetQuerySegmentInfo(ctx context.Context, collName string) (
 	if err != nil {
 		return []*entity.Segment{}, err
 	}
-	if err := handleRespStatus(resp.GetStatus()); err != nil {
+	if err := handleRespStatus(ctx, collName, resp.GetStatus()); err != nil {
 		return []*entity.Segment{}, err
 	}
 
@@ -347,7 +359,9 @@ func (c *GrpcClient) GetQuerySegmentInfo(ctx context.Context, collName string) (
 }
 
 func (c *GrpcClient) CalcDistance(ctx context.Context, collName string, partitions []string,
-	metricType entity.MetricType, opLeft, opRight entity.Column) (entity.Column, error) {
+	metricType entity.MetricType, opLeft, opRight entity.Column) (_ entity.Column, err error) {
+	ctx, span := c.startSpan(ctx, "CalcDistance", AttrCollection.String(collName), partitionCount(partitions...))
+	defer func() { endSpan(span, err) }()
 	if c.Service == nil {
 		return nil, ErrClientNotReady
 	}
@@ -386,7 +400,7 @@ func (c *GrpcClient) CalcDistance(ctx context.Context, collName string, partitio
 	if err != nil {
 		return nil, err
 	}
-	if err := handleRespStatus(resp.GetStatus()); err != nil {
+	if err := handleRespStatus(ctx, collName, resp.GetStatus()); err != nil {
 		return nil, err
 	}
 
@@ -543,7 +557,33 @@ func estRowSize(sch *entity.Schema, selected []string) int64 {
 			dimStr := field.TypeParams[entity.TypeParamDim]
 			dim, _ := strconv.ParseInt(dimStr, 10, 64)
 			total += 4 * dim / 8
+		case entity.FieldTypeFloat16Vector, entity.FieldTypeBFloat16Vector:
+			dimStr := field.TypeParams[entity.TypeParamDim]
+			dim, _ := strconv.ParseInt(dimStr, 10, 64)
+			total += 2 * dim
+		case entity.FieldTypeArray:
+			capacity, _ := strconv.ParseInt(field.TypeParams[entity.TypeParamMaxCapacity], 10, 64)
+			total += capacity * arrayElementSize(field)
 		}
 	}
 	return total
 }
+
+// arrayElementSize estimate size per element of array field
+func arrayElementSize(field *entity.Field) int64 {
+	switch field.ElementType {
+	case entity.FieldTypeBool, entity.FieldTypeInt8:
+		return 1
+	case entity.FieldTypeInt16:
+		return 2
+	case entity.FieldTypeInt32, entity.FieldTypeFloat:
+		return 4
+	case entity.FieldTypeInt64, entity.FieldTypeDouble:
+		return 8
+	case entity.FieldTypeVarChar:
+		maxLength, _ := strconv.ParseInt(field.TypeParams[entity.TypeParamMaxLength], 10, 64)
+		return maxLength
+	default:
+		return 0
+	}
+}
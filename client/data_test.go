@@ -465,6 +465,58 @@ func (s *SearchSuite) TestSearchSuccess() {
 		s.NoError(err)
 		s.Equal("abc", str)
 	})
+
+	s.Run("sparse_vector", func() {
+		defer s.resetMock()
+		sch := entity.NewSchema().WithName(testCollectionName).
+			WithField(entity.NewField().WithName("ID").WithDataType(entity.FieldTypeInt64).WithIsPrimaryKey(true)).
+			WithField(entity.NewField().WithName("sparse").WithDataType(entity.FieldTypeSparseFloatVector))
+		s.setupDescribeCollection(testCollectionName, sch)
+
+		query, err := entity.NewSparseFloatVector([]uint32{10, 2}, []float32{0.5, 0.25})
+		s.Require().NoError(err)
+		hit, err := entity.NewSparseFloatVector([]uint32{2, 7}, []float32{1, 2})
+		s.Require().NoError(err)
+		sp, err := entity.NewIndexSparseInvertedSearchParam(0.2)
+		s.Require().NoError(err)
+
+		s.mock.EXPECT().Search(mock.Anything, mock.AnythingOfType("*milvuspb.SearchRequest")).
+			Run(func(_ context.Context, req *server.SearchRequest) {
+				phg := &common.PlaceholderGroup{}
+				s.Require().NoError(proto.Unmarshal(req.GetPlaceholderGroup(), phg))
+				s.Require().Equal(1, len(phg.GetPlaceholders()))
+				ph := phg.GetPlaceholders()[0]
+				s.Equal(common.PlaceholderType_SparseFloatVector, ph.GetType())
+				s.Equal([][]byte{query.Serialize()}, ph.GetValues())
+			}).
+			Return(&server.SearchResults{
+				Status: getSuccessStatus(),
+				Results: &schema.SearchResultData{
+					NumQueries: 1,
+					TopK:       1,
+					FieldsData: []*schema.FieldData{
+						entity.NewColumnSparseFloatVector("sparse", []entity.SparseFloatVector{hit}).FieldData(),
+					},
+					Ids: &schema.IDs{
+						IdField: &schema.IDs_IntId{
+							IntId: &schema.LongArray{
+								Data: []int64{1},
+							},
+						},
+					},
+					Scores: make([]float32, 1),
+					Topks:  []int64{1},
+				},
+			}, nil)
+
+		r, err := c.Search(ctx, testCollectionName, []string{}, "", []string{"sparse"}, []entity.Vector{query},
+			"sparse", entity.IP, 1, sp)
+		s.NoError(err)
+		s.Require().Equal(1, len(r))
+		column, ok := r[0].Fields.GetColumn("sparse").(*entity.ColumnSparseFloatVector)
+		s.Require().True(ok)
+		s.Equal([]entity.SparseFloatVector{hit}, column.Data())
+	})
 }
 
 func TestSearch(t *testing.T) {
@@ -1029,6 +1081,19 @@ func TestEstRowSize(t *testing.T) {
 	t.Log(est)
 
 	assert.Greater(t, est, int64(sr2l-sr1l))
+
+	sch = entity.NewSchema().WithName(testCollectionName).
+		WithField(entity.NewField().WithName("tags").WithDataType(entity.FieldTypeArray).
+			WithElementType(entity.FieldTypeVarChar).WithMaxCapacity(4).WithMaxLength(16)).
+		WithField(entity.NewField().WithName("scores").WithDataType(entity.FieldTypeArray).
+			WithElementType(entity.FieldTypeDouble).WithMaxCapacity(8))
+	assert.EqualValues(t, 4*16+8*8, estRowSize(sch, nil))
+	assert.EqualValues(t, 8*8, estRowSize(sch, []string{"scores"}))
+
+	sch = entity.NewSchema().WithName(testCollectionName).
+		WithField(entity.NewField().WithName("fp16").WithDataType(entity.FieldTypeFloat16Vector).WithDim(128)).
+		WithField(entity.NewField().WithName("bf16").WithDataType(entity.FieldTypeBFloat16Vector).WithDim(64))
+	assert.EqualValues(t, 2*128+2*64, estRowSize(sch, nil))
 }
 
 func generateFloatVector(num, dim int) [][]float32 {
@@ -1085,4 +1150,56 @@ func TestVector2PlaceHolder(t *testing.T) {
 			assert.Equal(t, vectors[idx].Serialize(), line)
 		}
 	})
+
+	t.Run("SparseFloatVector", func(t *testing.T) {
+		vectors := make([]entity.Vector, 0, 10)
+		for i := 0; i < 10; i++ {
+			vector, err := entity.NewSparseFloatVector([]uint32{uint32(i), uint32(i + 10)}, []float32{rand.Float32(), rand.Float32()})
+			require.NoError(t, err)
+			vectors = append(vectors, vector)
+		}
+
+		phv := vector2Placeholder(vectors)
+		assert.Equal(t, "$0", phv.Tag)
+		assert.Equal(t, common.PlaceholderType_SparseFloatVector, phv.Type)
+		require.Equal(t, len(vectors), len(phv.Values))
+		for idx, line := range phv.Values {
+			assert.Equal(t, vectors[idx].Serialize(), line)
+			assert.Equal(t, 16, len(line))
+		}
+	})
+
+	t.Run("Float16Vector", func(t *testing.T) {
+		data := generateFloatVector(10, 32)
+		vectors := make([]entity.Vector, 0, len(data))
+		for _, row := range data {
+			vectors = append(vectors, entity.FloatVector(row).ToFloat16Vector())
+		}
+
+		phv := vector2Placeholder(vectors)
+		assert.Equal(t, "$0", phv.Tag)
+		assert.Equal(t, common.PlaceholderType_Float16Vector, phv.Type)
+		require.Equal(t, len(vectors), len(phv.Values))
+		for idx, line := range phv.Values {
+			assert.Equal(t, vectors[idx].Serialize(), line)
+			assert.Equal(t, 64, len(line))
+		}
+	})
+
+	t.Run("BFloat16Vector", func(t *testing.T) {
+		data := generateFloatVector(10, 32)
+		vectors := make([]entity.Vector, 0, len(data))
+		for _, row := range data {
+			vectors = append(vectors, entity.FloatVector(row).ToBFloat16Vector())
+		}
+
+		phv := vector2Placeholder(vectors)
+		assert.Equal(t, "$0", phv.Tag)
+		assert.Equal(t, common.PlaceholderType_BFloat16Vector, phv.Type)
+		require.Equal(t, len(vectors), len(phv.Values))
+		for idx, line := range phv.Values {
+			assert.Equal(t, vectors[idx].Serialize(), line)
+			assert.Equal(t, 64, len(line))
+		}
+	})
 }
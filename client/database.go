@@ -23,24 +23,92 @@ import (
 	"github.com/milvus-io/milvus-sdk-go/v2/entity"
 )
 
+type databaseCtxKey struct{}
+
+// ContextWithDatabase returns a context which makes the request use dbName instead of the database of client.
+// e.g. c.HasCollection(client.ContextWithDatabase(ctx, "db1"), "coll") checks the collection in db1
+// no matter which database the client uses.
+func ContextWithDatabase(ctx context.Context, dbName string) context.Context {
+	return context.WithValue(ctx, databaseCtxKey{}, dbName)
+}
+
+// DatabaseFromContext returns the database set by ContextWithDatabase, false if not set.
+func DatabaseFromContext(ctx context.Context) (string, bool) {
+	dbName, ok := ctx.Value(databaseCtxKey{}).(string)
+	return dbName, ok
+}
+
+// currentDatabase returns the database used by client.
+func (c *GrpcClient) currentDatabase() string {
+	if dbName, ok := c.db.Load().(string); ok {
+		return dbName
+	}
+	if c.config == nil {
+		return ""
+	}
+	return c.config.DBName
+}
+
+// database returns the database which the request made with ctx is applied to.
+func (c *GrpcClient) database(ctx context.Context) string {
+	if dbName, ok := DatabaseFromContext(ctx); ok {
+		return dbName
+	}
+	return c.currentDatabase()
+}
+
+// withDatabase returns the context of client method, requests made with it are applied to the database of c,
+// unless the database is specified by ContextWithDatabase already.
+// The metadata interceptor resolves the database of request from the context.
+func (c *GrpcClient) withDatabase(ctx context.Context) context.Context {
+	if _, ok := DatabaseFromContext(ctx); ok {
+		return ctx
+	}
+	return ContextWithDatabase(ctx, c.currentDatabase())
+}
+
 // UsingDatabase for database operation after this function call.
 // All request in any goroutine will be applied to new database on the same client. e.g.
 // 1. goroutine A access DB1.
 // 2. goroutine B call UsingDatabase(ctx, "DB2").
 // 3. goroutine A access DB2 after 2.
+//
+// Use WithDatabase or ContextWithDatabase instead to access several databases concurrently.
 func (c *GrpcClient) UsingDatabase(ctx context.Context, dbName string) error {
-	c.config.useDatabase(dbName)
-	err := c.connectInternal(ctx)
+	prev := c.currentDatabase()
+	c.db.Store(dbName)
+	err := c.connectInternal(ContextWithDatabase(ctx, dbName))
 	if err != nil {
+		// keep using previous database, unless switched by others meanwhile
+		c.db.CompareAndSwap(dbName, prev)
 		return err
 	}
 
 	return nil
 }
 
+// WithDatabase returns a Client using dbName, which shares the connection and meta cache with c.
+// The database of c is left unchanged, and closing the returned Client does not close the connection.
+func (c *GrpcClient) WithDatabase(dbName string) Client {
+	view := &GrpcClient{
+		Conn:      c.Conn,
+		config:    c.config,
+		endpoints: c.endpoints,
+		cache:     c.cache,
+		view:      true,
+	}
+	view.db.Store(dbName)
+	if c.Conn != nil {
+		view.Service = server.NewMilvusServiceClient(c.Conn)
+	}
+	return view
+}
+
 // CreateDatabase creates a new database for remote Milvus cluster.
 // TODO:New options can be added as expanding parameters.
-func (c *GrpcClient) CreateDatabase(ctx context.Context, dbName string) error {
+func (c *GrpcClient) CreateDatabase(ctx context.Context, dbName string) (err error) {
+	ctx, span := c.startSpan(ctx, "CreateDatabase")
+	defer func() { endSpan(span, err) }()
 	if c.Service == nil {
 		return ErrClientNotReady
 	}
@@ -51,11 +119,13 @@ func (c *GrpcClient) CreateDatabase(ctx context.Context, dbName string) error {
 	if err != nil {
 		return err
 	}
-	return handleRespStatus(resp)
+	return handleRespStatus(ctx, "", resp)
 }
 
 // ListDatabases list all database in milvus cluster.
-func (c *GrpcClient) ListDatabases(ctx context.Context) ([]entity.Database, error) {
+func (c *GrpcClient) ListDatabases(ctx context.Context) (_ []entity.Database, err error) {
+	ctx, span := c.startSpan(ctx, "ListDatabases")
+	defer func() { endSpan(span, err) }()
 	if c.Service == nil {
 		return nil, ErrClientNotReady
 	}
@@ -65,7 +135,7 @@ func (c *GrpcClient) ListDatabases(ctx context.Context) ([]entity.Database, erro
 	if err != nil {
 		return nil, err
 	}
-	if err = handleRespStatus(resp.GetStatus()); err != nil {
+	if err = handleRespStatus(ctx, "", resp.GetStatus()); err != nil {
 		return nil, err
 	}
 	databases := make([]entity.Database, len(resp.GetDbNames()))
@@ -78,7 +148,9 @@ func (c *GrpcClient) ListDatabases(ctx context.Context) ([]entity.Database, erro
 }
 
 // DropDatabase drop all database in milvus cluster.
-func (c *GrpcClient) DropDatabase(ctx context.Context, dbName string) error {
+func (c *GrpcClient) DropDatabase(ctx context.Context, dbName string) (err error) {
+	ctx, span := c.startSpan(ctx, "DropDatabase")
+	defer func() { endSpan(span, err) }()
 	if c.Service == nil {
 		return ErrClientNotReady
 	}
@@ -90,5 +162,5 @@ func (c *GrpcClient) DropDatabase(ctx context.Context, dbName string) error {
 	if err != nil {
 		return err
 	}
-	return handleRespStatus(resp)
+	return handleRespStatus(ctx, "", resp)
 }
@@ -2,13 +2,25 @@ package client
 
 import (
 	"context"
+	"net"
+	"strings"
+	"sync"
 	"testing"
 
 	"github.com/go-faker/faker/v4"
 	"github.com/go-faker/faker/v4/pkg/options"
 	"github.com/golang/protobuf/proto"
+	common "github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	server "github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/milvus-io/milvus-sdk-go/v2/mocks"
 )
 
 func TestGrpcClientListDatabases(t *testing.T) {
@@ -58,3 +70,128 @@ func TestGrpcClientDropDatabase(t *testing.T) {
 	err := c.DropDatabase(ctx, "a")
 	assert.Nil(t, err)
 }
+
+func TestWithDatabase(t *testing.T) {
+	lis := bufconn.Listen(bufSize)
+	svr := grpc.NewServer()
+	m := &mocks.MilvusServiceServer{}
+	var mut sync.Mutex
+	connected := map[string]int{}
+	m.EXPECT().Connect(mock.Anything, mock.Anything).
+		Run(func(ctx context.Context, _ *server.ConnectRequest) {
+			md, _ := metadata.FromIncomingContext(ctx)
+			mut.Lock()
+			defer mut.Unlock()
+			connected[strings.Join(md.Get("dbname"), ",")]++
+		}).
+		RunAndReturn(func(ctx context.Context, _ *server.ConnectRequest) (*server.ConnectResponse, error) {
+			md, _ := metadata.FromIncomingContext(ctx)
+			if strings.Join(md.Get("dbname"), ",") == "not_exist" {
+				return &server.ConnectResponse{Status: &common.Status{ErrorCode: common.ErrorCode_UnexpectedError, Code: codeDatabaseNotFound}}, nil
+			}
+			return &server.ConnectResponse{Status: &common.Status{}, Identifier: 1}, nil
+		})
+	// echo dbname of request as collection existence
+	m.EXPECT().HasCollection(mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, req *server.HasCollectionRequest) (*server.BoolResponse, error) {
+			md, _ := metadata.FromIncomingContext(ctx)
+			return &server.BoolResponse{Status: &common.Status{}, Value: strings.Join(md.Get("dbname"), ",") == req.GetCollectionName()}, nil
+		})
+	server.RegisterMilvusServiceServer(svr, m)
+	go svr.Serve(lis)
+	defer svr.Stop()
+
+	ctx := context.Background()
+	c, err := NewClient(ctx, Config{
+		Address: "bufnet",
+		DBName:  "db0",
+		DialOptions: []grpc.DialOption{
+			grpc.WithBlock(),
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+			grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+				return lis.Dial()
+			}),
+		},
+	})
+	require.NoError(t, err)
+	defer c.Close()
+
+	inDatabase := func(c Client, ctx context.Context, dbName string) bool {
+		has, err := c.HasCollection(ctx, dbName)
+		require.NoError(t, err)
+		return has
+	}
+
+	t.Run("view", func(t *testing.T) {
+		db1 := c.WithDatabase("db1")
+		db2 := db1.WithDatabase("db2")
+		var wg sync.WaitGroup
+		for i := 0; i < 10; i++ {
+			wg.Add(3)
+			go func() {
+				defer wg.Done()
+				assert.True(t, inDatabase(c, ctx, "db0"))
+			}()
+			go func() {
+				defer wg.Done()
+				assert.True(t, inDatabase(db1, ctx, "db1"))
+			}()
+			go func() {
+				defer wg.Done()
+				assert.True(t, inDatabase(db2, ctx, "db2"))
+			}()
+		}
+		wg.Wait()
+
+		// closing view keeps the connection
+		assert.NoError(t, db1.Close())
+		assert.True(t, inDatabase(c, ctx, "db0"))
+		assert.True(t, inDatabase(db2, ctx, "db2"))
+	})
+
+	t.Run("context", func(t *testing.T) {
+		assert.True(t, inDatabase(c, ContextWithDatabase(ctx, "db3"), "db3"))
+		assert.True(t, inDatabase(c.WithDatabase("db1"), ContextWithDatabase(ctx, "db3"), "db3"))
+		assert.True(t, inDatabase(c, ctx, "db0"))
+
+		gc := c.(*GrpcClient)
+		assert.NotEqual(t, gc.metaKey(ctx, "coll"), gc.metaKey(ContextWithDatabase(ctx, "db3"), "coll"))
+		assert.Equal(t, gc.metaKey(ContextWithDatabase(ctx, "db1"), "coll"), c.WithDatabase("db1").(*GrpcClient).metaKey(ctx, "coll"))
+	})
+
+	t.Run("using_database", func(t *testing.T) {
+		view := c.WithDatabase("db1")
+		var wg sync.WaitGroup
+		for _, dbName := range []string{"db4", "db5", "db6"} {
+			wg.Add(2)
+			go func(dbName string) {
+				defer wg.Done()
+				assert.NoError(t, c.UsingDatabase(ctx, dbName))
+			}(dbName)
+			go func() {
+				defer wg.Done()
+				assert.True(t, inDatabase(view, ctx, "db1"))
+			}()
+		}
+		wg.Wait()
+
+		require.NoError(t, c.UsingDatabase(ctx, "db7"))
+		assert.True(t, inDatabase(c, ctx, "db7"))
+		assert.True(t, inDatabase(view, ctx, "db1"))
+		mut.Lock()
+		assert.Equal(t, 1, connected["db7"])
+		mut.Unlock()
+
+		// requests sent by Service directly are applied to the database in use
+		resp, err := c.(*GrpcClient).Service.HasCollection(ctx, &server.HasCollectionRequest{CollectionName: "db7"})
+		require.NoError(t, err)
+		assert.True(t, resp.GetValue())
+
+		// failed switch keeps the database in use
+		assert.ErrorIs(t, c.UsingDatabase(ctx, "not_exist"), ErrNotFound)
+		assert.True(t, inDatabase(c, ctx, "db7"))
+		resp, err = c.(*GrpcClient).Service.HasCollection(ctx, &server.HasCollectionRequest{CollectionName: "db7"})
+		require.NoError(t, err)
+		assert.True(t, resp.GetValue())
+	})
+}
@@ -12,21 +12,228 @@
 package client
 
 import (
+	"context"
 	"fmt"
+	"sync/atomic"
 
 	"github.com/cockroachdb/errors"
+	common "github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // ErrServiceFailed indicates error returns from milvus Service
+//
+// Deprecated: failures returned by milvus service are *ServerError now.
 type ErrServiceFailed error
 
 var (
 	//ErrClientNotReady error indicates client not ready
 	ErrClientNotReady = errors.New("client not ready")
+	//ErrClientClosed error indicates client is closed
+	ErrClientClosed = errors.New("client closed")
 	//ErrStatusNil error indicates response has nil status
 	ErrStatusNil = errors.New("response status is nil")
 )
 
+// Sentinels of server errors, check with errors.Is(err, ErrRateLimited) for example.
+var (
+	//ErrRateLimited error indicates request is rejected by the rate limiter of server
+	ErrRateLimited = errors.New("rate limited")
+	//ErrNotFound error indicates the database, collection, partition, index, alias or other object does not exist
+	ErrNotFound = errors.New("not found")
+	//ErrAlreadyExists error indicates the object to create exists already
+	ErrAlreadyExists = errors.New("already exists")
+	//ErrUnauthenticated error indicates the credential is missing or rejected
+	ErrUnauthenticated = errors.New("unauthenticated")
+	//ErrPermissionDenied error indicates the user has no privilege for the request
+	ErrPermissionDenied = errors.New("permission denied")
+	//ErrIndexNotExist error indicates the index does not exist
+	ErrIndexNotExist = errors.New("index not exist")
+	//ErrMemoryQuotaExhausted error indicates the memory quota of server is exhausted
+	ErrMemoryQuotaExhausted = errors.New("memory quota exhausted")
+	//ErrNotReady error indicates the server is not ready to serve yet
+	ErrNotReady = errors.New("service not ready")
+)
+
+// milvus 2.3+ error codes in common.Status.Code
+const (
+	codeCollectionNotFound         = 100
+	codeCollectionSchemaMismatch   = 108
+	codePartitionNotFound          = 200
+	codeResourceGroupNotFound      = 300
+	codeResourceGroupAlreadyExists = 301
+	codeIndexNotFound              = 700
+	codeIndexDuplicate             = 702
+	codeDatabaseNotFound           = 800
+	codeAliasNotFound              = 1600
+	codeAliasAlreadyExists         = 1602
+)
+
+// ServerError is returned when milvus service fails the request,
+// either with a non-success response status or a grpc status error.
+type ServerError struct {
+	ErrorCode  common.ErrorCode // error code in response status, Success if request failed with grpc error
+	Code       int32            // error code of milvus 2.3+ in response status, zero if not provided
+	GrpcCode   codes.Code       // grpc status code, OK if request failed with response status
+	Reason     string           // reason in response status or grpc status message
+	Method     string           // grpc method name, "Search" for example
+	Collection string           // collection name of the request, empty if not applicable
+	RequestID  string           // request id set by WithClientRequestID, empty if not provided
+	err        error
+}
+
+func newServerError(ctx context.Context, collection string, status *common.Status) *ServerError {
+	return &ServerError{
+		ErrorCode:  status.GetErrorCode(),
+		Code:       status.GetCode(),
+		Reason:     status.GetReason(),
+		Method:     lastRPCOf(ctx),
+		Collection: collection,
+		RequestID:  requestIDOf(ctx),
+	}
+}
+
+// Error implements error.
+func (e *ServerError) Error() string {
+	msg := e.Reason
+	if msg == "" {
+		msg = "service failed"
+	}
+	if e.Collection != "" {
+		msg = fmt.Sprintf("%s on collection %s: %s", e.Method, e.Collection, msg)
+	} else if e.Method != "" {
+		msg = fmt.Sprintf("%s: %s", e.Method, msg)
+	}
+	code := e.ErrorCode.String()
+	if e.GrpcCode != codes.OK {
+		code = e.GrpcCode.String()
+	}
+	if e.RequestID != "" {
+		return fmt.Sprintf("%s (code=%s, request_id=%s)", msg, code, e.RequestID)
+	}
+	return fmt.Sprintf("%s (code=%s)", msg, code)
+}
+
+// Unwrap returns the grpc error if request failed with grpc error.
+func (e *ServerError) Unwrap() error {
+	return e.err
+}
+
+// GRPCStatus keeps status.Code(err) returning the grpc code, codes.Unknown for failed response status.
+func (e *ServerError) GRPCStatus() *status.Status {
+	if e.err != nil {
+		return status.Convert(e.err)
+	}
+	return status.New(codes.Unknown, e.Error())
+}
+
+// Is makes errors.Is(err, sentinel) true for the sentinels matching the error.
+func (e *ServerError) Is(target error) bool {
+	switch target {
+	case ErrRateLimited:
+		return e.ErrorCode == common.ErrorCode_RateLimit || e.GrpcCode == codes.ResourceExhausted
+	case ErrNotFound:
+		switch e.ErrorCode {
+		case common.ErrorCode_CollectionNotExists, common.ErrorCode_CollectionNameNotFound,
+			common.ErrorCode_IndexNotExist, common.ErrorCode_SegmentNotFound:
+			return true
+		}
+		switch e.Code {
+		case codeCollectionNotFound, codePartitionNotFound, codeResourceGroupNotFound, codeIndexNotFound,
+			codeDatabaseNotFound, codeAliasNotFound:
+			return true
+		}
+		return e.GrpcCode == codes.NotFound
+	case ErrAlreadyExists:
+		switch e.Code {
+		case codeResourceGroupAlreadyExists, codeIndexDuplicate, codeAliasAlreadyExists:
+			return true
+		}
+		return e.GrpcCode == codes.AlreadyExists
+	case ErrUnauthenticated:
+		return e.GrpcCode == codes.Unauthenticated
+	case ErrPermissionDenied:
+		return e.ErrorCode == common.ErrorCode_PermissionDenied || e.GrpcCode == codes.PermissionDenied
+	case ErrIndexNotExist:
+		return e.ErrorCode == common.ErrorCode_IndexNotExist || e.Code == codeIndexNotFound
+	case ErrMemoryQuotaExhausted:
+		return e.ErrorCode == common.ErrorCode_MemoryQuotaExhausted
+	case ErrNotReady:
+		return e.ErrorCode == common.ErrorCode_NotReadyServe || e.ErrorCode == common.ErrorCode_NotReadyCoordActivating
+	}
+	return false
+}
+
+// IsRetryable reports whether err is a transient failure, which may succeed if the request is retried later.
+func IsRetryable(err error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, ErrRateLimited) || errors.Is(err, ErrNotReady) {
+		return true
+	}
+	var serverErr *ServerError
+	if errors.As(err, &serverErr) {
+		switch serverErr.ErrorCode {
+		case common.ErrorCode_NotShardLeader, common.ErrorCode_NoReplicaAvailable, common.ErrorCode_TimeTickLongDelay:
+			return true
+		}
+	}
+	switch status.Code(err) {
+	case codes.Unavailable, codes.ResourceExhausted, codes.Aborted:
+		return true
+	}
+	return false
+}
+
+type lastRPCCtxKey struct{}
+
+// withLastRPC returns context recording the name of the last request sent with it,
+// so that errors of response status carry the method of the request.
+func withLastRPC(ctx context.Context) context.Context {
+	return context.WithValue(ctx, lastRPCCtxKey{}, &atomic.Value{})
+}
+
+func lastRPCOf(ctx context.Context) string {
+	if last, ok := ctx.Value(lastRPCCtxKey{}).(*atomic.Value); ok {
+		method, _ := last.Load().(string)
+		return method
+	}
+	return ""
+}
+
+// serverErrorInterceptor converts grpc status errors into *ServerError,
+// deadline exceeded and canceled requests are left as is.
+func serverErrorInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if last, ok := ctx.Value(lastRPCCtxKey{}).(*atomic.Value); ok {
+			last.Store(methodName(method))
+		}
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		if err == nil {
+			return nil
+		}
+		var timeoutErr *TimeoutError
+		if errors.As(err, &timeoutErr) {
+			return err
+		}
+		s, ok := status.FromError(err)
+		if !ok || s.Code() == codes.Canceled || s.Code() == codes.DeadlineExceeded {
+			return err
+		}
+		return &ServerError{
+			GrpcCode:   s.Code(),
+			Reason:     s.Message(),
+			Method:     methodName(method),
+			Collection: collectionOf(req),
+			RequestID:  requestIDOf(ctx),
+			err:        err,
+		}
+	}
+}
+
 // ErrCollectionNotExists indicates the collection with specified collection name does not exist
 type ErrCollectionNotExists struct {
 	collName string
@@ -37,6 +244,11 @@ func (e ErrCollectionNotExists) Error() string {
 	return fmt.Sprintf("collection %s does not exist", e.collName)
 }
 
+// Is makes errors.Is(err, ErrNotFound) true
+func (e ErrCollectionNotExists) Is(target error) bool {
+	return target == ErrNotFound
+}
+
 // ErrPartitionNotExists indicates the partition of collection does not exist
 type ErrPartitionNotExists struct {
 	collName     string
@@ -48,6 +260,11 @@ func (e ErrPartitionNotExists) Error() string {
 	return fmt.Sprintf("partition %s of collection %s does not exist", e.paritionName, e.collName)
 }
 
+// Is makes errors.Is(err, ErrNotFound) true
+func (e ErrPartitionNotExists) Is(target error) bool {
+	return target == ErrNotFound
+}
+
 func collNotExistsErr(collName string) ErrCollectionNotExists {
 	return ErrCollectionNotExists{collName: collName}
 }
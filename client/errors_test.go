@@ -0,0 +1,152 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	common "github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	server "github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestServerError(t *testing.T) {
+	ctx := withLastRPC(WithClientRequestID(context.Background(), "req-1"))
+	inter := serverErrorInterceptor()
+	require.NoError(t, inter(ctx, "/milvus.proto.milvus.MilvusService/Search", nil, nil, nil,
+		func(context.Context, string, interface{}, interface{}, *grpc.ClientConn, ...grpc.CallOption) error {
+			return nil
+		}))
+	err := handleRespStatus(ctx, "coll", &common.Status{ErrorCode: common.ErrorCode_RateLimit, Reason: "too many requests"})
+	wrapped := fmt.Errorf("search failed: %w", err)
+
+	var serverErr *ServerError
+	require.True(t, errors.As(wrapped, &serverErr))
+	assert.Equal(t, common.ErrorCode_RateLimit, serverErr.ErrorCode)
+	assert.Equal(t, "too many requests", serverErr.Reason)
+	assert.Equal(t, "Search", serverErr.Method)
+	assert.Equal(t, "coll", serverErr.Collection)
+	assert.Equal(t, "req-1", serverErr.RequestID)
+	assert.Equal(t, "Search on collection coll: too many requests (code=RateLimit, request_id=req-1)", err.Error())
+	assert.True(t, errors.Is(wrapped, ErrRateLimited))
+	assert.False(t, errors.Is(wrapped, ErrNotFound))
+	assert.True(t, IsRetryable(wrapped))
+	assert.Equal(t, codes.Unknown, status.Code(err))
+
+	err = handleRespStatus(context.Background(), "", &common.Status{ErrorCode: common.ErrorCode_UnexpectedError})
+	assert.Equal(t, "service failed (code=UnexpectedError)", err.Error())
+}
+
+func TestServerErrorSentinels(t *testing.T) {
+	cases := []struct {
+		err      *ServerError
+		sentinel error
+	}{
+		{&ServerError{ErrorCode: common.ErrorCode_RateLimit}, ErrRateLimited},
+		{&ServerError{GrpcCode: codes.ResourceExhausted}, ErrRateLimited},
+		{&ServerError{ErrorCode: common.ErrorCode_CollectionNotExists}, ErrNotFound},
+		{&ServerError{ErrorCode: common.ErrorCode_CollectionNameNotFound}, ErrNotFound},
+		{&ServerError{ErrorCode: common.ErrorCode_SegmentNotFound}, ErrNotFound},
+		{&ServerError{ErrorCode: common.ErrorCode_UnexpectedError, Code: codeCollectionNotFound}, ErrNotFound},
+		{&ServerError{ErrorCode: common.ErrorCode_UnexpectedError, Code: codePartitionNotFound}, ErrNotFound},
+		{&ServerError{ErrorCode: common.ErrorCode_UnexpectedError, Code: codeResourceGroupNotFound}, ErrNotFound},
+		{&ServerError{ErrorCode: common.ErrorCode_UnexpectedError, Code: codeIndexNotFound}, ErrNotFound},
+		{&ServerError{ErrorCode: common.ErrorCode_UnexpectedError, Code: codeDatabaseNotFound}, ErrNotFound},
+		{&ServerError{ErrorCode: common.ErrorCode_UnexpectedError, Code: codeAliasNotFound}, ErrNotFound},
+		{&ServerError{GrpcCode: codes.NotFound}, ErrNotFound},
+		{&ServerError{ErrorCode: common.ErrorCode_UnexpectedError, Code: codeResourceGroupAlreadyExists}, ErrAlreadyExists},
+		{&ServerError{ErrorCode: common.ErrorCode_UnexpectedError, Code: codeIndexDuplicate}, ErrAlreadyExists},
+		{&ServerError{ErrorCode: common.ErrorCode_UnexpectedError, Code: codeAliasAlreadyExists}, ErrAlreadyExists},
+		{&ServerError{GrpcCode: codes.AlreadyExists}, ErrAlreadyExists},
+		{&ServerError{GrpcCode: codes.Unauthenticated}, ErrUnauthenticated},
+		{&ServerError{ErrorCode: common.ErrorCode_PermissionDenied}, ErrPermissionDenied},
+		{&ServerError{GrpcCode: codes.PermissionDenied}, ErrPermissionDenied},
+		{&ServerError{ErrorCode: common.ErrorCode_IndexNotExist}, ErrIndexNotExist},
+		{&ServerError{ErrorCode: common.ErrorCode_IndexNotExist}, ErrNotFound},
+		{&ServerError{ErrorCode: common.ErrorCode_UnexpectedError, Code: codeIndexNotFound}, ErrIndexNotExist},
+		{&ServerError{ErrorCode: common.ErrorCode_MemoryQuotaExhausted}, ErrMemoryQuotaExhausted},
+		{&ServerError{ErrorCode: common.ErrorCode_NotReadyServe}, ErrNotReady},
+		{&ServerError{ErrorCode: common.ErrorCode_NotReadyCoordActivating}, ErrNotReady},
+	}
+	for _, c := range cases {
+		assert.True(t, errors.Is(c.err, c.sentinel), "%v is %v", c.err, c.sentinel)
+	}
+
+	unexpected := &ServerError{ErrorCode: common.ErrorCode_UnexpectedError, Reason: "unexpected"}
+	for _, sentinel := range []error{ErrRateLimited, ErrNotFound, ErrAlreadyExists, ErrUnauthenticated,
+		ErrPermissionDenied, ErrIndexNotExist, ErrMemoryQuotaExhausted, ErrNotReady} {
+		assert.False(t, errors.Is(unexpected, sentinel), sentinel)
+	}
+
+	// reason is not matched
+	assert.False(t, errors.Is(&ServerError{ErrorCode: common.ErrorCode_UnexpectedError, Reason: "collection already exists"}, ErrAlreadyExists))
+
+	assert.True(t, errors.Is(collNotExistsErr("coll"), ErrNotFound))
+	assert.True(t, errors.Is(partNotExistsErr("coll", "part"), ErrNotFound))
+}
+
+func TestIsRetryable(t *testing.T) {
+	assert.False(t, IsRetryable(nil))
+	assert.False(t, IsRetryable(ErrClientClosed))
+	assert.False(t, IsRetryable(&ServerError{ErrorCode: common.ErrorCode_IllegalArgument}))
+	assert.False(t, IsRetryable(&ServerError{ErrorCode: common.ErrorCode_MemoryQuotaExhausted}))
+	assert.True(t, IsRetryable(&ServerError{ErrorCode: common.ErrorCode_NotReadyServe}))
+	assert.True(t, IsRetryable(&ServerError{ErrorCode: common.ErrorCode_NoReplicaAvailable}))
+	assert.True(t, IsRetryable(status.Error(codes.Unavailable, "connection refused")))
+	assert.False(t, IsRetryable(status.Error(codes.InvalidArgument, "invalid")))
+}
+
+func TestServerErrorInterceptor(t *testing.T) {
+	inter := serverErrorInterceptor()
+	invoke := func(err error) error {
+		return inter(WithClientRequestID(context.Background(), "req-1"), "/milvus.proto.milvus.MilvusService/DescribeCollection",
+			&server.DescribeCollectionRequest{CollectionName: "coll"}, &server.DescribeCollectionResponse{}, nil,
+			func(context.Context, string, interface{}, interface{}, *grpc.ClientConn, ...grpc.CallOption) error {
+				return err
+			})
+	}
+
+	assert.NoError(t, invoke(nil))
+
+	grpcErr := status.Error(codes.Unauthenticated, "invalid token")
+	err := invoke(grpcErr)
+	var serverErr *ServerError
+	require.True(t, errors.As(err, &serverErr))
+	assert.Equal(t, codes.Unauthenticated, serverErr.GrpcCode)
+	assert.Equal(t, "DescribeCollection", serverErr.Method)
+	assert.Equal(t, "coll", serverErr.Collection)
+	assert.Equal(t, "req-1", serverErr.RequestID)
+	assert.True(t, errors.Is(err, ErrUnauthenticated))
+	assert.True(t, errors.Is(err, grpcErr))
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// left as is
+	for _, err := range []error{
+		ErrClientClosed,
+		status.Error(codes.Canceled, "canceled"),
+		&TimeoutError{Method: "DescribeCollection", err: status.Error(codes.DeadlineExceeded, "deadline exceeded")},
+	} {
+		assert.Equal(t, err, invoke(err))
+	}
+}
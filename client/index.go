@@ -33,7 +33,7 @@ func (c *GrpcClient) checkCollField(ctx context.Context, collName string, fieldN
 	for _, field := range coll.Schema.Fields {
 		if field.Name == fieldName {
 			f = field
-			if f.DataType != entity.FieldTypeFloatVector && f.DataType != entity.FieldTypeBinaryVector {
+			if !isVectorField(f.DataType) {
 				return fmt.Errorf("field %s of collection %s is not vector field", fieldName, collName)
 			}
 			break
@@ -73,7 +73,9 @@ func getIndexDef(opts ...IndexOption) indexDef {
 // CreateIndex create index for collection
 // Deprecated please use CreateIndexV2 instead.
 func (c *GrpcClient) CreateIndex(ctx context.Context, collName string, fieldName string,
-	idx entity.Index, async bool, opts ...IndexOption) error {
+	idx entity.Index, async bool, opts ...IndexOption) (err error) {
+	ctx, span := c.startSpan(ctx, "CreateIndex", AttrCollection.String(collName))
+	defer func() { endSpan(span, err) }()
 	if c.Service == nil {
 		return ErrClientNotReady
 	}
@@ -100,7 +102,7 @@ func (c *GrpcClient) CreateIndex(ctx context.Context, collName string, fieldName
 	if err != nil {
 		return err
 	}
-	if err = handleRespStatus(resp); err != nil {
+	if err = handleRespStatus(ctx, collName, resp); err != nil {
 		return err
 	}
 	if !async { // sync mode, wait index building result
@@ -128,7 +130,9 @@ func (c *GrpcClient) CreateIndex(ctx context.Context, collName string, fieldName
 
 // DescribeIndex describe index
 // Deprecate please use DescribeIndexV2 instead.
-func (c *GrpcClient) DescribeIndex(ctx context.Context, collName string, fieldName string, opts ...IndexOption) ([]entity.Index, error) {
+func (c *GrpcClient) DescribeIndex(ctx context.Context, collName string, fieldName string, opts ...IndexOption) (_ []entity.Index, err error) {
+	ctx, span := c.startSpan(ctx, "DescribeIndex", AttrCollection.String(collName))
+	defer func() { endSpan(span, err) }()
 	if c.Service == nil {
 		return []entity.Index{}, ErrClientNotReady
 	}
@@ -157,7 +161,9 @@ func (c *GrpcClient) DescribeIndex(ctx context.Context, collName string, fieldNa
 
 // DropIndex drop index from collection
 // Deprecate please use DropIndexV2 instead.
-func (c *GrpcClient) DropIndex(ctx context.Context, collName string, fieldName string, opts ...IndexOption) error {
+func (c *GrpcClient) DropIndex(ctx context.Context, collName string, fieldName string, opts ...IndexOption) (err error) {
+	ctx, span := c.startSpan(ctx, "DropIndex", AttrCollection.String(collName))
+	defer func() { endSpan(span, err) }()
 	if c.Service == nil {
 		return ErrClientNotReady
 	}
@@ -180,12 +186,14 @@ func (c *GrpcClient) DropIndex(ctx context.Context, collName string, fieldName s
 	if err != nil {
 		return err
 	}
-	return handleRespStatus(resp)
+	return handleRespStatus(ctx, collName, resp)
 }
 
 // GetIndexState get index state
 // Deprecate please use DescribeIndexV2 instead.
-func (c *GrpcClient) GetIndexState(ctx context.Context, collName string, fieldName string, opts ...IndexOption) (entity.IndexState, error) {
+func (c *GrpcClient) GetIndexState(ctx context.Context, collName string, fieldName string, opts ...IndexOption) (_ entity.IndexState, err error) {
+	ctx, span := c.startSpan(ctx, "GetIndexState", AttrCollection.String(collName))
+	defer func() { endSpan(span, err) }()
 	if c.Service == nil {
 		return entity.IndexState(common.IndexState_Failed), ErrClientNotReady
 	}
@@ -204,7 +212,7 @@ func (c *GrpcClient) GetIndexState(ctx context.Context, collName string, fieldNa
 	if err != nil {
 		return entity.IndexState(common.IndexState_IndexStateNone), err
 	}
-	if err := handleRespStatus(resp.GetStatus()); err != nil {
+	if err := handleRespStatus(ctx, collName, resp.GetStatus()); err != nil {
 		return entity.IndexState(common.IndexState_IndexStateNone), err
 	}
 
@@ -214,6 +222,8 @@ func (c *GrpcClient) GetIndexState(ctx context.Context, collName string, fieldNa
 // GetIndexBuildProgress get index building progress
 // Deprecate please use DescribeIndexV2 instead.
 func (c *GrpcClient) GetIndexBuildProgress(ctx context.Context, collName string, fieldName string, opts ...IndexOption) (total, indexed int64, err error) {
+	ctx, span := c.startSpan(ctx, "GetIndexBuildProgress", AttrCollection.String(collName))
+	defer func() { endSpan(span, err) }()
 	if c.Service == nil {
 		return 0, 0, ErrClientNotReady
 	}
@@ -232,7 +242,7 @@ func (c *GrpcClient) GetIndexBuildProgress(ctx context.Context, collName string,
 	if err != nil {
 		return 0, 0, err
 	}
-	if err = handleRespStatus(resp.GetStatus()); err != nil {
+	if err = handleRespStatus(ctx, collName, resp.GetStatus()); err != nil {
 		return 0, 0, err
 	}
 	return resp.GetTotalRows(), resp.GetIndexedRows(), nil
@@ -250,7 +260,7 @@ func (c *GrpcClient) describeIndex(ctx context.Context, collName string, fieldNa
 	if err != nil {
 		return nil, err
 	}
-	if err := handleRespStatus(resp.GetStatus()); err != nil {
+	if err := handleRespStatus(ctx, collName, resp.GetStatus()); err != nil {
 		return nil, err
 	}
 
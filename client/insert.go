@@ -30,55 +30,59 @@ import (
 // collName is the collection name
 // partitionName is the partition to insert, if not specified(empty), default partition will be used
 // columns are slice of the column-based data
-func (c *GrpcClient) Insert(ctx context.Context, collName string, partitionName string, columns ...entity.Column) (entity.Column, error) {
+func (c *GrpcClient) Insert(ctx context.Context, collName string, partitionName string, columns ...entity.Column) (_ entity.Column, err error) {
+	ctx, span := c.startSpan(ctx, "Insert", AttrCollection.String(collName), partitionCount(partitionName))
+	defer func() { endSpan(span, err) }()
+	return c.insert(ctx, collName, partitionName, func(*entity.Schema) ([]entity.Column, error) {
+		return columns, nil
+	})
+}
+
+// insert inserts the columns built against collection schema, build may be called again with refreshed schema.
+func (c *GrpcClient) insert(ctx context.Context, collName string, partitionName string,
+	build func(sch *entity.Schema) ([]entity.Column, error)) (entity.Column, error) {
 	if c.Service == nil {
 		return nil, ErrClientNotReady
 	}
-	// 1. validation for all input params
-	// collection
-	if err := c.checkCollectionExists(ctx, collName); err != nil {
-		return nil, err
-	}
-	if partitionName != "" {
-		err := c.checkPartitionExists(ctx, collName, partitionName)
-		if err != nil {
-			return nil, err
-		}
-	}
-	// fields
-	var rowSize int
-	coll, err := c.DescribeCollection(ctx, collName)
-	if err != nil {
-		return nil, err
-	}
-
-	// convert columns to field data
-	fieldsData, rowSize, err := c.processInsertColumns(coll.Schema, columns...)
-	if err != nil {
-		return nil, err
-	}
-
-	// 2. do insert request
-	req := &server.InsertRequest{
-		DbName:         "", // reserved
-		CollectionName: collName,
-		PartitionName:  partitionName,
-		FieldsData:     fieldsData,
-	}
-	if req.PartitionName == "" {
-		req.PartitionName = "_default" // use default partition
-	}
-	req.NumRows = uint32(rowSize)
-
-	resp, err := c.Service.Insert(ctx, req)
+	var req *server.InsertRequest
+	var resp *server.MutationResult
+	err := c.writeWithCachedMeta(ctx, collName, partitionName,
+		func(sch *entity.Schema) error {
+			columns, err := build(sch)
+			if err != nil {
+				return err
+			}
+			// convert columns to field data
+			fieldsData, rowSize, err := c.processInsertColumns(sch, columns...)
+			if err != nil {
+				return err
+			}
+			req = &server.InsertRequest{
+				DbName:         "", // reserved
+				CollectionName: collName,
+				PartitionName:  partitionName,
+				FieldsData:     fieldsData,
+				NumRows:        uint32(rowSize),
+			}
+			setSpanAttributes(ctx, AttrRowCount.Int(rowSize))
+			if req.PartitionName == "" {
+				req.PartitionName = "_default" // use default partition
+			}
+			return nil
+		},
+		func() error {
+			var err error
+			resp, err = c.Service.Insert(ctx, req)
+			if err != nil {
+				return err
+			}
+			return c.handleCollectionRespStatus(ctx, collName, resp.GetStatus())
+		})
 	if err != nil {
 		return nil, err
 	}
-	if err := handleRespStatus(resp.GetStatus()); err != nil {
-		return nil, err
-	}
-	MetaCache.setSessionTs(collName, resp.Timestamp)
-	// 3. parse id column
+	c.cache.setSessionTs(c.metaKey(ctx, collName), resp.Timestamp)
+	// parse id column
 	return entity.IDColumns(resp.GetIDs(), 0, -1)
 }
 
@@ -121,13 +125,24 @@ func (c *GrpcClient) processInsertColumns(colSchema *entity.Schema, columns ...e
 		if column.Type() != field.DataType {
 			return nil, 0, fmt.Errorf("param column %s has type %v but collection field definition is %v", column.Name(), column.FieldData(), field.DataType)
 		}
-		if field.DataType == entity.FieldTypeFloatVector || field.DataType == entity.FieldTypeBinaryVector {
+		if arrayColumn, ok := column.(entity.ArrayColumn); ok && arrayColumn.ElementType() != field.ElementType {
+			return nil, 0, fmt.Errorf("param column %s has element type %v but collection field definition is %v", column.Name(), arrayColumn.ElementType(), field.ElementType)
+		}
+		if err := checkNullValues(field, column); err != nil {
+			return nil, 0, err
+		}
+		// sparse vector has no fixed dim
+		if isVectorField(field.DataType) && field.DataType != entity.FieldTypeSparseFloatVector {
 			dim := 0
 			switch column := column.(type) {
 			case *entity.ColumnFloatVector:
 				dim = column.Dim()
 			case *entity.ColumnBinaryVector:
 				dim = column.Dim()
+			case *entity.ColumnFloat16Vector:
+				dim = column.Dim()
+			case *entity.ColumnBFloat16Vector:
+				dim = column.Dim()
 			}
 			if fmt.Sprintf("%d", dim) != field.TypeParams[entity.TypeParamDim] {
 				return nil, 0, fmt.Errorf("params column %s vector dim %d not match collection definition, which has dim of %s", field.Name, dim, field.TypeParams[entity.TypeParamDim])
@@ -138,8 +153,9 @@ func (c *GrpcClient) processInsertColumns(colSchema *entity.Schema, columns ...e
 	// check all fixed field pass value
 	for _, field := range colSchema.Fields {
 		_, has := mNameColumn[field.Name]
+		// nullable field or field with default value could be omitted
 		if !has &&
-			!field.AutoID && !field.IsDynamic {
+			!field.AutoID && !field.IsDynamic && !field.Nullable && field.DefaultValue == nil {
 			return nil, 0, fmt.Errorf("field %s not passed", field.Name)
 		}
 	}
@@ -192,7 +208,9 @@ func (c *GrpcClient) mergeDynamicColumns(dynamicName string, rowSize int, column
 
 // Flush force collection to flush memory records into storage
 // in sync mode, flush will wait all segments to be flushed
-func (c *GrpcClient) Flush(ctx context.Context, collName string, async bool) error {
+func (c *GrpcClient) Flush(ctx context.Context, collName string, async bool) (err error) {
+	ctx, span := c.startSpan(ctx, "Flush", AttrCollection.String(collName))
+	defer func() { endSpan(span, err) }()
 	if c.Service == nil {
 		return ErrClientNotReady
 	}
@@ -207,7 +225,7 @@ func (c *GrpcClient) Flush(ctx context.Context, collName string, async bool) err
 	if err != nil {
 		return err
 	}
-	if err := handleRespStatus(resp.GetStatus()); err != nil {
+	if err := handleRespStatus(ctx, collName, resp.GetStatus()); err != nil {
 		return err
 	}
 	if !async {
@@ -239,26 +257,13 @@ func (c *GrpcClient) Flush(ctx context.Context, collName string, async bool) err
 }
 
 // DeleteByPks deletes entries related to provided primary keys
-func (c *GrpcClient) DeleteByPks(ctx context.Context, collName string, partitionName string, ids entity.Column) error {
+func (c *GrpcClient) DeleteByPks(ctx context.Context, collName string, partitionName string, ids entity.Column) (err error) {
+	ctx, span := c.startSpan(ctx, "DeleteByPks", AttrCollection.String(collName), partitionCount(partitionName))
+	defer func() { endSpan(span, err) }()
 	if c.Service == nil {
 		return ErrClientNotReady
 	}
 
-	// check collection name
-	if err := c.checkCollectionExists(ctx, collName); err != nil {
-		return err
-	}
-	coll, err := c.DescribeCollection(ctx, collName)
-	if err != nil {
-		return err
-	}
-	// check partition name
-	if partitionName != "" {
-		err := c.checkPartitionExists(ctx, collName, partitionName)
-		if err != nil {
-			return err
-		}
-	}
 	// check primary keys
 	if ids.Len() == 0 {
 		return errors.New("ids len must not be zero")
@@ -266,31 +271,37 @@ func (c *GrpcClient) DeleteByPks(ctx context.Context, collName string, partition
 	if ids.Type() != entity.FieldTypeInt64 && ids.Type() != entity.FieldTypeVarChar { // string key not supported yet
 		return errors.New("only int64 and varchar column can be primary key for now")
 	}
+	setSpanAttributes(ctx, AttrRowCount.Int(ids.Len()))
 
-	pkf := getPKField(coll.Schema)
-	// pkf shall not be nil since is returned from milvus
-	if ids.Name() != "" && pkf.Name != ids.Name() {
-		return errors.New("only delete by primary key is supported now")
-	}
-
-	expr := PKs2Expr(pkf.Name, ids)
-
-	req := &server.DeleteRequest{
-		DbName:         "",
-		CollectionName: collName,
-		PartitionName:  partitionName,
-		Expr:           expr,
-	}
-
-	resp, err := c.Service.Delete(ctx, req)
-	if err != nil {
-		return err
-	}
-	err = handleRespStatus(resp.GetStatus())
+	var req *server.DeleteRequest
+	var resp *server.MutationResult
+	err = c.writeWithCachedMeta(ctx, collName, partitionName,
+		func(sch *entity.Schema) error {
+			pkf := getPKField(sch)
+			// pkf shall not be nil since is returned from milvus
+			if ids.Name() != "" && pkf.Name != ids.Name() {
+				return errors.New("only delete by primary key is supported now")
+			}
+			req = &server.DeleteRequest{
+				DbName:         "",
+				CollectionName: collName,
+				PartitionName:  partitionName,
+				Expr:           PKs2Expr(pkf.Name, ids),
+			}
+			return nil
+		},
+		func() error {
+			var err error
+			resp, err = c.Service.Delete(ctx, req)
+			if err != nil {
+				return err
+			}
+			return c.handleCollectionRespStatus(ctx, collName, resp.GetStatus())
+		})
 	if err != nil {
 		return err
 	}
-	MetaCache.setSessionTs(collName, resp.Timestamp)
+	c.cache.setSessionTs(c.metaKey(ctx, collName), resp.Timestamp)
 	return nil
 }
 
@@ -298,29 +309,68 @@ func (c *GrpcClient) DeleteByPks(ctx context.Context, collName string, partition
 // collName is the collection name
 // partitionName is the partition to upsert, if not specified(empty), default partition will be used
 // columns are slice of the column-based data
-func (c *GrpcClient) Upsert(ctx context.Context, collName string, partitionName string, columns ...entity.Column) (entity.Column, error) {
+func (c *GrpcClient) Upsert(ctx context.Context, collName string, partitionName string, columns ...entity.Column) (_ entity.Column, err error) {
+	ctx, span := c.startSpan(ctx, "Upsert", AttrCollection.String(collName), partitionCount(partitionName))
+	defer func() { endSpan(span, err) }()
+	return c.upsert(ctx, collName, partitionName, func(*entity.Schema) ([]entity.Column, error) {
+		return columns, nil
+	})
+}
+
+// upsert upserts the columns built against collection schema, build may be called again with refreshed schema.
+func (c *GrpcClient) upsert(ctx context.Context, collName string, partitionName string,
+	build func(sch *entity.Schema) ([]entity.Column, error)) (entity.Column, error) {
 	if c.Service == nil {
 		return nil, ErrClientNotReady
 	}
-	// 1. validation for all input params
-	// collection
-	if err := c.checkCollectionExists(ctx, collName); err != nil {
-		return nil, err
-	}
-	if partitionName != "" {
-		err := c.checkPartitionExists(ctx, collName, partitionName)
-		if err != nil {
-			return nil, err
-		}
-	}
-	// fields
-	var rowSize int
-	coll, err := c.DescribeCollection(ctx, collName)
+	var req *server.UpsertRequest
+	var resp *server.MutationResult
+	err := c.writeWithCachedMeta(ctx, collName, partitionName,
+		func(sch *entity.Schema) error {
+			columns, err := build(sch)
+			if err != nil {
+				return err
+			}
+			rowSize, err := validateUpsertColumns(sch, collName, columns...)
+			if err != nil {
+				return err
+			}
+			req = &server.UpsertRequest{
+				DbName:         "", // reserved
+				CollectionName: collName,
+				PartitionName:  partitionName,
+				NumRows:        uint32(rowSize),
+			}
+			setSpanAttributes(ctx, AttrRowCount.Int(rowSize))
+			if req.PartitionName == "" {
+				req.PartitionName = "_default" // use default partition
+			}
+			for _, column := range columns {
+				req.FieldsData = append(req.FieldsData, column.FieldData())
+			}
+			return nil
+		},
+		func() error {
+			var err error
+			resp, err = c.Service.Upsert(ctx, req)
+			if err != nil {
+				return err
+			}
+			return c.handleCollectionRespStatus(ctx, collName, resp.GetStatus())
+		})
 	if err != nil {
 		return nil, err
 	}
+	c.cache.setSessionTs(c.metaKey(ctx, collName), resp.Timestamp)
+	// parse id column
+	return entity.IDColumns(resp.GetIDs(), 0, -1)
+}
+
+// validateUpsertColumns checks the columns match the collection schema and returns the row count.
+func validateUpsertColumns(sch *entity.Schema, collName string, columns ...entity.Column) (int, error) {
+	var rowSize int
 	mNameField := make(map[string]*entity.Field)
-	for _, field := range coll.Schema.Fields {
+	for _, field := range sch.Fields {
 		mNameField[field.Name] = field
 	}
 	mNameColumn := make(map[string]entity.Column)
@@ -331,63 +381,67 @@ func (c *GrpcClient) Upsert(ctx context.Context, collName string, partitionName
 			rowSize = l
 		} else {
 			if rowSize != l {
-				return nil, errors.New("column size not match")
+				return 0, errors.New("column size not match")
 			}
 		}
 		field, has := mNameField[column.Name()]
 		if !has {
-			return nil, fmt.Errorf("field %s does not exist in collection %s", column.Name(), collName)
+			return 0, fmt.Errorf("field %s does not exist in collection %s", column.Name(), collName)
 		}
 		if column.Type() != field.DataType {
-			return nil, fmt.Errorf("param column %s has type %v but collection field definition is %v", column.Name(), column.FieldData(), field.DataType)
+			return 0, fmt.Errorf("param column %s has type %v but collection field definition is %v", column.Name(), column.FieldData(), field.DataType)
+		}
+		if arrayColumn, ok := column.(entity.ArrayColumn); ok && arrayColumn.ElementType() != field.ElementType {
+			return 0, fmt.Errorf("param column %s has element type %v but collection field definition is %v", column.Name(), arrayColumn.ElementType(), field.ElementType)
+		}
+		if err := checkNullValues(field, column); err != nil {
+			return 0, err
 		}
-		if field.DataType == entity.FieldTypeFloatVector || field.DataType == entity.FieldTypeBinaryVector {
+		// sparse vector has no fixed dim
+		if isVectorField(field.DataType) && field.DataType != entity.FieldTypeSparseFloatVector {
 			dim := 0
 			switch column := column.(type) {
 			case *entity.ColumnFloatVector:
 				dim = column.Dim()
 			case *entity.ColumnBinaryVector:
 				dim = column.Dim()
+			case *entity.ColumnFloat16Vector:
+				dim = column.Dim()
+			case *entity.ColumnBFloat16Vector:
+				dim = column.Dim()
 			}
 			if fmt.Sprintf("%d", dim) != field.TypeParams[entity.TypeParamDim] {
-				return nil, fmt.Errorf("params column %s vector dim %d not match collection definition, which has dim of %s", field.Name, dim, field.TypeParams[entity.TypeParamDim])
+				return 0, fmt.Errorf("params column %s vector dim %d not match collection definition, which has dim of %s", field.Name, dim, field.TypeParams[entity.TypeParamDim])
 			}
 		}
 	}
-	for _, field := range coll.Schema.Fields {
+	for _, field := range sch.Fields {
 		_, has := mNameColumn[field.Name]
-		if !has && !field.AutoID {
-			return nil, fmt.Errorf("field %s not passed", field.Name)
+		if !has && !field.AutoID && !field.Nullable && field.DefaultValue == nil {
+			return 0, fmt.Errorf("field %s not passed", field.Name)
 		}
 	}
+	return rowSize, nil
+}
 
-	// 2. do upsert request
-	req := &server.UpsertRequest{
-		DbName:         "", // reserved
-		CollectionName: collName,
-		PartitionName:  partitionName,
-	}
-	if req.PartitionName == "" {
-		req.PartitionName = "_default" // use default partition
-	}
-	req.NumRows = uint32(rowSize)
-	for _, column := range columns {
-		req.FieldsData = append(req.FieldsData, column.FieldData())
-	}
-	resp, err := c.Service.Upsert(ctx, req)
-	if err != nil {
-		return nil, err
+// checkNullValues checks column contains null values only when field is nullable or has default value.
+func checkNullValues(field *entity.Field, column entity.Column) error {
+	nullableColumn, ok := column.(entity.NullableColumn)
+	if !ok || field.Nullable || field.DefaultValue != nil {
+		return nil
 	}
-	if err := handleRespStatus(resp.GetStatus()); err != nil {
-		return nil, err
+	for _, valid := range nullableColumn.ValidData() {
+		if !valid {
+			return fmt.Errorf("param column %s contains null value but field is not nullable and has no default value", column.Name())
+		}
 	}
-	MetaCache.setSessionTs(collName, resp.Timestamp)
-	// 3. parse id column
-	return entity.IDColumns(resp.GetIDs(), 0, -1)
+	return nil
 }
 
 // BulkInsert data files(json, numpy, etc.) on MinIO/S3 storage, read and parse them into sealed segments
-func (c *GrpcClient) BulkInsert(ctx context.Context, collName string, partitionName string, files []string, opts ...BulkInsertOption) (int64, error) {
+func (c *GrpcClient) BulkInsert(ctx context.Context, collName string, partitionName string, files []string, opts ...BulkInsertOption) (_ int64, err error) {
+	ctx, span := c.startSpan(ctx, "BulkInsert", AttrCollection.String(collName), partitionCount(partitionName))
+	defer func() { endSpan(span, err) }()
 	if c.Service == nil {
 		return 0, ErrClientNotReady
 	}
@@ -405,7 +459,7 @@ func (c *GrpcClient) BulkInsert(ctx context.Context, collName string, partitionN
 	if err != nil {
 		return 0, err
 	}
-	if err := handleRespStatus(resp.GetStatus()); err != nil {
+	if err := handleRespStatus(ctx, collName, resp.GetStatus()); err != nil {
 		return 0, err
 	}
 
@@ -413,7 +467,9 @@ func (c *GrpcClient) BulkInsert(ctx context.Context, collName string, partitionN
 }
 
 // GetBulkInsertState checks import task state
-func (c *GrpcClient) GetBulkInsertState(ctx context.Context, taskID int64) (*entity.BulkInsertTaskState, error) {
+func (c *GrpcClient) GetBulkInsertState(ctx context.Context, taskID int64) (_ *entity.BulkInsertTaskState, err error) {
+	ctx, span := c.startSpan(ctx, "GetBulkInsertState")
+	defer func() { endSpan(span, err) }()
 	if c.Service == nil {
 		return nil, ErrClientNotReady
 	}
@@ -424,7 +480,7 @@ func (c *GrpcClient) GetBulkInsertState(ctx context.Context, taskID int64) (*ent
 	if err != nil {
 		return nil, err
 	}
-	if err := handleRespStatus(resp.GetStatus()); err != nil {
+	if err := handleRespStatus(ctx, "", resp.GetStatus()); err != nil {
 		return nil, err
 	}
 
@@ -441,7 +497,9 @@ func (c *GrpcClient) GetBulkInsertState(ctx context.Context, taskID int64) (*ent
 }
 
 // ListBulkInsertTasks list state of all import tasks
-func (c *GrpcClient) ListBulkInsertTasks(ctx context.Context, collName string, limit int64) ([]*entity.BulkInsertTaskState, error) {
+func (c *GrpcClient) ListBulkInsertTasks(ctx context.Context, collName string, limit int64) (_ []*entity.BulkInsertTaskState, err error) {
+	ctx, span := c.startSpan(ctx, "ListBulkInsertTasks", AttrCollection.String(collName))
+	defer func() { endSpan(span, err) }()
 	if c.Service == nil {
 		return nil, ErrClientNotReady
 	}
@@ -453,7 +511,7 @@ func (c *GrpcClient) ListBulkInsertTasks(ctx context.Context, collName string, l
 	if err != nil {
 		return nil, err
 	}
-	if err := handleRespStatus(resp.GetStatus()); err != nil {
+	if err := handleRespStatus(ctx, collName, resp.GetStatus()); err != nil {
 		return nil, err
 	}
 
@@ -499,6 +557,12 @@ func vector2Placeholder(vectors []entity.Vector) *common.PlaceholderValue {
 		placeHolderType = common.PlaceholderType_FloatVector
 	case entity.BinaryVector:
 		placeHolderType = common.PlaceholderType_BinaryVector
+	case entity.Float16Vector:
+		placeHolderType = common.PlaceholderType_Float16Vector
+	case entity.BFloat16Vector:
+		placeHolderType = common.PlaceholderType_BFloat16Vector
+	case entity.SparseFloatVector:
+		placeHolderType = common.PlaceholderType_SparseFloatVector
 	}
 	ph.Type = placeHolderType
 	for _, vector := range vectors {
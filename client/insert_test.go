@@ -13,6 +13,7 @@ package client
 
 import (
 	"context"
+	"net"
 	"testing"
 
 	"github.com/cockroachdb/errors"
@@ -20,8 +21,11 @@ import (
 	server "github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	schema "github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus-sdk-go/v2/entity"
+	"github.com/milvus-io/milvus-sdk-go/v2/mocks"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
 )
 
 type InsertSuite struct {
@@ -35,14 +39,19 @@ func (s *InsertSuite) TestInsertFail() {
 
 	s.Run("collection_not_exist", func() {
 		defer s.resetMock()
-		s.setupHasCollection()
+		s.setupDescribeCollectionError(common.ErrorCode_CollectionNotExists, nil)
 		_, err := c.Insert(ctx, testCollectionName, "")
 		s.Error(err)
+		s.ErrorIs(err, ErrNotFound)
+		s.ErrorAs(err, &ErrCollectionNotExists{})
 	})
 
 	s.Run("partition_not_exist", func() {
 		defer s.resetMock()
-		s.setupHasCollection(testCollectionName)
+		s.setupDescribeCollection(testCollectionName, entity.NewSchema().
+			WithField(entity.NewField().WithIsPrimaryKey(true).WithIsAutoID(true).WithName("ID").WithDataType(entity.FieldTypeInt64)).
+			WithField(entity.NewField().WithName("vector").WithDataType(entity.FieldTypeFloatVector).WithTypeParams(entity.TypeParamDim, "128")),
+		)
 		s.setupHasPartition(testCollectionName)
 
 		_, err := c.Insert(ctx, testCollectionName, "partition_name")
@@ -51,7 +60,6 @@ func (s *InsertSuite) TestInsertFail() {
 
 	s.Run("field_not_exist", func() {
 		defer s.resetMock()
-		s.setupHasCollection(testCollectionName)
 		s.setupHasPartition(testCollectionName, "partition_1")
 		s.setupDescribeCollection(testCollectionName, entity.NewSchema().
 			WithField(entity.NewField().WithIsPrimaryKey(true).WithIsAutoID(true).WithName("ID").WithDataType(entity.FieldTypeInt64)).
@@ -66,7 +74,6 @@ func (s *InsertSuite) TestInsertFail() {
 
 	s.Run("missing_field", func() {
 		defer s.resetMock()
-		s.setupHasCollection(testCollectionName)
 		s.setupHasPartition(testCollectionName, "partition_1")
 
 		s.setupDescribeCollection(testCollectionName, entity.NewSchema().
@@ -82,7 +89,6 @@ func (s *InsertSuite) TestInsertFail() {
 
 	s.Run("column_len_not_match", func() {
 		defer s.resetMock()
-		s.setupHasCollection(testCollectionName)
 		s.setupHasPartition(testCollectionName, "partition_1")
 
 		s.setupDescribeCollection(testCollectionName, entity.NewSchema().
@@ -99,7 +105,6 @@ func (s *InsertSuite) TestInsertFail() {
 
 	s.Run("duplicated column", func() {
 		defer s.resetMock()
-		s.setupHasCollection(testCollectionName)
 		s.setupHasPartition(testCollectionName, "partition_1")
 
 		s.setupDescribeCollection(testCollectionName, entity.NewSchema().
@@ -131,7 +136,6 @@ func (s *InsertSuite) TestInsertFail() {
 
 	s.Run("dim_not_match", func() {
 		defer s.resetMock()
-		s.setupHasCollection(testCollectionName)
 		s.setupHasPartition(testCollectionName, "partition_1")
 
 		s.setupDescribeCollection(testCollectionName, entity.NewSchema().
@@ -146,9 +150,42 @@ func (s *InsertSuite) TestInsertFail() {
 		s.Error(err)
 	})
 
+	s.Run("half_vector_dim_not_match", func() {
+		defer s.resetMock()
+		s.setupHasPartition(testCollectionName, "partition_1")
+
+		s.setupDescribeCollection(testCollectionName, entity.NewSchema().
+			WithField(entity.NewField().WithIsPrimaryKey(true).WithIsAutoID(true).WithName("ID").WithDataType(entity.FieldTypeInt64)).
+			WithField(entity.NewField().WithName("vector").WithDataType(entity.FieldTypeFloat16Vector).WithDim(128)),
+		)
+
+		_, err := c.Insert(ctx, testCollectionName, "partition_1",
+			entity.NewColumnFloat16VectorFromFloat32("vector", 8, generateFloatVector(1, 8)),
+		)
+		s.Error(err)
+	})
+
+	s.Run("null_value_not_nullable", func() {
+		defer s.resetMock()
+		s.setupHasPartition(testCollectionName, "partition_1")
+
+		s.setupDescribeCollection(testCollectionName, entity.NewSchema().
+			WithField(entity.NewField().WithIsPrimaryKey(true).WithIsAutoID(true).WithName("ID").WithDataType(entity.FieldTypeInt64)).
+			WithField(entity.NewField().WithName("age").WithDataType(entity.FieldTypeInt64)).
+			WithField(entity.NewField().WithName("vector").WithDataType(entity.FieldTypeFloatVector).WithDim(8)),
+		)
+
+		age, err := entity.NewNullableColumnInt64("age", []int64{0}, []bool{false})
+		s.Require().NoError(err)
+		_, err = c.Insert(ctx, testCollectionName, "partition_1",
+			age,
+			entity.NewColumnFloatVector("vector", 8, generateFloatVector(1, 8)),
+		)
+		s.Error(err)
+	})
+
 	s.Run("server_insert_fail", func() {
 		defer s.resetMock()
-		s.setupHasCollection(testCollectionName)
 		s.setupHasPartition(testCollectionName, "partition_1")
 
 		s.setupDescribeCollection(testCollectionName, entity.NewSchema().
@@ -169,7 +206,6 @@ func (s *InsertSuite) TestInsertFail() {
 
 	s.Run("server_connection_error", func() {
 		defer s.resetMock()
-		s.setupHasCollection(testCollectionName)
 		s.setupHasPartition(testCollectionName, "partition_1")
 
 		s.setupDescribeCollection(testCollectionName, entity.NewSchema().
@@ -196,7 +232,6 @@ func (s *InsertSuite) TestInsertSuccess() {
 
 	s.Run("non_dynamic_schema", func() {
 		defer s.resetMock()
-		s.setupHasCollection(testCollectionName)
 		s.setupHasPartition(testCollectionName, "partition_1")
 
 		s.setupDescribeCollection(testCollectionName, entity.NewSchema().
@@ -226,9 +261,135 @@ func (s *InsertSuite) TestInsertSuccess() {
 		s.Equal(1, r.Len())
 	})
 
+	s.Run("half_vector_fields", func() {
+		defer s.resetMock()
+		s.setupHasPartition(testCollectionName, "partition_1")
+
+		s.setupDescribeCollection(testCollectionName, entity.NewSchema().
+			WithField(entity.NewField().WithIsPrimaryKey(true).WithIsAutoID(true).WithName("ID").WithDataType(entity.FieldTypeInt64)).
+			WithField(entity.NewField().WithName("fp16").WithDataType(entity.FieldTypeFloat16Vector).WithDim(128)).
+			WithField(entity.NewField().WithName("bf16").WithDataType(entity.FieldTypeBFloat16Vector).WithDim(128)),
+		)
+
+		s.mock.EXPECT().Insert(mock.Anything, mock.AnythingOfType("*milvuspb.InsertRequest")).
+			Run(func(ctx context.Context, req *server.InsertRequest) {
+				s.Require().Equal(2, len(req.GetFieldsData()))
+				for _, fd := range req.GetFieldsData() {
+					s.EqualValues(128, fd.GetVectors().GetDim())
+					switch fd.GetFieldName() {
+					case "fp16":
+						s.Equal(schema.DataType_Float16Vector, fd.GetType())
+						s.Equal(256, len(fd.GetVectors().GetFloat16Vector()))
+					case "bf16":
+						s.Equal(schema.DataType_BFloat16Vector, fd.GetType())
+						s.Equal(256, len(fd.GetVectors().GetBfloat16Vector()))
+					}
+				}
+			}).Return(&server.MutationResult{
+			Status: &common.Status{},
+			IDs: &schema.IDs{
+				IdField: &schema.IDs_IntId{
+					IntId: &schema.LongArray{
+						Data: []int64{1},
+					},
+				},
+			},
+		}, nil)
+
+		vectors := generateFloatVector(1, 128)
+		r, err := c.Insert(ctx, testCollectionName, "partition_1",
+			entity.NewColumnFloat16VectorFromFloat32("fp16", 128, vectors),
+			entity.NewColumnBFloat16VectorFromFloat32("bf16", 128, vectors),
+		)
+
+		s.NoError(err)
+		s.Equal(1, r.Len())
+	})
+
+	s.Run("sparse_vector_field", func() {
+		defer s.resetMock()
+		s.setupHasPartition(testCollectionName, "partition_1")
+
+		s.setupDescribeCollection(testCollectionName, entity.NewSchema().
+			WithField(entity.NewField().WithIsPrimaryKey(true).WithIsAutoID(true).WithName("ID").WithDataType(entity.FieldTypeInt64)).
+			WithField(entity.NewField().WithName("sparse").WithDataType(entity.FieldTypeSparseFloatVector)),
+		)
+
+		vector, err := entity.NewSparseFloatVector([]uint32{1, 100}, []float32{0.1, 0.2})
+		s.Require().NoError(err)
+
+		s.mock.EXPECT().Insert(mock.Anything, mock.AnythingOfType("*milvuspb.InsertRequest")).
+			Run(func(ctx context.Context, req *server.InsertRequest) {
+				s.Require().Equal(1, len(req.GetFieldsData()))
+				fd := req.GetFieldsData()[0]
+				s.Equal(schema.DataType_SparseFloatVector, fd.GetType())
+				s.EqualValues(101, fd.GetVectors().GetSparseFloatVector().GetDim())
+				s.Equal([][]byte{vector.Serialize()}, fd.GetVectors().GetSparseFloatVector().GetContents())
+			}).Return(&server.MutationResult{
+			Status: &common.Status{},
+			IDs: &schema.IDs{
+				IdField: &schema.IDs_IntId{
+					IntId: &schema.LongArray{
+						Data: []int64{1},
+					},
+				},
+			},
+		}, nil)
+
+		r, err := c.Insert(ctx, testCollectionName, "partition_1",
+			entity.NewColumnSparseFloatVector("sparse", []entity.SparseFloatVector{vector}),
+		)
+
+		s.NoError(err)
+		s.Equal(1, r.Len())
+	})
+
+	s.Run("nullable_and_default_fields", func() {
+		defer s.resetMock()
+		s.setupHasPartition(testCollectionName, "partition_1")
+
+		s.setupDescribeCollection(testCollectionName, entity.NewSchema().
+			WithField(entity.NewField().WithIsPrimaryKey(true).WithIsAutoID(true).WithName("ID").WithDataType(entity.FieldTypeInt64)).
+			WithField(entity.NewField().WithName("age").WithDataType(entity.FieldTypeInt64).WithNullable(true)).
+			WithField(entity.NewField().WithName("name").WithDataType(entity.FieldTypeVarChar).WithMaxLength(32).WithDefaultValue("unknown")).
+			WithField(entity.NewField().WithName("vector").WithDataType(entity.FieldTypeFloatVector).WithDim(8)),
+		)
+
+		s.mock.EXPECT().Insert(mock.Anything, mock.AnythingOfType("*milvuspb.InsertRequest")).
+			Run(func(ctx context.Context, req *server.InsertRequest) {
+				// field with default value omitted, server fills default value
+				s.Require().Equal(2, len(req.GetFieldsData()))
+				for _, fd := range req.GetFieldsData() {
+					if fd.GetFieldName() != "age" {
+						continue
+					}
+					s.Equal([]int64{20}, fd.GetScalars().GetLongData().GetData())
+					s.Equal([]bool{false, true}, fd.GetValidData())
+				}
+			}).Return(&server.MutationResult{
+			Status: &common.Status{},
+			IDs: &schema.IDs{
+				IdField: &schema.IDs_IntId{
+					IntId: &schema.LongArray{
+						Data: []int64{1, 2},
+					},
+				},
+			},
+		}, nil)
+
+		age, err := entity.NewNullableColumnInt64("age", []int64{0, 20}, []bool{false, true})
+		s.Require().NoError(err)
+		r, err := c.Insert(ctx, testCollectionName, "partition_1",
+			age,
+			entity.NewColumnFloatVector("vector", 8, generateFloatVector(2, 8)),
+		)
+
+		s.NoError(err)
+		s.Equal(2, r.Len())
+	})
+
 	s.Run("dynamic_field_schema", func() {
 		defer s.resetMock()
-		s.setupHasCollection(testCollectionName)
 		s.setupHasPartition(testCollectionName, "partition_1")
 
 		s.setupDescribeCollection(testCollectionName, entity.NewSchema().
@@ -269,6 +430,161 @@ func (s *InsertSuite) TestInsertSuccess() {
 	})
 }
 
+func (s *InsertSuite) TestInsertCachedMeta() {
+	c := s.client
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	sch := entity.NewSchema().WithName(testCollectionName).
+		WithField(entity.NewField().WithIsPrimaryKey(true).WithIsAutoID(true).WithName("ID").WithDataType(entity.FieldTypeInt64)).
+		WithField(entity.NewField().WithName("vector").WithDataType(entity.FieldTypeFloatVector).WithTypeParams(entity.TypeParamDim, "128"))
+	insertResult := &server.MutationResult{
+		Status: &common.Status{},
+		IDs:    &schema.IDs{IdField: &schema.IDs_IntId{IntId: &schema.LongArray{Data: []int64{1}}}},
+	}
+	countCalls := func(method string) int {
+		count := 0
+		for _, call := range s.mock.Calls {
+			if call.Method == method {
+				count++
+			}
+		}
+		return count
+	}
+
+	s.Run("no_meta_request_once_cached", func() {
+		defer s.resetMock()
+		s.setupDescribeCollection(testCollectionName, sch)
+		s.setupHasPartition(testCollectionName, "partition_1")
+		s.mock.EXPECT().Insert(mock.Anything, mock.Anything).Return(insertResult, nil)
+
+		for i := 0; i < 3; i++ {
+			_, err := c.Insert(ctx, testCollectionName, "partition_1",
+				entity.NewColumnFloatVector("vector", 128, generateFloatVector(1, 128)))
+			s.Require().NoError(err)
+		}
+		s.Equal(1, countCalls("DescribeCollection"))
+		s.Equal(1, countCalls("HasPartition"))
+		s.Equal(0, countCalls("HasCollection"))
+		s.Equal(3, countCalls("Insert"))
+	})
+
+	s.Run("refresh_on_schema_mismatch", func() {
+		defer s.resetMock()
+		s.setupDescribeCollection(testCollectionName, sch)
+		s.mock.EXPECT().Insert(mock.Anything, mock.Anything).Return(&server.MutationResult{
+			Status: &common.Status{ErrorCode: common.ErrorCode_UnexpectedError, Code: codeCollectionSchemaMismatch, Reason: "collection schema mismatch"},
+		}, nil).Once()
+		s.mock.EXPECT().Insert(mock.Anything, mock.Anything).Return(insertResult, nil).Once()
+
+		_, err := c.DescribeCollection(ctx, testCollectionName)
+		s.Require().NoError(err)
+		_, err = c.Insert(ctx, testCollectionName, "", entity.NewColumnFloatVector("vector", 128, generateFloatVector(1, 128)))
+		s.NoError(err)
+		s.Equal(2, countCalls("DescribeCollection"))
+		s.Equal(2, countCalls("Insert"))
+	})
+
+	s.Run("retry_once_only", func() {
+		defer s.resetMock()
+		s.setupDescribeCollection(testCollectionName, sch)
+		s.mock.EXPECT().Insert(mock.Anything, mock.Anything).Return(&server.MutationResult{
+			Status: &common.Status{ErrorCode: common.ErrorCode_UnexpectedError, Code: codeCollectionSchemaMismatch, Reason: "collection schema mismatch"},
+		}, nil)
+
+		_, err := c.Insert(ctx, testCollectionName, "", entity.NewColumnFloatVector("vector", 128, generateFloatVector(1, 128)))
+		s.Error(err)
+		s.Equal(2, countCalls("Insert"))
+	})
+
+	s.Run("no_retry_on_user_error", func() {
+		defer s.resetMock()
+		s.setupDescribeCollection(testCollectionName, sch)
+		s.mock.EXPECT().Insert(mock.Anything, mock.Anything).Return(&server.MutationResult{
+			Status: &common.Status{ErrorCode: common.ErrorCode_IllegalArgument, Reason: "schema mismatch: dim"},
+		}, nil)
+
+		_, err := c.Insert(ctx, testCollectionName, "", entity.NewColumnFloatVector("vector", 128, generateFloatVector(1, 128)))
+		s.Error(err)
+		s.Equal(1, countCalls("DescribeCollection"))
+		s.Equal(1, countCalls("Insert"))
+	})
+
+	s.Run("refresh_on_stale_cached_schema", func() {
+		defer s.resetMock()
+		// cached schema without the "extra" field
+		s.client.(*GrpcClient).cache.setCollectionInfo(s.client.(*GrpcClient).metaKey(ctx, testCollectionName), &collInfo{Name: testCollectionName, Schema: sch})
+		s.setupDescribeCollection(testCollectionName, entity.NewSchema().WithName(testCollectionName).
+			WithField(entity.NewField().WithIsPrimaryKey(true).WithIsAutoID(true).WithName("ID").WithDataType(entity.FieldTypeInt64)).
+			WithField(entity.NewField().WithName("extra").WithDataType(entity.FieldTypeInt64)).
+			WithField(entity.NewField().WithName("vector").WithDataType(entity.FieldTypeFloatVector).WithTypeParams(entity.TypeParamDim, "128")))
+		s.mock.EXPECT().Insert(mock.Anything, mock.Anything).Return(insertResult, nil)
+
+		_, err := c.Insert(ctx, testCollectionName, "",
+			entity.NewColumnInt64("extra", []int64{1}),
+			entity.NewColumnFloatVector("vector", 128, generateFloatVector(1, 128)))
+		s.NoError(err)
+		s.Equal(1, countCalls("DescribeCollection"))
+	})
+}
+
 func TestGrpcInsert(t *testing.T) {
 	suite.Run(t, new(InsertSuite))
 }
+
+// BenchmarkInsert compares the small batch insert with cached collection meta and without.
+func BenchmarkInsert(b *testing.B) {
+	lis := bufconn.Listen(bufSize)
+	svr := grpc.NewServer()
+	m := &mocks.MilvusServiceServer{}
+	sch := entity.NewSchema().WithName(testCollectionName).
+		WithField(entity.NewField().WithIsPrimaryKey(true).WithIsAutoID(true).WithName("ID").WithDataType(entity.FieldTypeInt64)).
+		WithField(entity.NewField().WithName("vector").WithDataType(entity.FieldTypeFloatVector).WithTypeParams(entity.TypeParamDim, "128"))
+	m.EXPECT().Connect(mock.Anything, mock.Anything).Return(&server.ConnectResponse{Status: &common.Status{}, Identifier: 1}, nil).Maybe()
+	m.EXPECT().DescribeCollection(mock.Anything, mock.Anything).
+		Return(&server.DescribeCollectionResponse{Status: &common.Status{}, Schema: sch.ProtoMessage()}, nil).Maybe()
+	m.EXPECT().HasPartition(mock.Anything, mock.Anything).Return(&server.BoolResponse{Status: &common.Status{}, Value: true}, nil).Maybe()
+	m.EXPECT().Insert(mock.Anything, mock.Anything).Return(&server.MutationResult{
+		Status: &common.Status{},
+		IDs:    &schema.IDs{IdField: &schema.IDs_IntId{IntId: &schema.LongArray{Data: []int64{1}}}},
+	}, nil).Maybe()
+	server.RegisterMilvusServiceServer(svr, m)
+	go svr.Serve(lis)
+	defer svr.Stop()
+
+	ctx := context.Background()
+	c, err := NewClient(ctx, Config{
+		Address: "bufnet",
+		DialOptions: []grpc.DialOption{
+			grpc.WithBlock(),
+			grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+				return lis.Dial()
+			}),
+		},
+	})
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer c.Close()
+	column := entity.NewColumnFloatVector("vector", 128, generateFloatVector(10, 128))
+
+	b.Run("cached", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if _, err := c.Insert(ctx, testCollectionName, "part", column); err != nil {
+				b.Fatal(err)
+			}
+			// mock records every call
+			m.Calls = nil
+		}
+	})
+
+	b.Run("uncached", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			c.(*GrpcClient).invalidateSchema(ctx, testCollectionName)
+			if _, err := c.Insert(ctx, testCollectionName, "part", column); err != nil {
+				b.Fatal(err)
+			}
+			m.Calls = nil
+		}
+	})
+}
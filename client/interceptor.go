@@ -48,16 +48,26 @@ func databaseNameInterceptor(ctx context.Context, dbNameGetter func() string) co
 	return ctx
 }
 
-// createMetaDataUnaryInterceptor creates a unary interceptor for metadata information.
-func createMetaDataUnaryInterceptor(cfg *Config) grpc.UnaryClientInterceptor {
+// createMetaDataUnaryInterceptor creates a unary interceptor for metadata information,
+// requests without database in context are applied to the database returned by dbNameGetter.
+func createMetaDataUnaryInterceptor(cfg *Config, dbNameGetter func() string) grpc.UnaryClientInterceptor {
 	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
-		ctx = authenticationInterceptor(ctx, cfg.Username, cfg.Password)
-		ctx = apiKeyInterceptor(ctx, cfg.APIKey)
-		ctx = identifierInterceptor(ctx, func() string {
-			return cfg.Identifier
-		})
+		// credential from provider is appended by credentialInterceptor
+		if cfg.CredentialProvider == nil {
+			ctx = authenticationInterceptor(ctx, cfg.Username, cfg.Password)
+			ctx = apiKeyInterceptor(ctx, cfg.APIKey)
+		}
+		// identifier of each endpoint is appended by endpoint pool if there are multiple endpoints
+		if len(cfg.parsedEndpoints) <= 1 {
+			ctx = identifierInterceptor(ctx, func() string {
+				return cfg.getIdentifier()
+			})
+		}
 		ctx = databaseNameInterceptor(ctx, func() string {
-			return cfg.DBName
+			if dbName, ok := DatabaseFromContext(ctx); ok {
+				return dbName
+			}
+			return dbNameGetter()
 		})
 		return invoker(ctx, method, req, reply, cc, opts...)
 	}
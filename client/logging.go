@@ -0,0 +1,178 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	common "github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// clientLogger writes the sdk logs into Config.Logger, all methods are no-op if no logger provided.
+//
+// The log level set by WithDebugLogLevel and similar helpers overrides the handler level for the call,
+// and the request id set by WithClientRequestID is attached to the records.
+type clientLogger struct {
+	logger *slog.Logger
+}
+
+func newClientLogger(logger *slog.Logger) clientLogger {
+	return clientLogger{logger: logger}
+}
+
+// outgoingValue returns the last value of key in outgoing metadata.
+func outgoingValue(ctx context.Context, key string) string {
+	md, _ := metadata.FromOutgoingContext(ctx)
+	values := md.Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[len(values)-1]
+}
+
+// levelOf returns the log level attached to outgoing context.
+func levelOf(ctx context.Context) (slog.Level, bool) {
+	switch outgoingValue(ctx, logLevelRPCMetaKey) {
+	case debugLevel:
+		return slog.LevelDebug, true
+	case infoLevel:
+		return slog.LevelInfo, true
+	case warnLevel:
+		return slog.LevelWarn, true
+	case errorLevel:
+		return slog.LevelError, true
+	default:
+		return 0, false
+	}
+}
+
+// requestIDOf returns the client request id attached to outgoing context.
+func requestIDOf(ctx context.Context) string {
+	return outgoingValue(ctx, clientRequestIDKey)
+}
+
+// enabled reports whether record at level shall be written for the call made with ctx.
+func (l clientLogger) enabled(ctx context.Context, level slog.Level) bool {
+	if l.logger == nil {
+		return false
+	}
+	if ctxLevel, ok := levelOf(ctx); ok {
+		return level >= ctxLevel
+	}
+	return l.logger.Enabled(ctx, level)
+}
+
+func (l clientLogger) log(ctx context.Context, level slog.Level, msg string, attrs ...slog.Attr) {
+	if !l.enabled(ctx, level) {
+		return
+	}
+	record := slog.NewRecord(time.Now(), level, msg, 0)
+	if requestID := requestIDOf(ctx); requestID != "" {
+		record.AddAttrs(slog.String("request_id", requestID))
+	}
+	record.AddAttrs(attrs...)
+	// handler level is bypassed on purpose when level is overridden by context
+	_ = l.logger.Handler().Handle(ctx, record)
+}
+
+// retry logs a retry about to happen after backoff, rate limited requests are logged separately.
+func (l clientLogger) retry(ctx context.Context, method string, attempt uint, backoff time.Duration, err error, rspStatus *common.Status) {
+	attrs := []slog.Attr{
+		slog.String("method", method),
+		slog.Uint64("attempt", uint64(attempt)),
+		slog.Duration("backoff", backoff),
+	}
+	switch {
+	case err != nil:
+		l.log(ctx, slog.LevelWarn, "retrying request", append(attrs, slog.String("code", status.Code(err).String()), slog.String("error", err.Error()))...)
+	case rspStatus.GetErrorCode() == common.ErrorCode_RateLimit:
+		l.log(ctx, slog.LevelWarn, "rate limited, backing off", append(attrs, slog.String("reason", rspStatus.GetReason()))...)
+	default:
+		l.log(ctx, slog.LevelWarn, "retrying request", append(attrs, slog.String("error_code", rspStatus.GetErrorCode().String()), slog.String("reason", rspStatus.GetReason()))...)
+	}
+}
+
+// reconnect logs the progress of re-establishing the handshake with server.
+func (l clientLogger) reconnect(ctx context.Context, attempt int, err error) {
+	if err != nil {
+		l.log(ctx, slog.LevelWarn, "reconnect failed", slog.Int("attempt", attempt), slog.String("error", err.Error()))
+		return
+	}
+	l.log(ctx, slog.LevelInfo, "reconnected", slog.Int("attempt", attempt))
+}
+
+// eject logs the endpoint excluded from picking.
+func (l clientLogger) eject(ctx context.Context, address string, ejection time.Duration, err error) {
+	attrs := []slog.Attr{slog.String("endpoint", address), slog.Duration("ejection", ejection)}
+	if err != nil {
+		attrs = append(attrs, slog.String("error", err.Error()))
+	}
+	l.log(ctx, slog.LevelWarn, "endpoint ejected", attrs...)
+}
+
+// payloadSize returns the encoded size of proto message, or -1 for other values.
+func payloadSize(msg interface{}) int {
+	if m, ok := msg.(proto.Message); ok {
+		return proto.Size(m)
+	}
+	return -1
+}
+
+// loggingInterceptor logs the payload sizes of each request at debug level,
+// and the requests taking longer than slowThreshold at warn level, retries included.
+func loggingInterceptor(logger clientLogger, slowThreshold time.Duration) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		start := time.Now()
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		elapsed := time.Since(start)
+
+		slow := slowThreshold > 0 && elapsed >= slowThreshold
+		level := slog.LevelDebug
+		if slow {
+			level = slog.LevelWarn
+		}
+		if !logger.enabled(ctx, level) {
+			return err
+		}
+
+		attrs := []slog.Attr{
+			slog.String("method", methodName(method)),
+			slog.Duration("elapsed", elapsed),
+			slog.Int("request_bytes", payloadSize(req)),
+		}
+		if collection := collectionOf(req); collection != "" {
+			attrs = append(attrs, slog.String("collection", collection))
+		}
+		if err == nil {
+			attrs = append(attrs, slog.Int("response_bytes", payloadSize(reply)))
+		} else {
+			attrs = append(attrs, slog.String("error", err.Error()))
+		}
+		if slow {
+			logger.log(ctx, level, "slow request", append(attrs, slog.Duration("threshold", slowThreshold))...)
+		} else {
+			logger.log(ctx, level, "request", attrs...)
+		}
+		return err
+	}
+}
@@ -0,0 +1,146 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"testing"
+	"time"
+
+	common "github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	server "github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+// logRecords decodes the records written by json handler.
+func logRecords(t *testing.T, buf *bytes.Buffer) []map[string]interface{} {
+	t.Helper()
+	var records []map[string]interface{}
+	decoder := json.NewDecoder(buf)
+	for decoder.More() {
+		record := map[string]interface{}{}
+		require.NoError(t, decoder.Decode(&record))
+		records = append(records, record)
+	}
+	buf.Reset()
+	return records
+}
+
+func TestLevelOf(t *testing.T) {
+	ctx := context.Background()
+	_, ok := levelOf(ctx)
+	assert.False(t, ok)
+
+	for ctx, expected := range map[context.Context]slog.Level{
+		WithDebugLogLevel(ctx): slog.LevelDebug,
+		WithInfoLogLevel(ctx):  slog.LevelInfo,
+		WithWarnLogLevel(ctx):  slog.LevelWarn,
+		WithErrorLogLevel(ctx): slog.LevelError,
+		// last one wins
+		WithErrorLogLevel(WithDebugLogLevel(ctx)): slog.LevelError,
+	} {
+		level, ok := levelOf(ctx)
+		assert.True(t, ok)
+		assert.Equal(t, expected, level)
+	}
+
+	assert.Equal(t, "req-1", requestIDOf(WithClientRequestID(ctx, "req-1")))
+	assert.Equal(t, "", requestIDOf(ctx))
+}
+
+func TestRetryLogging(t *testing.T) {
+	buf := &bytes.Buffer{}
+	logger := newClientLogger(slog.New(slog.NewJSONHandler(buf, &slog.HandlerOptions{Level: slog.LevelInfo})))
+	rule := RetryRule{
+		MaxAttempts:         3,
+		InitialBackoff:      time.Millisecond,
+		RetryableErrorCodes: []common.ErrorCode{common.ErrorCode_RateLimit, common.ErrorCode_NotReadyServe},
+	}
+	inter := retryInterceptor(&RetryPolicy{Read: rule}, logger)
+
+	errorCodes := []common.ErrorCode{common.ErrorCode_RateLimit, common.ErrorCode_NotReadyServe, common.ErrorCode_Success}
+	invoke := func(ctx context.Context) {
+		calls := 0
+		err := inter(ctx, "/milvus.proto.milvus.MilvusService/HasCollection", &server.HasCollectionRequest{}, &server.BoolResponse{}, nil,
+			func(_ context.Context, _ string, _, reply interface{}, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+				reply.(*server.BoolResponse).Status = &common.Status{ErrorCode: errorCodes[calls], Reason: "mocked"}
+				calls++
+				return nil
+			})
+		require.NoError(t, err)
+	}
+
+	invoke(WithClientRequestID(context.Background(), "req-1"))
+	records := logRecords(t, buf)
+	require.Len(t, records, 2)
+	assert.Equal(t, "rate limited, backing off", records[0]["msg"])
+	assert.Equal(t, "WARN", records[0]["level"])
+	assert.Equal(t, "HasCollection", records[0]["method"])
+	assert.EqualValues(t, 1, records[0]["attempt"])
+	assert.Equal(t, "req-1", records[0]["request_id"])
+	assert.Equal(t, "retrying request", records[1]["msg"])
+	assert.Equal(t, common.ErrorCode_NotReadyServe.String(), records[1]["error_code"])
+
+	// level in context silences the warnings
+	invoke(WithErrorLogLevel(context.Background()))
+	assert.Empty(t, logRecords(t, buf))
+}
+
+func TestLoggingInterceptor(t *testing.T) {
+	buf := &bytes.Buffer{}
+	logger := newClientLogger(slog.New(slog.NewJSONHandler(buf, &slog.HandlerOptions{Level: slog.LevelInfo})))
+	req := &server.InsertRequest{CollectionName: "coll", NumRows: 10}
+	delay := time.Duration(0)
+	invoker := func(_ context.Context, _ string, _, reply interface{}, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+		time.Sleep(delay)
+		reply.(*server.MutationResult).Status = &common.Status{}
+		return nil
+	}
+	method := "/milvus.proto.milvus.MilvusService/Insert"
+
+	inter := loggingInterceptor(logger, 20*time.Millisecond)
+	require.NoError(t, inter(context.Background(), method, req, &server.MutationResult{}, nil, invoker))
+	assert.Empty(t, logRecords(t, buf))
+
+	// debug level in context enables payload logging for the call only
+	reply := &server.MutationResult{}
+	require.NoError(t, inter(WithDebugLogLevel(context.Background()), method, req, reply, nil, invoker))
+	records := logRecords(t, buf)
+	require.Len(t, records, 1)
+	assert.Equal(t, "request", records[0]["msg"])
+	assert.Equal(t, "DEBUG", records[0]["level"])
+	assert.Equal(t, "coll", records[0]["collection"])
+	assert.EqualValues(t, payloadSize(req), records[0]["request_bytes"])
+	assert.EqualValues(t, payloadSize(reply), records[0]["response_bytes"])
+
+	delay = 30 * time.Millisecond
+	require.NoError(t, inter(context.Background(), method, req, &server.MutationResult{}, nil, invoker))
+	records = logRecords(t, buf)
+	require.Len(t, records, 1)
+	assert.Equal(t, "slow request", records[0]["msg"])
+	assert.Equal(t, "WARN", records[0]["level"])
+	assert.Equal(t, "Insert", records[0]["method"])
+
+	// no logger provided
+	inter = loggingInterceptor(clientLogger{}, time.Nanosecond)
+	require.NoError(t, inter(WithDebugLogLevel(context.Background()), method, req, &server.MutationResult{}, nil, invoker))
+}
@@ -26,7 +26,9 @@ import (
 )
 
 // ManualCompaction triggers a compaction on provided collection
-func (c *GrpcClient) ManualCompaction(ctx context.Context, collName string, toleranceDuration time.Duration) (int64, error) {
+func (c *GrpcClient) ManualCompaction(ctx context.Context, collName string, toleranceDuration time.Duration) (_ int64, err error) {
+	ctx, span := c.startSpan(ctx, "ManualCompaction", AttrCollection.String(collName))
+	defer func() { endSpan(span, err) }()
 	if c.Service == nil {
 		return 0, ErrClientNotReady
 	}
@@ -51,7 +53,7 @@ func (c *GrpcClient) ManualCompaction(ctx context.Context, collName string, tole
 		return 0, err
 	}
 
-	err = handleRespStatus(resp.GetStatus())
+	err = handleRespStatus(ctx, collName, resp.GetStatus())
 	if err != nil {
 		return 0, err
 	}
@@ -60,7 +62,9 @@ func (c *GrpcClient) ManualCompaction(ctx context.Context, collName string, tole
 }
 
 // GetCompactionState get compaction state of provided compaction id
-func (c *GrpcClient) GetCompactionState(ctx context.Context, id int64) (entity.CompactionState, error) {
+func (c *GrpcClient) GetCompactionState(ctx context.Context, id int64) (_ entity.CompactionState, err error) {
+	ctx, span := c.startSpan(ctx, "GetCompactionState")
+	defer func() { endSpan(span, err) }()
 	if c.Service == nil {
 		return entity.CompcationStateUndefined, ErrClientNotReady
 	}
@@ -71,7 +75,7 @@ func (c *GrpcClient) GetCompactionState(ctx context.Context, id int64) (entity.C
 		return entity.CompcationStateUndefined, err
 	}
 
-	err = handleRespStatus(resp.GetStatus())
+	err = handleRespStatus(ctx, "", resp.GetStatus())
 	if err != nil {
 		return entity.CompcationStateUndefined, err
 	}
@@ -81,7 +85,9 @@ func (c *GrpcClient) GetCompactionState(ctx context.Context, id int64) (entity.C
 }
 
 // GetCompactionStateWithPlans get compaction state with plans of provided compaction id
-func (c *GrpcClient) GetCompactionStateWithPlans(ctx context.Context, id int64) (entity.CompactionState, []entity.CompactionPlan, error) {
+func (c *GrpcClient) GetCompactionStateWithPlans(ctx context.Context, id int64) (_ entity.CompactionState, _ []entity.CompactionPlan, err error) {
+	ctx, span := c.startSpan(ctx, "GetCompactionStateWithPlans")
+	defer func() { endSpan(span, err) }()
 	if c.Service == nil {
 		return entity.CompcationStateUndefined, nil, ErrClientNotReady
 	}
@@ -94,7 +100,7 @@ func (c *GrpcClient) GetCompactionStateWithPlans(ctx context.Context, id int64)
 		return entity.CompcationStateUndefined, nil, err
 	}
 
-	err = handleRespStatus(resp.GetStatus())
+	err = handleRespStatus(ctx, "", resp.GetStatus())
 	if err != nil {
 		return entity.CompcationStateUndefined, nil, err
 	}
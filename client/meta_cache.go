@@ -1,7 +1,13 @@
 package client
 
 import (
+	"context"
 	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	common "github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"google.golang.org/grpc/codes"
 
 	"github.com/milvus-io/milvus-sdk-go/v2/entity"
 )
@@ -20,47 +26,86 @@ type collInfo struct {
 	ConsistencyLevel entity.ConsistencyLevel
 }
 
-var MetaCache = metaCache{
-	sessionTsMap: make(map[string]uint64),
-	collInfoMap:  make(map[string]collInfo),
+// metaCacheKey identifies a collection across clusters and databases.
+type metaCacheKey struct {
+	address    string
+	db         string
+	collection string
+}
+
+type cachedCollInfo struct {
+	collInfo
+	expireAt time.Time // zero for never expire
 }
 
-// timestampMap collects the last-write-timestamp of every collection, which is required by session consistency level.
+type cachedPartitions struct {
+	names    map[string]struct{}
+	expireAt time.Time // zero for never expire
+}
+
+// metaCache caches the collection info and the last-write-timestamp of every collection,
+// the latter is required by session consistency level.
 type metaCache struct {
+	ttl          time.Duration
 	sessionMu    sync.RWMutex
 	colMu        sync.RWMutex
-	sessionTsMap map[string]uint64 // collectionName -> last-write-timestamp
-	collInfoMap  map[string]collInfo
+	sessionTsMap map[metaCacheKey]uint64 // collection -> last-write-timestamp
+	collInfoMap  map[metaCacheKey]cachedCollInfo
+	partitionMap map[metaCacheKey]cachedPartitions // collection -> names of partitions known to exist, used by write requests
+}
+
+// MetaCache was the meta cache shared by all clients in the process.
+//
+// Deprecated: each client owns its meta cache since the cache is keyed by address and database,
+// MetaCache is no longer read or written by clients. Use Client.InvalidateCollection to drop cached meta.
+var MetaCache = metaCache{
+	sessionTsMap: make(map[metaCacheKey]uint64),
+	collInfoMap:  make(map[metaCacheKey]cachedCollInfo),
+	partitionMap: make(map[metaCacheKey]cachedPartitions),
+}
+
+// newMetaCache creates a metaCache, collection info expires after ttl if it's positive.
+func newMetaCache(ttl time.Duration) *metaCache {
+	return &metaCache{
+		ttl:          ttl,
+		sessionTsMap: make(map[metaCacheKey]uint64),
+		collInfoMap:  make(map[metaCacheKey]cachedCollInfo),
+		partitionMap: make(map[metaCacheKey]cachedPartitions),
+	}
 }
 
-func (m *metaCache) getSessionTs(cName string) (uint64, bool) {
+func (m *metaCache) getSessionTs(key metaCacheKey) (uint64, bool) {
 	m.sessionMu.RLock()
 	defer m.sessionMu.RUnlock()
-	ts, ok := m.sessionTsMap[cName]
+	ts, ok := m.sessionTsMap[key]
 	return ts, ok
 }
 
-func (m *metaCache) setSessionTs(cName string, ts uint64) {
+func (m *metaCache) setSessionTs(key metaCacheKey, ts uint64) {
 	m.sessionMu.Lock()
 	defer m.sessionMu.Unlock()
-	m.sessionTsMap[cName] = max(m.sessionTsMap[cName], ts) // increase monotonically
+	m.sessionTsMap[key] = max(m.sessionTsMap[key], ts) // increase monotonically
 }
 
-func (m *metaCache) setCollectionInfo(cName string, c *collInfo) {
+func (m *metaCache) setCollectionInfo(key metaCacheKey, c *collInfo) {
 	m.colMu.Lock()
 	defer m.colMu.Unlock()
 	if c == nil {
-		delete(m.collInfoMap, cName)
-	} else {
-		m.collInfoMap[cName] = *c
+		delete(m.collInfoMap, key)
+		return
 	}
+	cached := cachedCollInfo{collInfo: *c}
+	if m.ttl > 0 {
+		cached.expireAt = time.Now().Add(m.ttl)
+	}
+	m.collInfoMap[key] = cached
 }
 
-func (m *metaCache) getCollectionInfo(cName string) (*collInfo, bool) {
+func (m *metaCache) getCollectionInfo(key metaCacheKey) (*collInfo, bool) {
 	m.colMu.RLock()
 	defer m.colMu.RUnlock()
-	col, ok := m.collInfoMap[cName]
-	if !ok {
+	col, ok := m.collInfoMap[key]
+	if !ok || (!col.expireAt.IsZero() && time.Now().After(col.expireAt)) {
 		return nil, false
 	}
 	return &collInfo{
@@ -71,15 +116,182 @@ func (m *metaCache) getCollectionInfo(cName string) (*collInfo, bool) {
 	}, true
 }
 
+// addPartition caches the partition known to exist.
+func (m *metaCache) addPartition(key metaCacheKey, name string) {
+	m.colMu.Lock()
+	defer m.colMu.Unlock()
+	cached, ok := m.partitionMap[key]
+	if !ok || (!cached.expireAt.IsZero() && time.Now().After(cached.expireAt)) {
+		cached = cachedPartitions{names: make(map[string]struct{})}
+		if m.ttl > 0 {
+			cached.expireAt = time.Now().Add(m.ttl)
+		}
+		m.partitionMap[key] = cached
+	}
+	cached.names[name] = struct{}{}
+}
+
+// hasPartition checks whether the partition is cached as existing.
+func (m *metaCache) hasPartition(key metaCacheKey, name string) bool {
+	m.colMu.RLock()
+	defer m.colMu.RUnlock()
+	cached, ok := m.partitionMap[key]
+	if !ok || (!cached.expireAt.IsZero() && time.Now().After(cached.expireAt)) {
+		return false
+	}
+	_, has := cached.names[name]
+	return has
+}
+
+func (m *metaCache) invalidatePartitions(key metaCacheKey) {
+	m.colMu.Lock()
+	defer m.colMu.Unlock()
+	delete(m.partitionMap, key)
+}
+
+// invalidate removes all cached meta of the collection.
+func (m *metaCache) invalidate(key metaCacheKey) {
+	m.colMu.Lock()
+	delete(m.collInfoMap, key)
+	delete(m.partitionMap, key)
+	m.colMu.Unlock()
+
+	m.sessionMu.Lock()
+	delete(m.sessionTsMap, key)
+	m.sessionMu.Unlock()
+}
+
 func (m *metaCache) reset() {
 	m.colMu.Lock()
 	defer m.colMu.Unlock()
-	m.collInfoMap = make(map[string]collInfo)
+	m.collInfoMap = make(map[metaCacheKey]cachedCollInfo)
+	m.partitionMap = make(map[metaCacheKey]cachedPartitions)
+}
+
+// isStaleCollectionStatus checks whether the failure status implies the cached collection info is stale,
+// the collection may be dropped or renamed, its schema mismatches the cached one, or the partition is dropped.
+// Only error codes are checked, reasons of user errors may mention schema as well.
+func isStaleCollectionStatus(status *common.Status) bool {
+	switch status.GetErrorCode() {
+	case common.ErrorCode_Success:
+		return false
+	case common.ErrorCode_CollectionNotExists, common.ErrorCode_CollectionNameNotFound:
+		return true
+	}
+	switch status.GetCode() {
+	case codeCollectionNotFound, codePartitionNotFound, codeCollectionSchemaMismatch:
+		return true
+	}
+	return false
+}
+
+// isStaleMetaError checks whether err is returned by server since the cached collection info is stale.
+func isStaleMetaError(err error) bool {
+	var serverErr *ServerError
+	if !errors.As(err, &serverErr) || serverErr.GrpcCode != codes.OK {
+		return false
+	}
+	return isStaleCollectionStatus(&common.Status{ErrorCode: serverErr.ErrorCode, Code: serverErr.Code, Reason: serverErr.Reason})
+}
+
+// metaKey returns the cache key of the collection in the database of request.
+func (c *GrpcClient) metaKey(ctx context.Context, collName string) metaCacheKey {
+	return metaCacheKey{
+		address:    c.config.getParsedAddress(),
+		db:         c.database(ctx),
+		collection: collName,
+	}
+}
+
+// InvalidateCollection removes the cached meta of the collection,
+// the latest one is fetched from server on next access.
+func (c *GrpcClient) InvalidateCollection(ctx context.Context, collName string) error {
+	if c.Service == nil {
+		return ErrClientNotReady
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	c.invalidateCollection(ctx, collName)
+	return nil
+}
+
+func (c *GrpcClient) invalidateCollection(ctx context.Context, collName string) {
+	c.cache.invalidate(c.metaKey(ctx, collName))
+}
+
+// invalidateSchema removes the cached schema and partitions of the collection, the session timestamp is kept.
+func (c *GrpcClient) invalidateSchema(ctx context.Context, collName string) {
+	key := c.metaKey(ctx, collName)
+	c.cache.setCollectionInfo(key, nil)
+	c.cache.invalidatePartitions(key)
+}
+
+// getCollectionSchema returns the cached schema of collection, the collection is described if not cached.
+// cached reports whether the schema comes from cache.
+func (c *GrpcClient) getCollectionSchema(ctx context.Context, collName string) (sch *entity.Schema, cached bool, err error) {
+	if info, ok := c.cache.getCollectionInfo(c.metaKey(ctx, collName)); ok {
+		return info.Schema, true, nil
+	}
+	coll, err := c.DescribeCollection(ctx, collName)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, false, collNotExistsErr(collName)
+		}
+		return nil, false, err
+	}
+	return coll.Schema, false, nil
+}
+
+// checkCachedPartition checks the partition exists with cached partitions, server is asked if not cached.
+func (c *GrpcClient) checkCachedPartition(ctx context.Context, collName string, partitionName string) error {
+	key := c.metaKey(ctx, collName)
+	if c.cache.hasPartition(key, partitionName) {
+		return nil
+	}
+	if err := c.checkPartitionExists(ctx, collName, partitionName); err != nil {
+		return err
+	}
+	c.cache.addPartition(key, partitionName)
+	return nil
+}
+
+// writeWithCachedMeta builds the write request with cached collection schema and partitions then sends it,
+// so that no meta request is issued per write. The schema and partitions are refreshed and the write is
+// retried once, if the request fails to build with cached schema or the server reports them stale.
+func (c *GrpcClient) writeWithCachedMeta(ctx context.Context, collName string, partitionName string,
+	build func(sch *entity.Schema) error, send func() error) error {
+	for refreshed := false; ; refreshed = true {
+		sch, cached, err := c.getCollectionSchema(ctx, collName)
+		if err != nil {
+			return err
+		}
+		if partitionName != "" {
+			if err := c.checkCachedPartition(ctx, collName, partitionName); err != nil {
+				return err
+			}
+		}
+		if err := build(sch); err != nil {
+			if cached && !refreshed {
+				c.invalidateSchema(ctx, collName)
+				continue
+			}
+			return err
+		}
+		err = send()
+		if refreshed || !isStaleMetaError(err) {
+			return err
+		}
+		c.invalidateSchema(ctx, collName)
+	}
 }
 
-func max(x, y uint64) uint64 {
-	if x > y {
-		return x
+// handleCollectionRespStatus handles the response status of request on collection,
+// and invalidates the cached schema of the collection if it's stale,
+// the session timestamp is kept for read-your-writes of earlier writes.
+func (c *GrpcClient) handleCollectionRespStatus(ctx context.Context, collName string, status *common.Status) error {
+	if isStaleCollectionStatus(status) {
+		c.invalidateSchema(ctx, collName)
 	}
-	return y
+	return handleRespStatus(ctx, collName, status)
 }
@@ -2,39 +2,41 @@ package client
 
 import (
 	"testing"
+	"time"
 
+	common "github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/stretchr/testify/assert"
 )
 
 func TestMetaCache(t *testing.T) {
-	meta := &metaCache{
-		sessionTsMap: make(map[string]uint64),
-		collInfoMap:  make(map[string]collInfo),
+	meta := newMetaCache(0)
+	key := func(collName string) metaCacheKey {
+		return metaCacheKey{address: "localhost:19530", db: "default", collection: collName}
 	}
 
 	t.Run("session-ts-get", func(t *testing.T) {
-		ts, ok := meta.getSessionTs("")
+		ts, ok := meta.getSessionTs(key(""))
 		assert.False(t, ok)
 		assert.Equal(t, uint64(0), ts)
 	})
 
 	t.Run("session-ts-set-then-get", func(t *testing.T) {
-		meta.setSessionTs("0", 1)
-		ts, ok := meta.getSessionTs("0")
+		meta.setSessionTs(key("0"), 1)
+		ts, ok := meta.getSessionTs(key("0"))
 		assert.True(t, ok)
 		assert.Equal(t, uint64(1), ts)
 	})
 
 	t.Run("session-ts-monotonic-set", func(t *testing.T) {
-		meta.setSessionTs("0", 2)
-		meta.setSessionTs("0", 1)
-		ts, ok := meta.getSessionTs("0")
+		meta.setSessionTs(key("0"), 2)
+		meta.setSessionTs(key("0"), 1)
+		ts, ok := meta.getSessionTs(key("0"))
 		assert.True(t, ok)
 		assert.Equal(t, uint64(2), ts)
 	})
 
 	t.Run("info-get", func(t *testing.T) {
-		info, ok := meta.getCollectionInfo("")
+		info, ok := meta.getCollectionInfo(key(""))
 		assert.False(t, ok)
 		assert.Nil(t, info)
 	})
@@ -43,13 +45,66 @@ func TestMetaCache(t *testing.T) {
 		info1 := &collInfo{
 			Name: "aaa",
 		}
-		meta.setCollectionInfo(info1.Name, info1)
-		info2, ok := meta.getCollectionInfo(info1.Name)
+		meta.setCollectionInfo(key(info1.Name), info1)
+		info2, ok := meta.getCollectionInfo(key(info1.Name))
 		assert.Equal(t, info1, info2)
 		assert.True(t, ok)
-		meta.setCollectionInfo(info1.Name, nil)
-		info2, ok = meta.getCollectionInfo(info1.Name)
+		meta.setCollectionInfo(key(info1.Name), nil)
+		info2, ok = meta.getCollectionInfo(key(info1.Name))
 		assert.Nil(t, info2)
 		assert.False(t, ok)
 	})
+
+	t.Run("isolated-by-address-and-db", func(t *testing.T) {
+		k := key("coll")
+		meta.setCollectionInfo(k, &collInfo{ID: 1, Name: "coll"})
+		meta.setSessionTs(k, 10)
+
+		other := []metaCacheKey{
+			{address: "localhost:19531", db: k.db, collection: k.collection},
+			{address: k.address, db: "db1", collection: k.collection},
+		}
+		for _, o := range other {
+			_, ok := meta.getCollectionInfo(o)
+			assert.False(t, ok)
+			_, ok = meta.getSessionTs(o)
+			assert.False(t, ok)
+		}
+	})
+
+	t.Run("invalidate", func(t *testing.T) {
+		k := key("coll")
+		meta.setCollectionInfo(k, &collInfo{ID: 1, Name: "coll"})
+		meta.setSessionTs(k, 10)
+		meta.invalidate(k)
+		_, ok := meta.getCollectionInfo(k)
+		assert.False(t, ok)
+		_, ok = meta.getSessionTs(k)
+		assert.False(t, ok)
+	})
+}
+
+func TestMetaCacheTTL(t *testing.T) {
+	meta := newMetaCache(50 * time.Millisecond)
+	key := metaCacheKey{address: "localhost:19530", collection: "coll"}
+	meta.setCollectionInfo(key, &collInfo{ID: 1, Name: "coll"})
+
+	_, ok := meta.getCollectionInfo(key)
+	assert.True(t, ok)
+	assert.Eventually(t, func() bool {
+		_, ok := meta.getCollectionInfo(key)
+		return !ok
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestIsStaleCollectionStatus(t *testing.T) {
+	assert.False(t, isStaleCollectionStatus(nil))
+	assert.False(t, isStaleCollectionStatus(&common.Status{}))
+	assert.False(t, isStaleCollectionStatus(&common.Status{ErrorCode: common.ErrorCode_RateLimit}))
+	assert.True(t, isStaleCollectionStatus(&common.Status{ErrorCode: common.ErrorCode_CollectionNotExists}))
+	assert.True(t, isStaleCollectionStatus(&common.Status{ErrorCode: common.ErrorCode_UnexpectedError, Code: codeCollectionNotFound}))
+	assert.True(t, isStaleCollectionStatus(&common.Status{ErrorCode: common.ErrorCode_UnexpectedError, Code: codeCollectionSchemaMismatch}))
+	assert.True(t, isStaleCollectionStatus(&common.Status{ErrorCode: common.ErrorCode_UnexpectedError, Code: codePartitionNotFound}))
+	// user errors mentioning schema are not stale meta
+	assert.False(t, isStaleCollectionStatus(&common.Status{ErrorCode: common.ErrorCode_IllegalArgument, Reason: "schema mismatch: dim"}))
 }
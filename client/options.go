@@ -12,6 +12,7 @@
 package client
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/cockroachdb/errors"
@@ -118,14 +119,17 @@ func WithTravelTimestamp(tt uint64) SearchQueryOptionFunc {
 	}
 }
 
-func makeSearchQueryOption(collName string, opts ...SearchQueryOptionFunc) (*SearchQueryOption, error) {
+func (c *GrpcClient) makeSearchQueryOption(ctx context.Context, collName string, opts ...SearchQueryOptionFunc) (*SearchQueryOption, error) {
 	opt := &SearchQueryOption{
 		ConsistencyLevel: entity.ClBounded, // default
 	}
-	info, ok := MetaCache.getCollectionInfo(collName)
+	info, ok := c.cache.getCollectionInfo(c.metaKey(ctx, collName))
 	if ok {
 		opt.ConsistencyLevel = info.ConsistencyLevel
 	}
+	if c.config.ConsistencyLevel != nil {
+		opt.ConsistencyLevel = *c.config.ConsistencyLevel
+	}
 	for _, o := range opts {
 		o(opt)
 	}
@@ -138,7 +142,7 @@ func makeSearchQueryOption(collName string, opts ...SearchQueryOptionFunc) (*Sea
 	case entity.ClStrong:
 		opt.GuaranteeTimestamp = StrongTimestamp
 	case entity.ClSession:
-		ts, ok := MetaCache.getSessionTs(collName)
+		ts, ok := c.cache.getSessionTs(c.metaKey(ctx, collName))
 		if !ok {
 			ts = EventuallyTimestamp
 		}
@@ -12,6 +12,7 @@
 package client
 
 import (
+	"context"
 	"math/rand"
 	"testing"
 
@@ -59,6 +60,7 @@ func TestCreateCollectionWithPartitionNum(t *testing.T) {
 }
 
 func TestMakeSearchQueryOption(t *testing.T) {
+	ctx := context.Background()
 	c := &entity.Collection{
 		Name:             "999",
 		ConsistencyLevel: entity.ClStrong,
@@ -68,10 +70,13 @@ func TestMakeSearchQueryOption(t *testing.T) {
 		Name:             c.Name,
 		ConsistencyLevel: c.ConsistencyLevel,
 	}
-	MetaCache.setCollectionInfo(c.Name, &cInfo)
+	cfg := &Config{Address: "localhost:19530"}
+	assert.NoError(t, cfg.parse())
+	gc := &GrpcClient{config: cfg, cache: newMetaCache(0)}
+	gc.cache.setCollectionInfo(gc.metaKey(ctx, c.Name), &cInfo)
 
 	t.Run("strong consistency", func(t *testing.T) {
-		opt, err := makeSearchQueryOption(c.Name)
+		opt, err := gc.makeSearchQueryOption(ctx, c.Name)
 		assert.Nil(t, err)
 		assert.NotNil(t, opt)
 		expected := &SearchQueryOption{
@@ -84,7 +89,7 @@ func TestMakeSearchQueryOption(t *testing.T) {
 	})
 
 	t.Run("ignore growing", func(t *testing.T) {
-		opt, err := makeSearchQueryOption(c.Name, WithIgnoreGrowing())
+		opt, err := gc.makeSearchQueryOption(ctx, c.Name, WithIgnoreGrowing())
 		assert.Nil(t, err)
 		assert.NotNil(t, opt)
 		expected := &SearchQueryOption{
@@ -97,7 +102,7 @@ func TestMakeSearchQueryOption(t *testing.T) {
 	})
 
 	t.Run("for tuning", func(t *testing.T) {
-		opt, err := makeSearchQueryOption(c.Name, WithForTuning())
+		opt, err := gc.makeSearchQueryOption(ctx, c.Name, WithForTuning())
 		assert.Nil(t, err)
 		assert.NotNil(t, opt)
 		expected := &SearchQueryOption{
@@ -110,7 +115,7 @@ func TestMakeSearchQueryOption(t *testing.T) {
 	})
 
 	t.Run("session consistency", func(t *testing.T) {
-		opt, err := makeSearchQueryOption(c.Name, WithSearchQueryConsistencyLevel(entity.ClSession))
+		opt, err := gc.makeSearchQueryOption(ctx, c.Name, WithSearchQueryConsistencyLevel(entity.ClSession))
 		assert.Nil(t, err)
 		assert.NotNil(t, opt)
 		expected := &SearchQueryOption{
@@ -121,8 +126,8 @@ func TestMakeSearchQueryOption(t *testing.T) {
 		}
 		assert.Equal(t, expected, opt)
 
-		MetaCache.setSessionTs(c.Name, 99)
-		opt, err = makeSearchQueryOption(c.Name, WithSearchQueryConsistencyLevel(entity.ClSession))
+		gc.cache.setSessionTs(gc.metaKey(ctx, c.Name), 99)
+		opt, err = gc.makeSearchQueryOption(ctx, c.Name, WithSearchQueryConsistencyLevel(entity.ClSession))
 		assert.Nil(t, err)
 		assert.NotNil(t, opt)
 		expected = &SearchQueryOption{
@@ -135,7 +140,7 @@ func TestMakeSearchQueryOption(t *testing.T) {
 	})
 
 	t.Run("bounded consistency", func(t *testing.T) {
-		opt, err := makeSearchQueryOption(c.Name, WithSearchQueryConsistencyLevel(entity.ClBounded))
+		opt, err := gc.makeSearchQueryOption(ctx, c.Name, WithSearchQueryConsistencyLevel(entity.ClBounded))
 		assert.Nil(t, err)
 		assert.NotNil(t, opt)
 		expected := &SearchQueryOption{
@@ -148,7 +153,7 @@ func TestMakeSearchQueryOption(t *testing.T) {
 	})
 
 	t.Run("eventually consistency", func(t *testing.T) {
-		opt, err := makeSearchQueryOption(c.Name, WithSearchQueryConsistencyLevel(entity.ClEventually))
+		opt, err := gc.makeSearchQueryOption(ctx, c.Name, WithSearchQueryConsistencyLevel(entity.ClEventually))
 		assert.Nil(t, err)
 		assert.NotNil(t, opt)
 		expected := &SearchQueryOption{
@@ -161,7 +166,7 @@ func TestMakeSearchQueryOption(t *testing.T) {
 	})
 
 	t.Run("customized consistency", func(t *testing.T) {
-		opt, err := makeSearchQueryOption(c.Name, WithSearchQueryConsistencyLevel(entity.ClCustomized), WithGuaranteeTimestamp(100))
+		opt, err := gc.makeSearchQueryOption(ctx, c.Name, WithSearchQueryConsistencyLevel(entity.ClCustomized), WithGuaranteeTimestamp(100))
 		assert.Nil(t, err)
 		assert.NotNil(t, opt)
 		expected := &SearchQueryOption{
@@ -174,7 +179,22 @@ func TestMakeSearchQueryOption(t *testing.T) {
 	})
 
 	t.Run("guarantee timestamp sanity check", func(t *testing.T) {
-		_, err := makeSearchQueryOption(c.Name, WithSearchQueryConsistencyLevel(entity.ClStrong), WithGuaranteeTimestamp(100))
+		_, err := gc.makeSearchQueryOption(ctx, c.Name, WithSearchQueryConsistencyLevel(entity.ClStrong), WithGuaranteeTimestamp(100))
 		assert.Error(t, err)
 	})
+
+	t.Run("config consistency level", func(t *testing.T) {
+		cl := entity.ClEventually
+		gc.config.ConsistencyLevel = &cl
+		defer func() { gc.config.ConsistencyLevel = nil }()
+
+		opt, err := gc.makeSearchQueryOption(ctx, c.Name)
+		assert.Nil(t, err)
+		assert.Equal(t, entity.ClEventually, opt.ConsistencyLevel)
+		assert.Equal(t, EventuallyTimestamp, opt.GuaranteeTimestamp)
+
+		opt, err = gc.makeSearchQueryOption(ctx, c.Name, WithSearchQueryConsistencyLevel(entity.ClStrong))
+		assert.Nil(t, err)
+		assert.Equal(t, entity.ClStrong, opt.ConsistencyLevel)
+	})
 }
@@ -24,7 +24,9 @@ import (
 )
 
 // CreatePartition create partition for collection
-func (c *GrpcClient) CreatePartition(ctx context.Context, collName string, partitionName string) error {
+func (c *GrpcClient) CreatePartition(ctx context.Context, collName string, partitionName string) (err error) {
+	ctx, span := c.startSpan(ctx, "CreatePartition", AttrCollection.String(collName), partitionCount(partitionName))
+	defer func() { endSpan(span, err) }()
 	if c.Service == nil {
 		return ErrClientNotReady
 	}
@@ -36,7 +38,7 @@ func (c *GrpcClient) CreatePartition(ctx context.Context, collName string, parti
 		return err
 	}
 	if has {
-		return fmt.Errorf("partition %s of collection %s already exists", partitionName, collName)
+		return fmt.Errorf("partition %s of collection %s %w", partitionName, collName, ErrAlreadyExists)
 	}
 
 	req := &server.CreatePartitionRequest{
@@ -48,7 +50,11 @@ func (c *GrpcClient) CreatePartition(ctx context.Context, collName string, parti
 	if err != nil {
 		return err
 	}
-	return handleRespStatus(resp)
+	if err := handleRespStatus(ctx, collName, resp); err != nil {
+		return err
+	}
+	c.cache.invalidatePartitions(c.metaKey(ctx, collName))
+	return nil
 }
 
 func (c *GrpcClient) checkPartitionExists(ctx context.Context, collName string, partitionName string) error {
@@ -63,7 +69,9 @@ func (c *GrpcClient) checkPartitionExists(ctx context.Context, collName string,
 }
 
 // DropPartition drop partition from collection
-func (c *GrpcClient) DropPartition(ctx context.Context, collName string, partitionName string) error {
+func (c *GrpcClient) DropPartition(ctx context.Context, collName string, partitionName string) (err error) {
+	ctx, span := c.startSpan(ctx, "DropPartition", AttrCollection.String(collName), partitionCount(partitionName))
+	defer func() { endSpan(span, err) }()
 	if c.Service == nil {
 		return ErrClientNotReady
 	}
@@ -82,11 +90,17 @@ func (c *GrpcClient) DropPartition(ctx context.Context, collName string, partiti
 	if err != nil {
 		return err
 	}
-	return handleRespStatus(resp)
+	if err := handleRespStatus(ctx, collName, resp); err != nil {
+		return err
+	}
+	c.cache.invalidatePartitions(c.metaKey(ctx, collName))
+	return nil
 }
 
 // HasPartition check whether specified partition exists
-func (c *GrpcClient) HasPartition(ctx context.Context, collName string, partitionName string) (bool, error) {
+func (c *GrpcClient) HasPartition(ctx context.Context, collName string, partitionName string) (_ bool, err error) {
+	ctx, span := c.startSpan(ctx, "HasPartition", AttrCollection.String(collName), partitionCount(partitionName))
+	defer func() { endSpan(span, err) }()
 	if c.Service == nil {
 		return false, ErrClientNotReady
 	}
@@ -106,7 +120,9 @@ func (c *GrpcClient) HasPartition(ctx context.Context, collName string, partitio
 }
 
 // ShowPartitions list all partitions from collection
-func (c *GrpcClient) ShowPartitions(ctx context.Context, collName string) ([]*entity.Partition, error) {
+func (c *GrpcClient) ShowPartitions(ctx context.Context, collName string) (_ []*entity.Partition, err error) {
+	ctx, span := c.startSpan(ctx, "ShowPartitions", AttrCollection.String(collName))
+	defer func() { endSpan(span, err) }()
 	if c.Service == nil {
 		return []*entity.Partition{}, ErrClientNotReady
 	}
@@ -118,7 +134,7 @@ func (c *GrpcClient) ShowPartitions(ctx context.Context, collName string) ([]*en
 	if err != nil {
 		return []*entity.Partition{}, err
 	}
-	if err := handleRespStatus(resp.GetStatus()); err != nil {
+	if err := handleRespStatus(ctx, collName, resp.GetStatus()); err != nil {
 		return []*entity.Partition{}, err
 	}
 	partitions := make([]*entity.Partition, 0, len(resp.GetPartitionIDs()))
@@ -137,7 +153,9 @@ func (c *GrpcClient) ShowPartitions(ctx context.Context, collName string) ([]*en
 }
 
 // LoadPartitions load collection paritions into memory
-func (c *GrpcClient) LoadPartitions(ctx context.Context, collName string, partitionNames []string, async bool) error {
+func (c *GrpcClient) LoadPartitions(ctx context.Context, collName string, partitionNames []string, async bool) (err error) {
+	ctx, span := c.startSpan(ctx, "LoadPartitions", AttrCollection.String(collName), partitionCount(partitionNames...))
+	defer func() { endSpan(span, err) }()
 	if c.Service == nil {
 		return ErrClientNotReady
 	}
@@ -176,7 +194,7 @@ func (c *GrpcClient) LoadPartitions(ctx context.Context, collName string, partit
 	if err != nil {
 		return err
 	}
-	if err := handleRespStatus(resp); err != nil {
+	if err := handleRespStatus(ctx, collName, resp); err != nil {
 		return err
 	}
 
@@ -216,7 +234,9 @@ func (c *GrpcClient) LoadPartitions(ctx context.Context, collName string, partit
 }
 
 // ReleasePartitions release partitions
-func (c *GrpcClient) ReleasePartitions(ctx context.Context, collName string, partitionNames []string) error {
+func (c *GrpcClient) ReleasePartitions(ctx context.Context, collName string, partitionNames []string) (err error) {
+	ctx, span := c.startSpan(ctx, "ReleasePartitions", AttrCollection.String(collName), partitionCount(partitionNames...))
+	defer func() { endSpan(span, err) }()
 	if c.Service == nil {
 		return ErrClientNotReady
 	}
@@ -238,5 +258,5 @@ func (c *GrpcClient) ReleasePartitions(ctx context.Context, collName string, par
 		return err
 	}
 
-	return handleRespStatus(resp)
+	return handleRespStatus(ctx, collName, resp)
 }
@@ -86,6 +86,8 @@ func getResultStatus(reply interface{}) *common.Status {
 		return r.GetStatus()
 	case *server.FlushResponse:
 		return r.GetStatus()
+	case interface{ GetStatus() *common.Status }:
+		return r.GetStatus()
 	default:
 		return nil
 	}
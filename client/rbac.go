@@ -18,6 +18,7 @@ package client
 
 import (
 	"context"
+	"fmt"
 
 	common "github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	server "github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
@@ -25,7 +26,9 @@ import (
 )
 
 // CreateRole creates a role entity in Milvus.
-func (c *GrpcClient) CreateRole(ctx context.Context, name string) error {
+func (c *GrpcClient) CreateRole(ctx context.Context, name string) (err error) {
+	ctx, span := c.startSpan(ctx, "CreateRole")
+	defer func() { endSpan(span, err) }()
 	if c.Service == nil {
 		return ErrClientNotReady
 	}
@@ -40,11 +43,13 @@ func (c *GrpcClient) CreateRole(ctx context.Context, name string) error {
 		return err
 	}
 
-	return handleRespStatus(resp)
+	return handleRespStatus(ctx, "", resp)
 }
 
 // DropRole drops a role entity in Milvus.
-func (c *GrpcClient) DropRole(ctx context.Context, name string) error {
+func (c *GrpcClient) DropRole(ctx context.Context, name string) (err error) {
+	ctx, span := c.startSpan(ctx, "DropRole")
+	defer func() { endSpan(span, err) }()
 	if c.Service == nil {
 		return ErrClientNotReady
 	}
@@ -58,11 +63,13 @@ func (c *GrpcClient) DropRole(ctx context.Context, name string) error {
 		return err
 	}
 
-	return handleRespStatus(resp)
+	return handleRespStatus(ctx, "", resp)
 }
 
 // AddUserRole adds one role for user.
-func (c *GrpcClient) AddUserRole(ctx context.Context, username string, role string) error {
+func (c *GrpcClient) AddUserRole(ctx context.Context, username string, role string) (err error) {
+	ctx, span := c.startSpan(ctx, "AddUserRole")
+	defer func() { endSpan(span, err) }()
 	if c.Service == nil {
 		return ErrClientNotReady
 	}
@@ -78,11 +85,13 @@ func (c *GrpcClient) AddUserRole(ctx context.Context, username string, role stri
 		return err
 	}
 
-	return handleRespStatus(resp)
+	return handleRespStatus(ctx, "", resp)
 }
 
 // RemoveUserRole removes one role from user.
-func (c *GrpcClient) RemoveUserRole(ctx context.Context, username string, role string) error {
+func (c *GrpcClient) RemoveUserRole(ctx context.Context, username string, role string) (err error) {
+	ctx, span := c.startSpan(ctx, "RemoveUserRole")
+	defer func() { endSpan(span, err) }()
 	if c.Service == nil {
 		return ErrClientNotReady
 	}
@@ -98,11 +107,13 @@ func (c *GrpcClient) RemoveUserRole(ctx context.Context, username string, role s
 		return err
 	}
 
-	return handleRespStatus(resp)
+	return handleRespStatus(ctx, "", resp)
 }
 
 // ListRoles lists the role objects in system.
-func (c *GrpcClient) ListRoles(ctx context.Context) ([]entity.Role, error) {
+func (c *GrpcClient) ListRoles(ctx context.Context) (_ []entity.Role, err error) {
+	ctx, span := c.startSpan(ctx, "ListRoles")
+	defer func() { endSpan(span, err) }()
 	if c.Service == nil {
 		return nil, ErrClientNotReady
 	}
@@ -113,7 +124,7 @@ func (c *GrpcClient) ListRoles(ctx context.Context) ([]entity.Role, error) {
 	if err != nil {
 		return nil, err
 	}
-	if err = handleRespStatus(resp.GetStatus()); err != nil {
+	if err = handleRespStatus(ctx, "", resp.GetStatus()); err != nil {
 		return nil, err
 	}
 
@@ -126,7 +137,9 @@ func (c *GrpcClient) ListRoles(ctx context.Context) ([]entity.Role, error) {
 }
 
 // ListUsers lists the user objects in system.
-func (c *GrpcClient) ListUsers(ctx context.Context) ([]entity.User, error) {
+func (c *GrpcClient) ListUsers(ctx context.Context) (_ []entity.User, err error) {
+	ctx, span := c.startSpan(ctx, "ListUsers")
+	defer func() { endSpan(span, err) }()
 	if c.Service == nil {
 		return nil, ErrClientNotReady
 	}
@@ -137,7 +150,7 @@ func (c *GrpcClient) ListUsers(ctx context.Context) ([]entity.User, error) {
 	if err != nil {
 		return nil, err
 	}
-	if err = handleRespStatus(resp.GetStatus()); err != nil {
+	if err = handleRespStatus(ctx, "", resp.GetStatus()); err != nil {
 		return nil, err
 	}
 
@@ -149,50 +162,108 @@ func (c *GrpcClient) ListUsers(ctx context.Context) ([]entity.User, error) {
 	return users, nil
 }
 
-// Grant adds object privileged for role.
-func (c *GrpcClient) Grant(ctx context.Context, role string, objectType entity.PriviledgeObjectType, object string) error {
+// SelectUser returns the user with the names of roles assigned to it.
+func (c *GrpcClient) SelectUser(ctx context.Context, username string) (_ entity.User, err error) {
+	ctx, span := c.startSpan(ctx, "SelectUser")
+	defer func() { endSpan(span, err) }()
 	if c.Service == nil {
-		return ErrClientNotReady
+		return entity.User{}, ErrClientNotReady
 	}
 
-	req := &server.OperatePrivilegeRequest{
-		Entity: &server.GrantEntity{
-			Role: &server.RoleEntity{
-				Name: role,
-			},
-			Object: &server.ObjectEntity{
-				Name: common.ObjectType_name[int32(objectType)],
-			},
-			ObjectName: object,
-		},
-		Type: server.OperatePrivilegeType_Grant,
+	req := &server.SelectUserRequest{
+		User:            &server.UserEntity{Name: username},
+		IncludeRoleInfo: true,
 	}
 
-	resp, err := c.Service.OperatePrivilege(ctx, req)
+	resp, err := c.Service.SelectUser(ctx, req)
 	if err != nil {
-		return err
+		return entity.User{}, err
+	}
+	if err = handleRespStatus(ctx, "", resp.GetStatus()); err != nil {
+		return entity.User{}, err
 	}
 
-	return handleRespStatus(resp)
+	for _, result := range resp.GetResults() {
+		if result.GetUser().GetName() != username {
+			continue
+		}
+		user := entity.User{Name: username, Roles: make([]string, 0, len(result.GetRoles()))}
+		for _, role := range result.GetRoles() {
+			user.Roles = append(user.Roles, role.GetName())
+		}
+		return user, nil
+	}
+	return entity.User{}, fmt.Errorf("user %s %w", username, ErrNotFound)
 }
 
-// Revoke removes privilege from role.
-func (c *GrpcClient) Revoke(ctx context.Context, role string, objectType entity.PriviledgeObjectType, object string) error {
+// SelectRole returns the role with the names of users assigned to it.
+func (c *GrpcClient) SelectRole(ctx context.Context, role string) (_ entity.Role, err error) {
+	ctx, span := c.startSpan(ctx, "SelectRole")
+	defer func() { endSpan(span, err) }()
 	if c.Service == nil {
-		return ErrClientNotReady
+		return entity.Role{}, ErrClientNotReady
+	}
+
+	req := &server.SelectRoleRequest{
+		Role:            &server.RoleEntity{Name: role},
+		IncludeUserInfo: true,
+	}
+
+	resp, err := c.Service.SelectRole(ctx, req)
+	if err != nil {
+		return entity.Role{}, err
 	}
+	if err = handleRespStatus(ctx, "", resp.GetStatus()); err != nil {
+		return entity.Role{}, err
+	}
+
+	for _, result := range resp.GetResults() {
+		if result.GetRole().GetName() != role {
+			continue
+		}
+		r := entity.Role{Name: role, Users: make([]string, 0, len(result.GetUsers()))}
+		for _, user := range result.GetUsers() {
+			r.Users = append(r.Users, user.GetName())
+		}
+		return r, nil
+	}
+	return entity.Role{}, fmt.Errorf("role %s %w", role, ErrNotFound)
+}
 
+// Grant adds the privilege on object for role, e.g.
+// Grant(ctx, "reader", entity.PriviledegeObjectTypeCollection, "book", entity.PrivilegeSearch).
+func (c *GrpcClient) Grant(ctx context.Context, role string, objectType entity.PriviledgeObjectType, object string, privilege entity.Privilege) (err error) {
+	ctx, span := c.startSpan(ctx, "Grant")
+	defer func() { endSpan(span, err) }()
+	return c.operatePrivilege(ctx, role, objectType, object, privilege, server.OperatePrivilegeType_Grant)
+}
+
+// Revoke removes the privilege on object from role.
+func (c *GrpcClient) Revoke(ctx context.Context, role string, objectType entity.PriviledgeObjectType, object string, privilege entity.Privilege) (err error) {
+	ctx, span := c.startSpan(ctx, "Revoke")
+	defer func() { endSpan(span, err) }()
+	return c.operatePrivilege(ctx, role, objectType, object, privilege, server.OperatePrivilegeType_Revoke)
+}
+
+func (c *GrpcClient) operatePrivilege(ctx context.Context, role string, objectType entity.PriviledgeObjectType, object string,
+	privilege entity.Privilege, operateType server.OperatePrivilegeType) error {
+	if c.Service == nil {
+		return ErrClientNotReady
+	}
 	req := &server.OperatePrivilegeRequest{
 		Entity: &server.GrantEntity{
 			Role: &server.RoleEntity{
 				Name: role,
 			},
 			Object: &server.ObjectEntity{
-				Name: common.ObjectType_name[int32(objectType)],
+				Name: objectType.String(),
 			},
 			ObjectName: object,
+			Grantor: &server.GrantorEntity{
+				Privilege: &server.PrivilegeEntity{Name: string(privilege)},
+			},
 		},
-		Type: server.OperatePrivilegeType_Revoke,
+		Type: operateType,
 	}
 
 	resp, err := c.Service.OperatePrivilege(ctx, req)
@@ -200,5 +271,56 @@ func (c *GrpcClient) Revoke(ctx context.Context, role string, objectType entity.
 		return err
 	}
 
-	return handleRespStatus(resp)
+	return handleRespStatus(ctx, "", resp)
+}
+
+// ListGrants lists all the privileges granted to role.
+func (c *GrpcClient) ListGrants(ctx context.Context, role string) (_ []entity.Grant, err error) {
+	ctx, span := c.startSpan(ctx, "ListGrants")
+	defer func() { endSpan(span, err) }()
+	return c.selectGrant(ctx, &server.GrantEntity{
+		Role: &server.RoleEntity{Name: role},
+	})
+}
+
+// SelectGrant lists the privileges granted to role on the object.
+func (c *GrpcClient) SelectGrant(ctx context.Context, role string, objectType entity.PriviledgeObjectType, object string) (_ []entity.Grant, err error) {
+	ctx, span := c.startSpan(ctx, "SelectGrant")
+	defer func() { endSpan(span, err) }()
+	return c.selectGrant(ctx, &server.GrantEntity{
+		Role:       &server.RoleEntity{Name: role},
+		Object:     &server.ObjectEntity{Name: objectType.String()},
+		ObjectName: object,
+	})
+}
+
+func (c *GrpcClient) selectGrant(ctx context.Context, grantEntity *server.GrantEntity) ([]entity.Grant, error) {
+	if c.Service == nil {
+		return nil, ErrClientNotReady
+	}
+
+	req := &server.SelectGrantRequest{
+		Entity: grantEntity,
+	}
+
+	resp, err := c.Service.SelectGrant(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if err = handleRespStatus(ctx, "", resp.GetStatus()); err != nil {
+		return nil, err
+	}
+
+	grants := make([]entity.Grant, 0, len(resp.GetEntities()))
+	for _, e := range resp.GetEntities() {
+		grants = append(grants, entity.Grant{
+			Role:       e.GetRole().GetName(),
+			ObjectType: entity.PriviledgeObjectType(common.ObjectType_value[e.GetObject().GetName()]),
+			Object:     e.GetObjectName(),
+			Privilege:  entity.Privilege(e.GetGrantor().GetPrivilege().GetName()),
+			Grantor:    e.GetGrantor().GetUser().GetName(),
+			DBName:     e.GetDbName(),
+		})
+	}
+	return grants, nil
 }
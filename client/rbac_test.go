@@ -353,6 +353,7 @@ func (s *RBACSuite) TestGrant() {
 	roleName := "testRole"
 	objectName := testCollectionName
 	objectType := entity.PriviledegeObjectTypeCollection
+	privilege := entity.PrivilegeSearch
 
 	s.Run("normal run", func() {
 		ctx, cancel := context.WithCancel(ctx)
@@ -362,10 +363,11 @@ func (s *RBACSuite) TestGrant() {
 			s.Equal(roleName, req.GetEntity().GetRole().GetName())
 			s.Equal(objectName, req.GetEntity().GetObjectName())
 			s.Equal(common.ObjectType_name[int32(objectType)], req.GetEntity().GetObject().GetName())
+			s.Equal(string(privilege), req.GetEntity().GetGrantor().GetPrivilege().GetName())
 			s.Equal(server.OperatePrivilegeType_Grant, req.GetType())
 		}).Return(&common.Status{ErrorCode: common.ErrorCode_Success}, nil)
 
-		err := s.client.Grant(ctx, roleName, objectType, objectName)
+		err := s.client.Grant(ctx, roleName, objectType, objectName, privilege)
 
 		s.NoError(err)
 	})
@@ -376,17 +378,30 @@ func (s *RBACSuite) TestGrant() {
 		defer s.resetMock()
 		s.mock.EXPECT().OperatePrivilege(mock.Anything, mock.Anything).Return(nil, errors.New("mock error"))
 
-		err := s.client.Grant(ctx, roleName, objectType, objectName)
+		err := s.client.Grant(ctx, roleName, objectType, objectName, privilege)
 		s.Error(err)
 	})
 
+	s.Run("privilege not listed", func() {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		defer s.resetMock()
+		// privileges not listed by entity are left for server to check
+		s.mock.EXPECT().OperatePrivilege(mock.Anything, mock.Anything).Run(func(ctx context.Context, req *server.OperatePrivilegeRequest) {
+			s.Equal("CreatePartition", req.GetEntity().GetGrantor().GetPrivilege().GetName())
+		}).Return(&common.Status{ErrorCode: common.ErrorCode_Success}, nil).Once()
+
+		err := s.client.Grant(ctx, roleName, objectType, objectName, entity.Privilege("CreatePartition"))
+		s.NoError(err)
+	})
+
 	s.Run("status error", func() {
 		ctx, cancel := context.WithCancel(ctx)
 		defer cancel()
 		defer s.resetMock()
 		s.mock.EXPECT().OperatePrivilege(mock.Anything, mock.Anything).Return(&common.Status{ErrorCode: common.ErrorCode_UnexpectedError}, nil)
 
-		err := s.client.Grant(ctx, roleName, objectType, objectName)
+		err := s.client.Grant(ctx, roleName, objectType, objectName, privilege)
 		s.Error(err)
 	})
 
@@ -395,7 +410,7 @@ func (s *RBACSuite) TestGrant() {
 		defer cancel()
 
 		c := &GrpcClient{}
-		err := c.Grant(ctx, roleName, objectType, objectName)
+		err := c.Grant(ctx, roleName, objectType, objectName, privilege)
 		s.Error(err)
 		s.ErrorIs(err, ErrClientNotReady)
 	})
@@ -407,6 +422,7 @@ func (s *RBACSuite) TestRevoke() {
 	roleName := "testRole"
 	objectName := testCollectionName
 	objectType := entity.PriviledegeObjectTypeCollection
+	privilege := entity.PrivilegeSearch
 
 	s.Run("normal run", func() {
 		ctx, cancel := context.WithCancel(ctx)
@@ -416,10 +432,11 @@ func (s *RBACSuite) TestRevoke() {
 			s.Equal(roleName, req.GetEntity().GetRole().GetName())
 			s.Equal(objectName, req.GetEntity().GetObjectName())
 			s.Equal(common.ObjectType_name[int32(objectType)], req.GetEntity().GetObject().GetName())
+			s.Equal(string(privilege), req.GetEntity().GetGrantor().GetPrivilege().GetName())
 			s.Equal(server.OperatePrivilegeType_Revoke, req.GetType())
 		}).Return(&common.Status{ErrorCode: common.ErrorCode_Success}, nil)
 
-		err := s.client.Revoke(ctx, roleName, objectType, objectName)
+		err := s.client.Revoke(ctx, roleName, objectType, objectName, privilege)
 
 		s.NoError(err)
 	})
@@ -430,7 +447,7 @@ func (s *RBACSuite) TestRevoke() {
 		defer s.resetMock()
 		s.mock.EXPECT().OperatePrivilege(mock.Anything, mock.Anything).Return(nil, errors.New("mock error"))
 
-		err := s.client.Revoke(ctx, roleName, objectType, objectName)
+		err := s.client.Revoke(ctx, roleName, objectType, objectName, privilege)
 		s.Error(err)
 	})
 
@@ -440,7 +457,7 @@ func (s *RBACSuite) TestRevoke() {
 		defer s.resetMock()
 		s.mock.EXPECT().OperatePrivilege(mock.Anything, mock.Anything).Return(&common.Status{ErrorCode: common.ErrorCode_UnexpectedError}, nil)
 
-		err := s.client.Revoke(ctx, roleName, objectType, objectName)
+		err := s.client.Revoke(ctx, roleName, objectType, objectName, privilege)
 		s.Error(err)
 	})
 
@@ -450,8 +467,189 @@ func (s *RBACSuite) TestRevoke() {
 		defer s.resetMock()
 
 		c := &GrpcClient{}
-		err := c.Revoke(ctx, roleName, objectType, objectName)
+		err := c.Revoke(ctx, roleName, objectType, objectName, privilege)
+		s.Error(err)
+		s.ErrorIs(err, ErrClientNotReady)
+	})
+}
+
+func (s *RBACSuite) TestSelectUser() {
+	ctx := context.Background()
+	userName := "testUser"
+
+	s.Run("normal run", func() {
+		defer s.resetMock()
+		s.mock.EXPECT().SelectUser(mock.Anything, mock.Anything).Run(func(ctx context.Context, req *server.SelectUserRequest) {
+			s.Equal(userName, req.GetUser().GetName())
+			s.True(req.GetIncludeRoleInfo())
+		}).Return(&server.SelectUserResponse{
+			Status: &common.Status{ErrorCode: common.ErrorCode_Success},
+			Results: []*server.UserResult{
+				{
+					User:  &server.UserEntity{Name: userName},
+					Roles: []*server.RoleEntity{{Name: "role1"}, {Name: "role2"}},
+				},
+			},
+		}, nil)
+
+		user, err := s.client.SelectUser(ctx, userName)
+		s.NoError(err)
+		s.Equal(entity.User{Name: userName, Roles: []string{"role1", "role2"}}, user)
+	})
+
+	s.Run("not found", func() {
+		defer s.resetMock()
+		s.mock.EXPECT().SelectUser(mock.Anything, mock.Anything).Return(&server.SelectUserResponse{
+			Status: &common.Status{ErrorCode: common.ErrorCode_Success},
+		}, nil)
+
+		_, err := s.client.SelectUser(ctx, userName)
+		s.ErrorIs(err, ErrNotFound)
+	})
+
+	s.Run("status error", func() {
+		defer s.resetMock()
+		s.mock.EXPECT().SelectUser(mock.Anything, mock.Anything).Return(&server.SelectUserResponse{
+			Status: &common.Status{ErrorCode: common.ErrorCode_UnexpectedError},
+		}, nil)
+
+		_, err := s.client.SelectUser(ctx, userName)
 		s.Error(err)
+	})
+}
+
+func (s *RBACSuite) TestSelectRole() {
+	ctx := context.Background()
+	roleName := "testRole"
+
+	s.Run("normal run", func() {
+		defer s.resetMock()
+		s.mock.EXPECT().SelectRole(mock.Anything, mock.Anything).Run(func(ctx context.Context, req *server.SelectRoleRequest) {
+			s.Equal(roleName, req.GetRole().GetName())
+			s.True(req.GetIncludeUserInfo())
+		}).Return(&server.SelectRoleResponse{
+			Status: &common.Status{ErrorCode: common.ErrorCode_Success},
+			Results: []*server.RoleResult{
+				{
+					Role:  &server.RoleEntity{Name: roleName},
+					Users: []*server.UserEntity{{Name: "user1"}},
+				},
+			},
+		}, nil)
+
+		role, err := s.client.SelectRole(ctx, roleName)
+		s.NoError(err)
+		s.Equal(entity.Role{Name: roleName, Users: []string{"user1"}}, role)
+	})
+
+	s.Run("not found", func() {
+		defer s.resetMock()
+		s.mock.EXPECT().SelectRole(mock.Anything, mock.Anything).Return(&server.SelectRoleResponse{
+			Status: &common.Status{ErrorCode: common.ErrorCode_Success},
+		}, nil)
+
+		_, err := s.client.SelectRole(ctx, roleName)
+		s.ErrorIs(err, ErrNotFound)
+	})
+
+	s.Run("rpc error", func() {
+		defer s.resetMock()
+		s.mock.EXPECT().SelectRole(mock.Anything, mock.Anything).Return(nil, errors.New("mock error"))
+
+		_, err := s.client.SelectRole(ctx, roleName)
+		s.Error(err)
+	})
+}
+
+func (s *RBACSuite) TestSelectGrant() {
+	ctx := context.Background()
+	roleName := "testRole"
+
+	grantEntities := []*server.GrantEntity{
+		{
+			Role:       &server.RoleEntity{Name: roleName},
+			Object:     &server.ObjectEntity{Name: "Collection"},
+			ObjectName: testCollectionName,
+			Grantor: &server.GrantorEntity{
+				User:      &server.UserEntity{Name: "root"},
+				Privilege: &server.PrivilegeEntity{Name: "Search"},
+			},
+			DbName: "default",
+		},
+		{
+			Role:       &server.RoleEntity{Name: roleName},
+			Object:     &server.ObjectEntity{Name: "Global"},
+			ObjectName: "*",
+			Grantor: &server.GrantorEntity{
+				User:      &server.UserEntity{Name: "root"},
+				Privilege: &server.PrivilegeEntity{Name: "CreateCollection"},
+			},
+			DbName: "default",
+		},
+	}
+	expected := []entity.Grant{
+		{
+			Role:       roleName,
+			ObjectType: entity.PriviledegeObjectTypeCollection,
+			Object:     testCollectionName,
+			Privilege:  entity.PrivilegeSearch,
+			Grantor:    "root",
+			DBName:     "default",
+		},
+		{
+			Role:       roleName,
+			ObjectType: entity.PriviledegeObjectTypeGlobal,
+			Object:     "*",
+			Privilege:  entity.PrivilegeCreateCollection,
+			Grantor:    "root",
+			DBName:     "default",
+		},
+	}
+
+	s.Run("list grants", func() {
+		defer s.resetMock()
+		s.mock.EXPECT().SelectGrant(mock.Anything, mock.Anything).Run(func(ctx context.Context, req *server.SelectGrantRequest) {
+			s.Equal(roleName, req.GetEntity().GetRole().GetName())
+			s.Nil(req.GetEntity().GetObject())
+		}).Return(&server.SelectGrantResponse{
+			Status:   &common.Status{ErrorCode: common.ErrorCode_Success},
+			Entities: grantEntities,
+		}, nil)
+
+		grants, err := s.client.ListGrants(ctx, roleName)
+		s.NoError(err)
+		s.Equal(expected, grants)
+	})
+
+	s.Run("select grant", func() {
+		defer s.resetMock()
+		s.mock.EXPECT().SelectGrant(mock.Anything, mock.Anything).Run(func(ctx context.Context, req *server.SelectGrantRequest) {
+			s.Equal(roleName, req.GetEntity().GetRole().GetName())
+			s.Equal("Collection", req.GetEntity().GetObject().GetName())
+			s.Equal(testCollectionName, req.GetEntity().GetObjectName())
+		}).Return(&server.SelectGrantResponse{
+			Status:   &common.Status{ErrorCode: common.ErrorCode_Success},
+			Entities: grantEntities[:1],
+		}, nil)
+
+		grants, err := s.client.SelectGrant(ctx, roleName, entity.PriviledegeObjectTypeCollection, testCollectionName)
+		s.NoError(err)
+		s.Equal(expected[:1], grants)
+	})
+
+	s.Run("status error", func() {
+		defer s.resetMock()
+		s.mock.EXPECT().SelectGrant(mock.Anything, mock.Anything).Return(&server.SelectGrantResponse{
+			Status: &common.Status{ErrorCode: common.ErrorCode_UnexpectedError},
+		}, nil)
+
+		_, err := s.client.ListGrants(ctx, roleName)
+		s.Error(err)
+	})
+
+	s.Run("service not ready", func() {
+		c := &GrpcClient{}
+		_, err := c.SelectGrant(ctx, roleName, entity.PriviledegeObjectTypeCollection, testCollectionName)
 		s.ErrorIs(err, ErrClientNotReady)
 	})
 }
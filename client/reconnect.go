@@ -0,0 +1,112 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"log/slog"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+)
+
+const (
+	reconnectBaseDelay = 100 * time.Millisecond
+	reconnectMaxDelay  = 3 * time.Second
+)
+
+// closedInterceptor rejects all requests once the client is closed.
+func (c *GrpcClient) closedInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if c.isClosed() {
+			return ErrClientClosed
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+func (c *GrpcClient) isClosed() bool {
+	return atomic.LoadInt32(&c.closed) == 1
+}
+
+// supervise watches the connectivity state of conn since state until the client is closed.
+// Once the connection is lost, it makes grpc redial the remote and
+// re-issues the `Connect` handshake after the connection is re-established,
+// so that the identifier and cached metadata are refreshed.
+func (c *GrpcClient) supervise(conn *grpc.ClientConn, state connectivity.State) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		<-c.done
+		cancel()
+	}()
+
+	established := state == connectivity.Ready
+	lost := false
+	for {
+		switch state {
+		case connectivity.Ready:
+			if lost {
+				c.reconnect(ctx)
+			}
+			established, lost = true, false
+		case connectivity.Idle:
+			// idle connection does not redial until next request, trigger it now
+			lost = lost || established
+			conn.Connect()
+		case connectivity.Connecting, connectivity.TransientFailure:
+			lost = lost || established
+		case connectivity.Shutdown:
+			return
+		}
+		if !conn.WaitForStateChange(ctx, state) {
+			return
+		}
+		state = conn.GetState()
+	}
+}
+
+// reconnect re-runs handshake with backoff until success or ctx done.
+func (c *GrpcClient) reconnect(ctx context.Context) {
+	c.cache.reset()
+	if c.config.DisableConn {
+		return
+	}
+	logger := newClientLogger(c.config.Logger)
+	logger.log(ctx, slog.LevelInfo, "connection lost, reconnecting", slog.String("address", c.config.getParsedAddress()))
+	delay := reconnectBaseDelay
+	for attempt := 1; ; attempt++ {
+		err := c.connectInternal(ctx)
+		logger.reconnect(ctx, attempt, err)
+		if err == nil {
+			return
+		}
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+		delay *= 2
+		if delay > reconnectMaxDelay {
+			delay = reconnectMaxDelay
+		}
+	}
+}
@@ -0,0 +1,133 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	common "github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	server "github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-sdk-go/v2/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+type ReconnectSuite struct {
+	suite.Suite
+
+	mu  sync.Mutex
+	lis *bufconn.Listener
+	svr *grpc.Server
+}
+
+// startServer starts a new mock server, which assigns the provided identifier in handshake.
+func (s *ReconnectSuite) startServer(identifier int64) *mocks.MilvusServiceServer {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.lis = bufconn.Listen(bufSize)
+	s.svr = grpc.NewServer()
+	m := &mocks.MilvusServiceServer{}
+	m.EXPECT().Connect(mock.Anything, mock.Anything).Return(&server.ConnectResponse{
+		Status:     &common.Status{},
+		Identifier: identifier,
+	}, nil).Maybe()
+	m.EXPECT().GetVersion(mock.Anything, mock.Anything).
+		Return(&server.GetVersionResponse{Status: &common.Status{}, Version: "2.3.0"}, nil).Maybe()
+	server.RegisterMilvusServiceServer(s.svr, m)
+	go s.svr.Serve(s.lis)
+	return m
+}
+
+func (s *ReconnectSuite) stopServer() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.svr.Stop()
+	s.lis.Close()
+}
+
+func (s *ReconnectSuite) dialer(context.Context, string) (net.Conn, error) {
+	s.mu.Lock()
+	lis := s.lis
+	s.mu.Unlock()
+	return lis.Dial()
+}
+
+func (s *ReconnectSuite) TearDownTest() {
+	s.stopServer()
+}
+
+func (s *ReconnectSuite) newClient() *GrpcClient {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	c, err := NewClient(ctx, Config{
+		Address: "bufnet",
+		DialOptions: []grpc.DialOption{
+			grpc.WithBlock(),
+			grpc.WithInsecure(),
+			grpc.WithContextDialer(s.dialer),
+		},
+	})
+	s.Require().NoError(err)
+	return c.(*GrpcClient)
+}
+
+func (s *ReconnectSuite) TestReconnect() {
+	s.startServer(1)
+	c := s.newClient()
+	defer c.Close()
+	s.Equal("1", c.config.getIdentifier())
+
+	// proxy restarts and assigns new identifier
+	s.stopServer()
+	s.startServer(2)
+
+	s.Eventually(func() bool {
+		return c.config.getIdentifier() == "2"
+	}, 10*time.Second, 10*time.Millisecond)
+
+	version, err := c.GetVersion(context.Background())
+	s.NoError(err)
+	s.Equal("2.3.0", version)
+}
+
+func (s *ReconnectSuite) TestClosed() {
+	s.startServer(1)
+	c := s.newClient()
+
+	_, err := c.GetVersion(context.Background())
+	s.NoError(err)
+
+	s.NoError(c.Close())
+	// close is idempotent
+	s.NoError(c.Close())
+
+	_, err = c.GetVersion(context.Background())
+	s.True(errors.Is(err, ErrClientClosed))
+	_, err = c.ListCollections(context.Background())
+	s.True(errors.Is(err, ErrClientClosed))
+}
+
+func TestReconnect(t *testing.T) {
+	suite.Run(t, new(ReconnectSuite))
+}
@@ -19,7 +19,9 @@ import (
 )
 
 // ListResourceGroups returns list of resource group names in current Milvus instance.
-func (c *GrpcClient) ListResourceGroups(ctx context.Context) ([]string, error) {
+func (c *GrpcClient) ListResourceGroups(ctx context.Context) (_ []string, err error) {
+	ctx, span := c.startSpan(ctx, "ListResourceGroups")
+	defer func() { endSpan(span, err) }()
 	if c.Service == nil {
 		return nil, ErrClientNotReady
 	}
@@ -30,7 +32,7 @@ func (c *GrpcClient) ListResourceGroups(ctx context.Context) ([]string, error) {
 	if err != nil {
 		return nil, err
 	}
-	if err = handleRespStatus(resp.GetStatus()); err != nil {
+	if err = handleRespStatus(ctx, "", resp.GetStatus()); err != nil {
 		return nil, err
 	}
 
@@ -38,7 +40,9 @@ func (c *GrpcClient) ListResourceGroups(ctx context.Context) ([]string, error) {
 }
 
 // CreateResourceGroup creates a resource group with provided name.
-func (c *GrpcClient) CreateResourceGroup(ctx context.Context, rgName string) error {
+func (c *GrpcClient) CreateResourceGroup(ctx context.Context, rgName string) (err error) {
+	ctx, span := c.startSpan(ctx, "CreateResourceGroup")
+	defer func() { endSpan(span, err) }()
 	if c.Service == nil {
 		return ErrClientNotReady
 	}
@@ -51,11 +55,13 @@ func (c *GrpcClient) CreateResourceGroup(ctx context.Context, rgName string) err
 	if err != nil {
 		return err
 	}
-	return handleRespStatus(resp)
+	return handleRespStatus(ctx, "", resp)
 }
 
 // DescribeResourceGroup returns resource groups information.
-func (c *GrpcClient) DescribeResourceGroup(ctx context.Context, rgName string) (*entity.ResourceGroup, error) {
+func (c *GrpcClient) DescribeResourceGroup(ctx context.Context, rgName string) (_ *entity.ResourceGroup, err error) {
+	ctx, span := c.startSpan(ctx, "DescribeResourceGroup")
+	defer func() { endSpan(span, err) }()
 	if c.Service == nil {
 		return nil, ErrClientNotReady
 	}
@@ -68,7 +74,7 @@ func (c *GrpcClient) DescribeResourceGroup(ctx context.Context, rgName string) (
 	if err != nil {
 		return nil, err
 	}
-	if err = handleRespStatus(resp.GetStatus()); err != nil {
+	if err = handleRespStatus(ctx, "", resp.GetStatus()); err != nil {
 		return nil, err
 	}
 
@@ -86,7 +92,9 @@ func (c *GrpcClient) DescribeResourceGroup(ctx context.Context, rgName string) (
 }
 
 // DropResourceGroup drops the resource group with provided name.
-func (c *GrpcClient) DropResourceGroup(ctx context.Context, rgName string) error {
+func (c *GrpcClient) DropResourceGroup(ctx context.Context, rgName string) (err error) {
+	ctx, span := c.startSpan(ctx, "DropResourceGroup")
+	defer func() { endSpan(span, err) }()
 	if c.Service == nil {
 		return ErrClientNotReady
 	}
@@ -99,11 +107,13 @@ func (c *GrpcClient) DropResourceGroup(ctx context.Context, rgName string) error
 	if err != nil {
 		return err
 	}
-	return handleRespStatus(resp)
+	return handleRespStatus(ctx, "", resp)
 }
 
 // TransferNode transfers querynodes between resource groups.
-func (c *GrpcClient) TransferNode(ctx context.Context, sourceRg, targetRg string, nodesNum int32) error {
+func (c *GrpcClient) TransferNode(ctx context.Context, sourceRg, targetRg string, nodesNum int32) (err error) {
+	ctx, span := c.startSpan(ctx, "TransferNode")
+	defer func() { endSpan(span, err) }()
 	if c.Service == nil {
 		return ErrClientNotReady
 	}
@@ -118,11 +128,13 @@ func (c *GrpcClient) TransferNode(ctx context.Context, sourceRg, targetRg string
 	if err != nil {
 		return err
 	}
-	return handleRespStatus(resp)
+	return handleRespStatus(ctx, "", resp)
 }
 
 // TransferReplica transfer collection replicas between source,target resource group.
-func (c *GrpcClient) TransferReplica(ctx context.Context, sourceRg, targetRg string, collectionName string, replicaNum int64) error {
+func (c *GrpcClient) TransferReplica(ctx context.Context, sourceRg, targetRg string, collectionName string, replicaNum int64) (err error) {
+	ctx, span := c.startSpan(ctx, "TransferReplica", AttrCollection.String(collectionName))
+	defer func() { endSpan(span, err) }()
 	if c.Service == nil {
 		return ErrClientNotReady
 	}
@@ -138,5 +150,5 @@ func (c *GrpcClient) TransferReplica(ctx context.Context, sourceRg, targetRg str
 	if err != nil {
 		return err
 	}
-	return handleRespStatus(resp)
+	return handleRespStatus(ctx, collectionName, resp)
 }
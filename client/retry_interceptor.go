@@ -0,0 +1,218 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"strings"
+	"time"
+
+	common "github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// MethodClass classifies milvus service methods by how they can be retried.
+type MethodClass int
+
+const (
+	// MethodClassRead contains the methods without side effect, Search/Query/Describe etc.
+	MethodClassRead MethodClass = iota
+	// MethodClassWrite contains the idempotent methods with side effect, DDL/Delete/Upsert etc.
+	MethodClassWrite
+	// MethodClassNonIdempotent contains the methods which may apply twice if retried, Insert/Import.
+	MethodClassNonIdempotent
+)
+
+var (
+	readMethodPrefixes   = []string{"Has", "Describe", "Show", "List", "Get", "Select", "Search", "Query", "Check", "CalcDistance"}
+	nonIdempotentMethods = map[string]struct{}{"Insert": {}, "Import": {}}
+)
+
+// methodClassOf returns the class of full grpc method name, "/milvus.proto.milvus.MilvusService/Insert" for example.
+func methodClassOf(fullMethod string) MethodClass {
+	method := methodName(fullMethod)
+	if _, ok := nonIdempotentMethods[method]; ok {
+		return MethodClassNonIdempotent
+	}
+	for _, prefix := range readMethodPrefixes {
+		if strings.HasPrefix(method, prefix) {
+			return MethodClassRead
+		}
+	}
+	return MethodClassWrite
+}
+
+// methodName returns the method name without service of full grpc method name.
+func methodName(fullMethod string) string {
+	return fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+}
+
+// RetryRule defines when and how a failed request is retried.
+type RetryRule struct {
+	MaxAttempts    uint          // Max attempts including the first one, 0 or 1 disables retry.
+	InitialBackoff time.Duration // Backoff before the first retry.
+	MaxBackoff     time.Duration // Upper bound of backoff, global MaxBackOff is also applied.
+	Multiplier     float64       // Factor backoff grows with after each retry, 1 if not set.
+	Jitter         float64       // Randomize backoff in range of [1-Jitter, 1+Jitter] times.
+
+	RetryableCodes      []codes.Code       // grpc status codes to retry on.
+	RetryableErrorCodes []common.ErrorCode // milvus error codes in response status to retry on.
+}
+
+// backoff returns the duration to wait before the provided retry attempt, which starts from 1.
+func (r RetryRule) backoff(attempt uint) time.Duration {
+	multiplier := r.Multiplier
+	if multiplier <= 0 {
+		multiplier = 1
+	}
+	backoff := float64(r.InitialBackoff) * math.Pow(multiplier, float64(attempt-1))
+	if r.MaxBackoff > 0 && backoff > float64(r.MaxBackoff) {
+		backoff = float64(r.MaxBackoff)
+	}
+	if r.Jitter > 0 {
+		backoff *= 1 + r.Jitter*(rand.Float64()*2-1)
+	}
+	return time.Duration(backoff)
+}
+
+func (r RetryRule) retryableCode(code codes.Code) bool {
+	for _, c := range r.RetryableCodes {
+		if c == code {
+			return true
+		}
+	}
+	return false
+}
+
+func (r RetryRule) retryableErrorCode(ctx context.Context, errorCode common.ErrorCode) bool {
+	if errorCode == common.ErrorCode_RateLimit && !retryOnRateLimit(ctx) {
+		return false
+	}
+	for _, c := range r.RetryableErrorCodes {
+		if c == errorCode {
+			return true
+		}
+	}
+	return false
+}
+
+// RetryPolicy defines the retry rule for each method class.
+type RetryPolicy struct {
+	Read          RetryRule // Rule for MethodClassRead.
+	Write         RetryRule // Rule for MethodClassWrite.
+	NonIdempotent RetryRule // Rule for MethodClassNonIdempotent.
+}
+
+// DefaultRetryPolicy returns the policy used when Config.RetryPolicy is not provided.
+// Read and idempotent write requests are retried on unavailable, resource exhausted
+// and rate limit, non-idempotent requests are only retried when rejected by server.
+func DefaultRetryPolicy() *RetryPolicy {
+	rule := RetryRule{
+		MaxAttempts:         6,
+		InitialBackoff:      60 * time.Millisecond,
+		MaxBackoff:          3 * time.Second,
+		Multiplier:          3,
+		Jitter:              0.2,
+		RetryableCodes:      []codes.Code{codes.Unavailable, codes.ResourceExhausted},
+		RetryableErrorCodes: []common.ErrorCode{common.ErrorCode_RateLimit},
+	}
+	nonIdempotent := rule
+	nonIdempotent.RetryableCodes = []codes.Code{codes.ResourceExhausted}
+	return &RetryPolicy{
+		Read:          rule,
+		Write:         rule,
+		NonIdempotent: nonIdempotent,
+	}
+}
+
+func (p *RetryPolicy) ruleOf(class MethodClass) RetryRule {
+	switch class {
+	case MethodClassRead:
+		return p.Read
+	case MethodClassNonIdempotent:
+		return p.NonIdempotent
+	default:
+		return p.Write
+	}
+}
+
+type retryRuleCtxKey struct{}
+
+// WithRetryRule overrides the retry rule of the requests made with returned context.
+func WithRetryRule(ctx context.Context, rule RetryRule) context.Context {
+	return context.WithValue(ctx, retryRuleCtxKey{}, rule)
+}
+
+// WithoutRetry disables retry for the requests made with returned context.
+func WithoutRetry(ctx context.Context) context.Context {
+	return WithRetryRule(ctx, RetryRule{})
+}
+
+// retryRuleOf returns the retry rule of method in policy, or the one overridden by WithRetryRule.
+func retryRuleOf(ctx context.Context, policy *RetryPolicy, method string) RetryRule {
+	if rule, ok := ctx.Value(retryRuleCtxKey{}).(RetryRule); ok {
+		return rule
+	}
+	return policy.ruleOf(methodClassOf(method))
+}
+
+// retryInterceptor returns a unary client interceptor retrying requests with the rules in policy,
+// both grpc status code and error code in response status are checked.
+func retryInterceptor(policy *RetryPolicy, logger clientLogger) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		rule := retryRuleOf(ctx, policy, method)
+		if rule.MaxAttempts <= 1 {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+
+		var lastErr error
+		var lastStatus *common.Status
+		for attempt := uint(0); attempt < rule.MaxAttempts; attempt++ {
+			var backoff time.Duration
+			if attempt > 0 {
+				backoff = rule.backoff(attempt)
+				if backoff > MaxBackOff {
+					backoff = MaxBackOff
+				}
+				logger.retry(ctx, methodName(method), attempt, backoff, lastErr, lastStatus)
+			}
+			_, err := waitRetryBackoff(ctx, attempt, func(context.Context, uint) time.Duration {
+				return backoff
+			})
+			if err != nil {
+				return err
+			}
+			recordRetryAttempt(ctx, attempt)
+			lastErr = invoker(ctx, method, req, reply, cc, opts...)
+			if lastErr != nil {
+				if rule.retryableCode(status.Code(lastErr)) {
+					continue
+				}
+				return lastErr
+			}
+			if lastStatus = getResultStatus(reply); rule.retryableErrorCode(ctx, lastStatus.GetErrorCode()) {
+				continue
+			}
+			return nil
+		}
+		return lastErr
+	}
+}
@@ -0,0 +1,163 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	common "github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	server "github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestMethodClassOf(t *testing.T) {
+	prefix := "/milvus.proto.milvus.MilvusService/"
+	cases := map[string]MethodClass{
+		"Search":             MethodClassRead,
+		"Query":              MethodClassRead,
+		"DescribeCollection": MethodClassRead,
+		"HasCollection":      MethodClassRead,
+		"ShowPartitions":     MethodClassRead,
+		"GetVersion":         MethodClassRead,
+		"ListDatabases":      MethodClassRead,
+		"Insert":             MethodClassNonIdempotent,
+		"Import":             MethodClassNonIdempotent,
+		"Upsert":             MethodClassWrite,
+		"Delete":             MethodClassWrite,
+		"CreateCollection":   MethodClassWrite,
+		"Flush":              MethodClassWrite,
+	}
+	for method, class := range cases {
+		assert.Equal(t, class, methodClassOf(prefix+method), method)
+	}
+}
+
+func TestRetryRuleBackoff(t *testing.T) {
+	rule := RetryRule{InitialBackoff: 10 * time.Millisecond, MaxBackoff: 50 * time.Millisecond, Multiplier: 2}
+	assert.Equal(t, 10*time.Millisecond, rule.backoff(1))
+	assert.Equal(t, 20*time.Millisecond, rule.backoff(2))
+	assert.Equal(t, 40*time.Millisecond, rule.backoff(3))
+	assert.Equal(t, 50*time.Millisecond, rule.backoff(4))
+
+	rule.Multiplier = 0
+	assert.Equal(t, 10*time.Millisecond, rule.backoff(3))
+
+	rule.Jitter = 0.5
+	for i := 0; i < 100; i++ {
+		backoff := rule.backoff(1)
+		assert.GreaterOrEqual(t, backoff, 5*time.Millisecond)
+		assert.LessOrEqual(t, backoff, 15*time.Millisecond)
+	}
+}
+
+func TestRetryInterceptor(t *testing.T) {
+	rule := RetryRule{
+		MaxAttempts:         3,
+		InitialBackoff:      time.Millisecond,
+		RetryableCodes:      []codes.Code{codes.Unavailable},
+		RetryableErrorCodes: []common.ErrorCode{common.ErrorCode_RateLimit},
+	}
+	noUnavailable := rule
+	noUnavailable.RetryableCodes = nil
+	inter := retryInterceptor(&RetryPolicy{Read: rule, Write: rule, NonIdempotent: noUnavailable}, clientLogger{})
+
+	invokeTimes := 0
+	invoker := func(err error, errorCode common.ErrorCode) grpc.UnaryInvoker {
+		invokeTimes = 0
+		return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+			invokeTimes++
+			if r, ok := reply.(*server.BoolResponse); ok {
+				r.Status = &common.Status{ErrorCode: errorCode}
+			}
+			return err
+		}
+	}
+	unavailable := status.Error(codes.Unavailable, "unavailable")
+	ctx := context.Background()
+
+	t.Run("grpc_code", func(t *testing.T) {
+		err := inter(ctx, "/milvus.proto.milvus.MilvusService/HasCollection", nil, &server.BoolResponse{}, nil, invoker(unavailable, common.ErrorCode_Success))
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+		assert.Equal(t, 3, invokeTimes)
+
+		err = inter(ctx, "/milvus.proto.milvus.MilvusService/HasCollection", nil, &server.BoolResponse{}, nil, invoker(status.Error(codes.Internal, "internal"), common.ErrorCode_Success))
+		assert.Equal(t, codes.Internal, status.Code(err))
+		assert.Equal(t, 1, invokeTimes)
+	})
+
+	t.Run("error_code", func(t *testing.T) {
+		err := inter(ctx, "/milvus.proto.milvus.MilvusService/HasCollection", nil, &server.BoolResponse{}, nil, invoker(nil, common.ErrorCode_RateLimit))
+		assert.NoError(t, err)
+		assert.Equal(t, 3, invokeTimes)
+
+		err = inter(ctx, "/milvus.proto.milvus.MilvusService/HasCollection", nil, &server.BoolResponse{}, nil, invoker(nil, common.ErrorCode_UnexpectedError))
+		assert.NoError(t, err)
+		assert.Equal(t, 1, invokeTimes)
+
+		// rate limit retry disabled by context
+		err = inter(context.WithValue(ctx, RetryOnRateLimit, false), "/milvus.proto.milvus.MilvusService/HasCollection", nil, &server.BoolResponse{}, nil, invoker(nil, common.ErrorCode_RateLimit))
+		assert.NoError(t, err)
+		assert.Equal(t, 1, invokeTimes)
+	})
+
+	t.Run("non_idempotent", func(t *testing.T) {
+		err := inter(ctx, "/milvus.proto.milvus.MilvusService/Insert", nil, &server.BoolResponse{}, nil, invoker(unavailable, common.ErrorCode_Success))
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+		assert.Equal(t, 1, invokeTimes)
+
+		err = inter(ctx, "/milvus.proto.milvus.MilvusService/Insert", nil, &server.BoolResponse{}, nil, invoker(nil, common.ErrorCode_RateLimit))
+		assert.NoError(t, err)
+		assert.Equal(t, 3, invokeTimes)
+	})
+
+	t.Run("context_override", func(t *testing.T) {
+		err := inter(WithoutRetry(ctx), "/milvus.proto.milvus.MilvusService/HasCollection", nil, &server.BoolResponse{}, nil, invoker(unavailable, common.ErrorCode_Success))
+		assert.Error(t, err)
+		assert.Equal(t, 1, invokeTimes)
+
+		override := rule
+		override.MaxAttempts = 5
+		err = inter(WithRetryRule(ctx, override), "/milvus.proto.milvus.MilvusService/Insert", nil, &server.BoolResponse{}, nil, invoker(unavailable, common.ErrorCode_Success))
+		assert.Error(t, err)
+		assert.Equal(t, 5, invokeTimes)
+	})
+
+	t.Run("context_done", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(ctx)
+		cancel()
+		err := inter(ctx, "/milvus.proto.milvus.MilvusService/HasCollection", nil, &server.BoolResponse{}, nil, invoker(unavailable, common.ErrorCode_Success))
+		assert.Error(t, err)
+		assert.LessOrEqual(t, invokeTimes, 1)
+	})
+}
+
+func TestDefaultRetryPolicy(t *testing.T) {
+	policy := DefaultRetryPolicy()
+	assert.True(t, policy.Read.retryableCode(codes.Unavailable))
+	assert.True(t, policy.Write.retryableCode(codes.Unavailable))
+	assert.False(t, policy.NonIdempotent.retryableCode(codes.Unavailable))
+	assert.True(t, policy.NonIdempotent.retryableCode(codes.ResourceExhausted))
+	assert.True(t, policy.NonIdempotent.retryableErrorCode(context.Background(), common.ErrorCode_RateLimit))
+
+	c := &Config{RetryPolicy: policy}
+	assert.Same(t, policy, c.Copy().RetryPolicy)
+}
@@ -14,7 +14,9 @@ import (
 )
 
 // CreateCollectionByRow create collection by row
-func (c *GrpcClient) CreateCollectionByRow(ctx context.Context, row entity.Row, shardNum int32) error {
+func (c *GrpcClient) CreateCollectionByRow(ctx context.Context, row entity.Row, shardNum int32) (err error) {
+	ctx, span := c.startSpan(ctx, "CreateCollectionByRow")
+	defer func() { endSpan(span, err) }()
 	if c.Service == nil {
 		return ErrClientNotReady
 	}
@@ -31,7 +33,7 @@ func (c *GrpcClient) CreateCollectionByRow(ctx context.Context, row entity.Row,
 	}
 	// already exists collection with same name, return error
 	if has {
-		return fmt.Errorf("collection %s already exist", sch.CollectionName)
+		return fmt.Errorf("collection %s %w", sch.CollectionName, ErrAlreadyExists)
 	}
 	// marshal schema to bytes for message transfer
 	p := sch.ProtoMessage()
@@ -51,7 +53,7 @@ func (c *GrpcClient) CreateCollectionByRow(ctx context.Context, row entity.Row,
 	if err != nil {
 		return err
 	}
-	err = handleRespStatus(resp)
+	err = handleRespStatus(ctx, sch.CollectionName, resp)
 	if err != nil {
 		return nil
 	}
@@ -60,7 +62,9 @@ func (c *GrpcClient) CreateCollectionByRow(ctx context.Context, row entity.Row,
 
 // InsertByRows insert by rows
 func (c *GrpcClient) InsertByRows(ctx context.Context, collName string, partitionName string,
-	rows []entity.Row) (entity.Column, error) {
+	rows []entity.Row) (_ entity.Column, err error) {
+	ctx, span := c.startSpan(ctx, "InsertByRows", AttrCollection.String(collName), partitionCount(partitionName), AttrRowCount.Int(len(rows)))
+	defer func() { endSpan(span, err) }()
 	if c.Service == nil {
 		return nil, ErrClientNotReady
 	}
@@ -68,47 +72,42 @@ func (c *GrpcClient) InsertByRows(ctx context.Context, collName string, partitio
 		return nil, errors.New("empty rows provided")
 	}
 
-	if err := c.checkCollectionExists(ctx, collName); err != nil {
-		return nil, err
-	}
-	if partitionName != "" {
-		if err := c.checkPartitionExists(ctx, collName, partitionName); err != nil {
-			return nil, err
-		}
-	}
-	coll, err := c.DescribeCollection(ctx, collName)
-	if err != nil {
-		return nil, err
-	}
-	// 1. convert rows to columns
-	columns, err := entity.RowsToColumns(rows, coll.Schema)
-	if err != nil {
-		return nil, err
-	}
-	//fieldData
-	// 2. do insert request
-	req := &server.InsertRequest{
-		DbName:         "", // reserved
-		CollectionName: collName,
-		PartitionName:  partitionName,
-	}
-	//c.Service.
-	if req.PartitionName == "" {
-		req.PartitionName = "_default" // use default partition
-	}
-	req.NumRows = uint32(len(rows))
-	for _, column := range columns {
-		req.FieldsData = append(req.FieldsData, column.FieldData())
-	}
-	resp, err := c.Service.Insert(ctx, req)
+	var req *server.InsertRequest
+	var resp *server.MutationResult
+	err = c.writeWithCachedMeta(ctx, collName, partitionName,
+		func(sch *entity.Schema) error {
+			// convert rows to columns
+			columns, err := entity.RowsToColumns(rows, sch)
+			if err != nil {
+				return err
+			}
+			req = &server.InsertRequest{
+				DbName:         "", // reserved
+				CollectionName: collName,
+				PartitionName:  partitionName,
+				NumRows:        uint32(len(rows)),
+			}
+			if req.PartitionName == "" {
+				req.PartitionName = "_default" // use default partition
+			}
+			for _, column := range columns {
+				req.FieldsData = append(req.FieldsData, column.FieldData())
+			}
+			return nil
+		},
+		func() error {
+			var err error
+			resp, err = c.Service.Insert(ctx, req)
+			if err != nil {
+				return err
+			}
+			return c.handleCollectionRespStatus(ctx, collName, resp.GetStatus())
+		})
 	if err != nil {
 		return nil, err
 	}
-	if err := handleRespStatus(resp.GetStatus()); err != nil {
-		return nil, err
-	}
-	MetaCache.setSessionTs(collName, resp.Timestamp)
-	// 3. parse id column
+	c.cache.setSessionTs(c.metaKey(ctx, collName), resp.Timestamp)
+	// parse id column
 	return entity.IDColumns(resp.GetIDs(), 0, -1)
 }
 
@@ -218,6 +217,26 @@ var (
 func SetFieldValue(field *entity.Field, f reflect.Value, fieldData *schema.FieldData, idx int) error {
 	scalars := fieldData.GetScalars()
 	vectors := fieldData.GetVectors()
+	if validData := fieldData.GetValidData(); validData != nil {
+		if idx >= len(validData) {
+			return ErrFieldTypeNotMatch
+		}
+		// null value leaves field zero value
+		if !validData[idx] {
+			f.Set(reflect.Zero(f.Type()))
+			return nil
+		}
+		// compacted data contains valid values only
+		if scalarDataLen(scalars) < len(validData) {
+			valid := 0
+			for _, v := range validData[:idx] {
+				if v {
+					valid++
+				}
+			}
+			idx = valid
+		}
+	}
 	// This switch part is messy
 	// Maybe this can be refactored later
 	switch field.DataType {
@@ -366,8 +385,73 @@ func SetFieldValue(field *entity.Field, f reflect.Value, fieldData *schema.Field
 		default:
 			return ErrFieldTypeNotMatch
 		}
+	case entity.FieldTypeFloat16Vector, entity.FieldTypeBFloat16Vector:
+		if vectors == nil {
+			return ErrFieldTypeNotMatch
+		}
+		var data []byte
+		if field.DataType == entity.FieldTypeFloat16Vector {
+			data = vectors.GetFloat16Vector()
+		} else {
+			data = vectors.GetBfloat16Vector()
+		}
+		if data == nil {
+			return ErrFieldTypeNotMatch
+		}
+		vector := reflect.ValueOf(data[idx*int(vectors.Dim)*2 : (idx+1)*int(vectors.Dim)*2])
+		if f.Kind() != reflect.Slice || !vector.Type().ConvertibleTo(f.Type()) {
+			return ErrFieldTypeNotMatch
+		}
+		f.Set(vector.Convert(f.Type()))
+	case entity.FieldTypeSparseFloatVector:
+		if f.Type() != reflect.TypeOf(entity.SparseFloatVector{}) {
+			return ErrFieldTypeNotMatch
+		}
+		contents := vectors.GetSparseFloatVector().GetContents()
+		if idx >= len(contents) {
+			return ErrFieldTypeNotMatch
+		}
+		vector, err := entity.DeserializeSparseFloatVector(contents[idx])
+		if err != nil {
+			return err
+		}
+		f.Set(reflect.ValueOf(vector))
+	case entity.FieldTypeArray:
+		if f.Kind() != reflect.Slice {
+			return ErrFieldTypeNotMatch
+		}
+		column, err := entity.FieldDataColumn(fieldData, idx, idx+1)
+		if err != nil || column.Len() != 1 {
+			return ErrFieldTypeNotMatch
+		}
+		v, _ := column.Get(0)
+		rv := reflect.ValueOf(v)
+		if !rv.Type().AssignableTo(f.Type()) {
+			return ErrFieldTypeNotMatch
+		}
+		f.Set(rv)
 	default:
 		return ErrFieldTypeNotMatch
 	}
 	return nil
 }
+
+// scalarDataLen returns the number of values in scalar field.
+func scalarDataLen(scalars *schema.ScalarField) int {
+	switch data := scalars.GetData().(type) {
+	case *schema.ScalarField_BoolData:
+		return len(data.BoolData.GetData())
+	case *schema.ScalarField_IntData:
+		return len(data.IntData.GetData())
+	case *schema.ScalarField_LongData:
+		return len(data.LongData.GetData())
+	case *schema.ScalarField_FloatData:
+		return len(data.FloatData.GetData())
+	case *schema.ScalarField_DoubleData:
+		return len(data.DoubleData.GetData())
+	case *schema.ScalarField_StringData:
+		return len(data.StringData.GetData())
+	default:
+		return 0
+	}
+}
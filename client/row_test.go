@@ -108,28 +108,17 @@ func (s *InsertByRowsSuite) TestFails() {
 
 	s.Run("fail_collection_not_found", func() {
 		defer s.resetMock()
-		s.setupHasCollection()
-		_, err := c.InsertByRows(ctx, testCollectionName, partName, []entity.Row{entity.RowBase{}})
-		s.Error(err)
-	})
-
-	s.Run("fail_hascollection_errcode", func() {
-		defer s.resetMock()
-		s.setupHasCollectionError(common.ErrorCode_UnexpectedError, nil)
-		_, err := c.InsertByRows(ctx, testCollectionName, partName, []entity.Row{entity.RowBase{}})
-		s.Error(err)
-	})
-
-	s.Run("fail_hascollection_error", func() {
-		defer s.resetMock()
-		s.setupHasCollectionError(common.ErrorCode_Success, errors.New("mock error"))
+		s.setupDescribeCollectionError(common.ErrorCode_CollectionNotExists, nil)
 		_, err := c.InsertByRows(ctx, testCollectionName, partName, []entity.Row{entity.RowBase{}})
 		s.Error(err)
 	})
 
 	s.Run("fail_partition_not_found", func() {
 		defer s.resetMock()
-		s.setupHasCollection(testCollectionName)
+		s.setupDescribeCollection(testCollectionName, entity.NewSchema().
+			WithField(entity.NewField().WithName("ID").WithDataType(entity.FieldTypeInt64).WithIsPrimaryKey(true).WithIsAutoID(true)).
+			WithField(entity.NewField().WithName("vector").WithDataType(entity.FieldTypeFloatVector).WithTypeParams(entity.TypeParamDim, "128")),
+		)
 		s.setupHasPartition(testCollectionName)
 		_, err := c.InsertByRows(ctx, testCollectionName, partName, []entity.Row{entity.RowBase{}})
 		s.Error(err)
@@ -137,7 +126,10 @@ func (s *InsertByRowsSuite) TestFails() {
 
 	s.Run("fail_haspartition_error", func() {
 		defer s.resetMock()
-		s.setupHasCollection(testCollectionName)
+		s.setupDescribeCollection(testCollectionName, entity.NewSchema().
+			WithField(entity.NewField().WithName("ID").WithDataType(entity.FieldTypeInt64).WithIsPrimaryKey(true).WithIsAutoID(true)).
+			WithField(entity.NewField().WithName("vector").WithDataType(entity.FieldTypeFloatVector).WithTypeParams(entity.TypeParamDim, "128")),
+		)
 		s.setupHasPartitionError(common.ErrorCode_Success, errors.New("mock error"))
 		_, err := c.InsertByRows(ctx, testCollectionName, partName, []entity.Row{entity.RowBase{}})
 		s.Error(err)
@@ -145,7 +137,10 @@ func (s *InsertByRowsSuite) TestFails() {
 
 	s.Run("fail_haspartition_errcode", func() {
 		defer s.resetMock()
-		s.setupHasCollection(testCollectionName)
+		s.setupDescribeCollection(testCollectionName, entity.NewSchema().
+			WithField(entity.NewField().WithName("ID").WithDataType(entity.FieldTypeInt64).WithIsPrimaryKey(true).WithIsAutoID(true)).
+			WithField(entity.NewField().WithName("vector").WithDataType(entity.FieldTypeFloatVector).WithTypeParams(entity.TypeParamDim, "128")),
+		)
 		s.setupHasPartitionError(common.ErrorCode_UnexpectedError, nil)
 		_, err := c.InsertByRows(ctx, testCollectionName, partName, []entity.Row{entity.RowBase{}})
 		s.Error(err)
@@ -153,7 +148,6 @@ func (s *InsertByRowsSuite) TestFails() {
 
 	s.Run("fail_describecollection_error", func() {
 		defer s.resetMock()
-		s.setupHasCollection(testCollectionName)
 		s.setupHasPartition(testCollectionName, partName)
 		s.setupDescribeCollectionError(common.ErrorCode_Success, errors.New("mock error"))
 		_, err := c.InsertByRows(ctx, testCollectionName, partName, []entity.Row{entity.RowBase{}})
@@ -162,7 +156,6 @@ func (s *InsertByRowsSuite) TestFails() {
 
 	s.Run("fail_describecollection_errcode", func() {
 		defer s.resetMock()
-		s.setupHasCollection(testCollectionName)
 		s.setupHasPartition(testCollectionName, partName)
 		s.setupDescribeCollectionError(common.ErrorCode_UnexpectedError, nil)
 		_, err := c.InsertByRows(ctx, testCollectionName, partName, []entity.Row{entity.RowBase{}})
@@ -171,7 +164,6 @@ func (s *InsertByRowsSuite) TestFails() {
 
 	s.Run("fail_field_missing", func() {
 		defer s.resetMock()
-		s.setupHasCollection(testCollectionName)
 		s.setupHasPartition(testCollectionName, partName)
 		s.setupDescribeCollection(testCollectionName,
 			entity.NewSchema().
@@ -188,7 +180,6 @@ func (s *InsertByRowsSuite) TestFails() {
 
 	s.Run("fail_field_type_not_match", func() {
 		defer s.resetMock()
-		s.setupHasCollection(testCollectionName)
 		s.setupHasPartition(testCollectionName, partName)
 		s.setupDescribeCollection(testCollectionName,
 			entity.NewSchema().
@@ -206,7 +197,6 @@ func (s *InsertByRowsSuite) TestFails() {
 
 	s.Run("fail_extra_field", func() {
 		defer s.resetMock()
-		s.setupHasCollection(testCollectionName)
 		s.setupHasPartition(testCollectionName, partName)
 		s.setupDescribeCollection(testCollectionName,
 			entity.NewSchema().
@@ -225,7 +215,6 @@ func (s *InsertByRowsSuite) TestFails() {
 
 	s.Run("vector_dim_not_match", func() {
 		defer s.resetMock()
-		s.setupHasCollection(testCollectionName)
 		s.setupHasPartition(testCollectionName, partName)
 		s.setupDescribeCollection(testCollectionName,
 			entity.NewSchema().
@@ -250,7 +239,6 @@ func (s *InsertByRowsSuite) TestSuccess() {
 
 	s.Run("non_dynamic", func() {
 		defer s.resetMock()
-		s.setupHasCollection(testCollectionName)
 		s.setupHasPartition(testCollectionName, partName)
 		s.setupDescribeCollection(testCollectionName, entity.NewSchema().WithName(testCollectionName).
 			WithField(entity.NewField().WithName("ID").WithDataType(entity.FieldTypeInt64).WithIsPrimaryKey(true)).
@@ -280,7 +268,6 @@ func (s *InsertByRowsSuite) TestSuccess() {
 
 	s.Run("dynamic", func() {
 		defer s.resetMock()
-		s.setupHasCollection(testCollectionName)
 		s.setupHasPartition(testCollectionName, partName)
 		s.setupDescribeCollection(testCollectionName, entity.NewSchema().
 			WithName(testCollectionName).WithDynamicFieldEnabled(true).
@@ -402,6 +389,11 @@ func TestSetFieldValue(t *testing.T) {
 		String string
 		Arr    [8]float32
 		ArrBin [8]byte
+		Tags   []int64
+		Names  []string
+		Fp16   entity.Float16Vector
+		Bf16   []byte
+		Sparse entity.SparseFloatVector
 	}
 
 	t.Run("successful cases", func(t *testing.T) {
@@ -479,6 +471,59 @@ func TestSetFieldValue(t *testing.T) {
 		}, binArr, binaryVectorFieldData("", []byte{'a', 'a', 'a', 'a', 'a', 'a', 'a', 'a'}), 0)
 		assert.Nil(t, err)
 		assert.EqualValues(t, [8]byte{'a', 'a', 'a', 'a', 'a', 'a', 'a', 'a'}, item.ArrBin)
+
+		tags := reflect.ValueOf(item).Elem().FieldByName("Tags")
+		err = SetFieldValue(&entity.Field{
+			DataType:    entity.FieldTypeArray,
+			ElementType: entity.FieldTypeInt64,
+		}, tags, entity.NewColumnInt64Array("", [][]int64{{1}, {2, 3}}).FieldData(), 1)
+		assert.Nil(t, err)
+		assert.Equal(t, []int64{2, 3}, item.Tags)
+
+		fv := entity.FloatVector{1, 2}
+		fp16 := reflect.ValueOf(item).Elem().FieldByName("Fp16")
+		err = SetFieldValue(&entity.Field{
+			DataType: entity.FieldTypeFloat16Vector,
+		}, fp16, entity.NewColumnFloat16Vector("", 2, [][]byte{make([]byte, 4), fv.ToFloat16Vector()}).FieldData(), 1)
+		assert.Nil(t, err)
+		assert.Equal(t, fv, item.Fp16.ToFloat32Vector())
+
+		bf16 := reflect.ValueOf(item).Elem().FieldByName("Bf16")
+		err = SetFieldValue(&entity.Field{
+			DataType: entity.FieldTypeBFloat16Vector,
+		}, bf16, entity.NewColumnBFloat16VectorFromFloat32("", 2, [][]float32{fv}).FieldData(), 0)
+		assert.Nil(t, err)
+		assert.Equal(t, fv, entity.BFloat16Vector(item.Bf16).ToFloat32Vector())
+
+		sv, err := entity.NewSparseFloatVector([]uint32{1, 3}, []float32{0.1, 0.3})
+		assert.Nil(t, err)
+		sparse := reflect.ValueOf(item).Elem().FieldByName("Sparse")
+		err = SetFieldValue(&entity.Field{
+			DataType: entity.FieldTypeSparseFloatVector,
+		}, sparse, entity.NewColumnSparseFloatVector("", []entity.SparseFloatVector{{}, sv}).FieldData(), 1)
+		assert.Nil(t, err)
+		assert.Equal(t, sv, item.Sparse)
+	})
+
+	t.Run("nullable cases", func(t *testing.T) {
+		item := &TestStruct{Int64: 1}
+		i64 := reflect.ValueOf(item).Elem().FieldByName("Int64")
+		column, err := entity.NewNullableColumnInt64("", []int64{10, 0, 30}, []bool{true, false, true})
+		assert.Nil(t, err)
+		fd := column.FieldData()
+
+		// null value resets field
+		err = SetFieldValue(&entity.Field{DataType: entity.FieldTypeInt64}, i64, fd, 1)
+		assert.Nil(t, err)
+		assert.EqualValues(t, 0, item.Int64)
+
+		// compacted data index remapped
+		err = SetFieldValue(&entity.Field{DataType: entity.FieldTypeInt64}, i64, fd, 2)
+		assert.Nil(t, err)
+		assert.EqualValues(t, 30, item.Int64)
+
+		err = SetFieldValue(&entity.Field{DataType: entity.FieldTypeInt64}, i64, fd, 3)
+		assert.NotNil(t, err)
 	})
 
 	t.Run("fail cases", func(t *testing.T) {
@@ -495,6 +540,31 @@ func TestSetFieldValue(t *testing.T) {
 		str := reflect.ValueOf(item).Elem().FieldByName("String")
 		vf := reflect.ValueOf(item).Elem().FieldByName("Arr")
 		//vb := reflect.ValueOf(item).Elem().FieldByName("ArrBin")
+		names := reflect.ValueOf(item).Elem().FieldByName("Names")
+
+		// sparse vector
+		sparseData := entity.NewColumnSparseFloatVector("", []entity.SparseFloatVector{{}}).FieldData()
+		err = SetFieldValue(&entity.Field{DataType: entity.FieldTypeSparseFloatVector}, vf, sparseData, 0)
+		assert.Equal(t, err, ErrFieldTypeNotMatch)
+		sparse := reflect.ValueOf(item).Elem().FieldByName("Sparse")
+		err = SetFieldValue(&entity.Field{DataType: entity.FieldTypeSparseFloatVector}, sparse, sparseData, 1)
+		assert.Equal(t, err, ErrFieldTypeNotMatch)
+
+		// half vectors
+		fp16Data := entity.NewColumnFloat16Vector("", 8, [][]byte{make([]byte, 16)}).FieldData()
+		err = SetFieldValue(&entity.Field{DataType: entity.FieldTypeFloat16Vector}, vf, fp16Data, 0)
+		assert.Equal(t, err, ErrFieldTypeNotMatch)
+		err = SetFieldValue(&entity.Field{DataType: entity.FieldTypeBFloat16Vector}, vf, fp16Data, 0)
+		assert.Equal(t, err, ErrFieldTypeNotMatch)
+
+		// array
+		arrayData := entity.NewColumnInt64Array("", [][]int64{{1}}).FieldData()
+		err = SetFieldValue(&entity.Field{DataType: entity.FieldTypeArray}, names, arrayData, 0)
+		assert.Equal(t, err, ErrFieldTypeNotMatch)
+		err = SetFieldValue(&entity.Field{DataType: entity.FieldTypeArray}, i64, arrayData, 0)
+		assert.Equal(t, err, ErrFieldTypeNotMatch)
+		err = SetFieldValue(&entity.Field{DataType: entity.FieldTypeArray}, names, emptyScalarFieldData(), 0)
+		assert.Equal(t, err, ErrFieldTypeNotMatch)
 
 		err = SetFieldValue(&entity.Field{
 			DataType: entity.FieldTypeNone,
@@ -0,0 +1,270 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	common "github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/milvus-io/milvus-sdk-go/v2/entity"
+)
+
+const instrumentationName = "github.com/milvus-io/milvus-sdk-go/v2/client"
+
+// Attribute keys set on request spans.
+const (
+	AttrMethod           = attribute.Key("milvus.method")
+	AttrCollection       = attribute.Key("milvus.collection")
+	AttrPartitionCount   = attribute.Key("milvus.partition_count")
+	AttrNq               = attribute.Key("milvus.nq")
+	AttrTopK             = attribute.Key("milvus.topk")
+	AttrRowCount         = attribute.Key("milvus.row_count")
+	AttrConsistencyLevel = attribute.Key("milvus.consistency_level")
+	AttrRetryAttempts    = attribute.Key("milvus.retry_attempts")
+	AttrErrorCode        = attribute.Key("milvus.error_code")
+	AttrGrpcCode         = attribute.Key("rpc.grpc.status_code")
+)
+
+// Metrics records the latency and failures of requests, set in Config to collect client side metrics.
+type Metrics interface {
+	// ObserveLatency records the latency of a request.
+	ObserveLatency(ctx context.Context, method, collection string, latency time.Duration)
+	// IncError counts a failed request, code is the grpc code or the milvus error code in response.
+	IncError(ctx context.Context, method, collection, code string)
+}
+
+// otelMetrics implements Metrics with OpenTelemetry instruments.
+type otelMetrics struct {
+	latency metric.Float64Histogram
+	errors  metric.Int64Counter
+}
+
+// NewOTelMetrics creates Metrics which records into "milvus.client.request.duration" histogram
+// and "milvus.client.request.errors" counter of meter provider.
+func NewOTelMetrics(provider metric.MeterProvider) (Metrics, error) {
+	meter := provider.Meter(instrumentationName)
+	latency, err := meter.Float64Histogram("milvus.client.request.duration",
+		metric.WithDescription("Latency of milvus client requests."),
+		metric.WithUnit("s"))
+	if err != nil {
+		return nil, err
+	}
+	errCounter, err := meter.Int64Counter("milvus.client.request.errors",
+		metric.WithDescription("Number of failed milvus client requests."))
+	if err != nil {
+		return nil, err
+	}
+	return &otelMetrics{latency: latency, errors: errCounter}, nil
+}
+
+func (m *otelMetrics) ObserveLatency(ctx context.Context, method, collection string, latency time.Duration) {
+	m.latency.Record(ctx, latency.Seconds(), metric.WithAttributes(AttrMethod.String(method), AttrCollection.String(collection)))
+}
+
+func (m *otelMetrics) IncError(ctx context.Context, method, collection, code string) {
+	m.errors.Add(ctx, 1, metric.WithAttributes(AttrMethod.String(method), AttrCollection.String(collection), AttrErrorCode.String(code)))
+}
+
+type methodSpanCtxKey struct{}
+
+// startSpan starts the span of client method, spans of the requests sent by the method are its children.
+// A non-recording span is returned if no TracerProvider is configured.
+// The returned context also carries the database of c and records the requests sent for the errors of response status.
+func (c *GrpcClient) startSpan(ctx context.Context, method string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	ctx = withLastRPC(c.withDatabase(ctx))
+	if c.config == nil || c.config.TracerProvider == nil {
+		return ctx, trace.SpanFromContext(context.Background())
+	}
+	attrs = append([]attribute.KeyValue{AttrMethod.String(method)}, attrs...)
+	ctx, span := c.config.TracerProvider.Tracer(instrumentationName).Start(ctx, "milvus.client."+method, trace.WithAttributes(attrs...))
+	return context.WithValue(ctx, methodSpanCtxKey{}, span), span
+}
+
+// endSpan records the error returned by client method and ends the span.
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		var serverErr *ServerError
+		if errors.As(err, &serverErr) {
+			if serverErr.GrpcCode != codes.OK {
+				span.SetAttributes(AttrGrpcCode.Int(int(serverErr.GrpcCode)))
+			} else {
+				span.SetAttributes(AttrErrorCode.String(serverErr.ErrorCode.String()))
+			}
+		}
+		span.RecordError(err)
+		span.SetStatus(otelcodes.Error, err.Error())
+	}
+	span.End()
+}
+
+// setSpanAttributes sets attributes known only after client method starts on the method span,
+// such as row count of built columns, nothing is done if the span is not started by client.
+func setSpanAttributes(ctx context.Context, attrs ...attribute.KeyValue) {
+	if span, ok := ctx.Value(methodSpanCtxKey{}).(trace.Span); ok {
+		span.SetAttributes(attrs...)
+	}
+}
+
+func setConsistencyLevel(ctx context.Context, cl entity.ConsistencyLevel) {
+	setSpanAttributes(ctx, AttrConsistencyLevel.String(cl.CommonConsistencyLevel().String()))
+}
+
+// partitionCount returns the partition count attribute, empty partition names are not counted.
+func partitionCount(names ...string) attribute.KeyValue {
+	count := 0
+	for _, name := range names {
+		if name != "" {
+			count++
+		}
+	}
+	return AttrPartitionCount.Int(count)
+}
+
+// requestAttributes extracts span attributes from request.
+func requestAttributes(req interface{}) []attribute.KeyValue {
+	var attrs []attribute.KeyValue
+	if collection := collectionOf(req); collection != "" {
+		attrs = append(attrs, AttrCollection.String(collection))
+	}
+	switch r := req.(type) {
+	case interface{ GetPartitionNames() []string }:
+		attrs = append(attrs, AttrPartitionCount.Int(len(r.GetPartitionNames())))
+	case interface{ GetPartitionName() string }:
+		if r.GetPartitionName() != "" {
+			attrs = append(attrs, AttrPartitionCount.Int(1))
+		}
+	}
+	if r, ok := req.(interface{ GetNq() int64 }); ok {
+		attrs = append(attrs, AttrNq.Int64(r.GetNq()))
+	}
+	if r, ok := req.(interface{ GetSearchParams() []*common.KeyValuePair }); ok {
+		for _, kv := range r.GetSearchParams() {
+			if kv.GetKey() == "topk" {
+				if topK, err := strconv.ParseInt(kv.GetValue(), 10, 64); err == nil {
+					attrs = append(attrs, AttrTopK.Int64(topK))
+				}
+			}
+		}
+	}
+	if r, ok := req.(interface{ GetNumRows() uint32 }); ok {
+		attrs = append(attrs, AttrRowCount.Int64(int64(r.GetNumRows())))
+	}
+	return attrs
+}
+
+// recordRetryAttempt records the retry attempts on the request span.
+func recordRetryAttempt(ctx context.Context, attempt uint) {
+	if attempt > 0 {
+		trace.SpanFromContext(ctx).SetAttributes(AttrRetryAttempts.Int64(int64(attempt)))
+	}
+}
+
+// metadataCarrier injects trace context into grpc outgoing metadata.
+type metadataCarrier struct {
+	md metadata.MD
+}
+
+var _ propagation.TextMapCarrier = metadataCarrier{}
+
+func (c metadataCarrier) Get(key string) string {
+	values := c.md.Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+func (c metadataCarrier) Set(key, value string) {
+	c.md.Set(key, value)
+}
+
+func (c metadataCarrier) Keys() []string {
+	keys := make([]string, 0, len(c.md))
+	for key := range c.md {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// telemetryInterceptor creates span for each request, as child of the client method span, and records metrics,
+// the W3C trace context is propagated to server through grpc metadata.
+func telemetryInterceptor(tracerProvider trace.TracerProvider, metrics Metrics) grpc.UnaryClientInterceptor {
+	var tracer trace.Tracer
+	if tracerProvider != nil {
+		tracer = tracerProvider.Tracer(instrumentationName)
+	}
+	propagator := propagation.TraceContext{}
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		name := methodName(method)
+		collection := collectionOf(req)
+
+		var span trace.Span
+		if tracer != nil {
+			attrs := append([]attribute.KeyValue{AttrMethod.String(name)}, requestAttributes(req)...)
+			ctx, span = tracer.Start(ctx, "milvus."+name, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attrs...))
+			defer span.End()
+
+			md := metadata.MD{}
+			propagator.Inject(ctx, metadataCarrier{md: md})
+			for key, values := range md {
+				for _, value := range values {
+					ctx = metadata.AppendToOutgoingContext(ctx, key, value)
+				}
+			}
+		}
+
+		start := time.Now()
+		err := invoker(ctx, method, req, reply, cc, opts...)
+
+		code := ""
+		if err != nil {
+			code = status.Code(err).String()
+		} else if errorCode := getResultStatus(reply).GetErrorCode(); errorCode != common.ErrorCode_Success {
+			code = errorCode.String()
+		}
+		if metrics != nil {
+			metrics.ObserveLatency(ctx, name, collection, time.Since(start))
+			if code != "" {
+				metrics.IncError(ctx, name, collection, code)
+			}
+		}
+		if span != nil {
+			span.SetAttributes(AttrGrpcCode.Int(int(status.Code(err))))
+			if err != nil {
+				span.RecordError(err)
+				span.SetStatus(otelcodes.Error, err.Error())
+			} else if code != "" {
+				span.SetAttributes(AttrErrorCode.String(code))
+				span.SetStatus(otelcodes.Error, getResultStatus(reply).GetReason())
+			}
+		}
+		return err
+	}
+}
@@ -0,0 +1,245 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	common "github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	server "github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/milvus-io/milvus-sdk-go/v2/entity"
+	"github.com/milvus-io/milvus-sdk-go/v2/mocks"
+)
+
+func spanAttr(span sdktrace.ReadOnlySpan, key attribute.Key) (attribute.Value, bool) {
+	for _, kv := range span.Attributes() {
+		if kv.Key == key {
+			return kv.Value, true
+		}
+	}
+	return attribute.Value{}, false
+}
+
+func TestTelemetry(t *testing.T) {
+	lis := bufconn.Listen(bufSize)
+	svr := grpc.NewServer()
+	m := &mocks.MilvusServiceServer{}
+	m.EXPECT().Connect(mock.Anything, mock.Anything).
+		Return(&server.ConnectResponse{Status: &common.Status{}, Identifier: 1}, nil).Maybe()
+	var traceparents []string
+	m.EXPECT().HasCollection(mock.Anything, mock.Anything).
+		Run(func(ctx context.Context, _ *server.HasCollectionRequest) {
+			md, _ := metadata.FromIncomingContext(ctx)
+			traceparents = append(traceparents, md.Get("traceparent")...)
+		}).
+		Return(&server.BoolResponse{Status: &common.Status{ErrorCode: common.ErrorCode_RateLimit}}, nil).Once()
+	m.EXPECT().HasCollection(mock.Anything, mock.Anything).
+		Return(&server.BoolResponse{Status: &common.Status{}, Value: true}, nil).Twice()
+	m.EXPECT().DropCollection(mock.Anything, mock.Anything).
+		Return(&common.Status{ErrorCode: common.ErrorCode_UnexpectedError, Reason: "mocked failure"}, nil).Once()
+	server.RegisterMilvusServiceServer(svr, m)
+	go svr.Serve(lis)
+	defer svr.Stop()
+
+	recorder := tracetest.NewSpanRecorder()
+	tracerProvider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	reader := sdkmetric.NewManualReader()
+	metrics, err := NewOTelMetrics(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)))
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	c, err := NewClient(ctx, Config{
+		Address:        "bufnet",
+		TracerProvider: tracerProvider,
+		Metrics:        metrics,
+		RetryPolicy: &RetryPolicy{Read: RetryRule{
+			MaxAttempts:         2,
+			InitialBackoff:      time.Millisecond,
+			RetryableErrorCodes: []common.ErrorCode{common.ErrorCode_RateLimit},
+		}},
+		DialOptions: []grpc.DialOption{
+			grpc.WithBlock(),
+			grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+				return lis.Dial()
+			}),
+		},
+	})
+	require.NoError(t, err)
+	defer c.Close()
+	// skip spans of requests made by NewClient
+	connectSpans := len(recorder.Ended())
+
+	has, err := c.HasCollection(ctx, "coll")
+	require.NoError(t, err)
+	assert.True(t, has)
+	err = c.DropCollection(ctx, "coll")
+	require.Error(t, err)
+
+	// spans in the order they end, request spans end before their client method span
+	spans := recorder.Ended()[connectSpans:]
+	names := make([]string, 0, len(spans))
+	for _, span := range spans {
+		names = append(names, span.Name())
+	}
+	require.Equal(t, []string{
+		"milvus.HasCollection", "milvus.client.HasCollection",
+		"milvus.HasCollection", "milvus.client.HasCollection", "milvus.DropCollection", "milvus.client.DropCollection",
+	}, names)
+
+	t.Run("span", func(t *testing.T) {
+		span, methodSpan := spans[0], spans[1]
+		value, ok := spanAttr(span, AttrCollection)
+		assert.True(t, ok)
+		assert.Equal(t, "coll", value.AsString())
+		value, ok = spanAttr(span, AttrRetryAttempts)
+		assert.True(t, ok)
+		assert.EqualValues(t, 1, value.AsInt64())
+
+		// request span is child of client method span
+		assert.Equal(t, methodSpan.SpanContext().SpanID(), span.Parent().SpanID())
+		value, ok = spanAttr(methodSpan, AttrCollection)
+		assert.True(t, ok)
+		assert.Equal(t, "coll", value.AsString())
+
+		// trace context propagated
+		require.Len(t, traceparents, 1)
+		assert.Contains(t, traceparents[0], span.SpanContext().TraceID().String())
+
+		span, methodSpan = spans[4], spans[5]
+		value, ok = spanAttr(span, AttrErrorCode)
+		assert.True(t, ok)
+		assert.Equal(t, common.ErrorCode_UnexpectedError.String(), value.AsString())
+		assert.Equal(t, "mocked failure", span.Status().Description)
+
+		// client methods called by DropCollection are nested in its span
+		assert.Equal(t, spans[3].SpanContext().SpanID(), spans[2].Parent().SpanID())
+		assert.Equal(t, methodSpan.SpanContext().SpanID(), spans[3].Parent().SpanID())
+		assert.Equal(t, methodSpan.SpanContext().SpanID(), span.Parent().SpanID())
+		value, ok = spanAttr(methodSpan, AttrErrorCode)
+		assert.True(t, ok)
+		assert.Equal(t, common.ErrorCode_UnexpectedError.String(), value.AsString())
+		assert.Equal(t, otelcodes.Error, methodSpan.Status().Code)
+	})
+
+	t.Run("metrics", func(t *testing.T) {
+		var rm metricdata.ResourceMetrics
+		require.NoError(t, reader.Collect(ctx, &rm))
+		require.Len(t, rm.ScopeMetrics, 1)
+
+		latency := map[string]uint64{}
+		errorCount := map[string]int64{}
+		for _, metric := range rm.ScopeMetrics[0].Metrics {
+			switch data := metric.Data.(type) {
+			case metricdata.Histogram[float64]:
+				for _, point := range data.DataPoints {
+					method, _ := point.Attributes.Value(AttrMethod)
+					latency[method.AsString()] += point.Count
+				}
+			case metricdata.Sum[int64]:
+				for _, point := range data.DataPoints {
+					code, _ := point.Attributes.Value(AttrErrorCode)
+					errorCount[code.AsString()] += point.Value
+				}
+			}
+		}
+		assert.EqualValues(t, 2, latency["HasCollection"])
+		assert.EqualValues(t, 1, latency["DropCollection"])
+		assert.EqualValues(t, 1, errorCount[common.ErrorCode_UnexpectedError.String()])
+	})
+}
+
+func TestRequestAttributes(t *testing.T) {
+	attrs := attribute.NewSet(requestAttributes(&server.SearchRequest{
+		CollectionName: "coll",
+		PartitionNames: []string{"p1", "p2"},
+		Nq:             3,
+		SearchParams:   []*common.KeyValuePair{{Key: "topk", Value: "10"}},
+	})...)
+	for key, expected := range map[attribute.Key]attribute.Value{
+		AttrCollection:     attribute.StringValue("coll"),
+		AttrPartitionCount: attribute.IntValue(2),
+		AttrNq:             attribute.Int64Value(3),
+		AttrTopK:           attribute.Int64Value(10),
+	} {
+		value, ok := attrs.Value(key)
+		assert.True(t, ok, key)
+		assert.Equal(t, expected, value, key)
+	}
+
+	attrs = attribute.NewSet(requestAttributes(&server.InsertRequest{CollectionName: "coll", PartitionName: "p1", NumRows: 100})...)
+	value, _ := attrs.Value(AttrRowCount)
+	assert.EqualValues(t, 100, value.AsInt64())
+	value, _ = attrs.Value(AttrPartitionCount)
+	assert.EqualValues(t, 1, value.AsInt64())
+
+}
+
+func TestMethodSpan(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	tracerProvider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	c := &GrpcClient{config: &Config{TracerProvider: tracerProvider}}
+
+	parentCtx, parent := tracerProvider.Tracer("test").Start(context.Background(), "parent")
+	ctx, span := c.startSpan(parentCtx, "Query", AttrCollection.String("coll"))
+	setConsistencyLevel(ctx, entity.ClSession)
+	// attributes are not set on spans not started by client
+	setSpanAttributes(parentCtx, AttrRowCount.Int(1))
+	endSpan(span, &ServerError{ErrorCode: common.ErrorCode_RateLimit, Reason: "rate limited"})
+	parent.End()
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+	assert.Equal(t, "milvus.client.Query", spans[0].Name())
+	assert.Equal(t, spans[1].SpanContext().SpanID(), spans[0].Parent().SpanID())
+	for key, expected := range map[attribute.Key]attribute.Value{
+		AttrMethod:           attribute.StringValue("Query"),
+		AttrCollection:       attribute.StringValue("coll"),
+		AttrConsistencyLevel: attribute.StringValue("Session"),
+		AttrErrorCode:        attribute.StringValue(common.ErrorCode_RateLimit.String()),
+	} {
+		value, ok := spanAttr(spans[0], key)
+		assert.True(t, ok, key)
+		assert.Equal(t, expected, value, key)
+	}
+	assert.Equal(t, otelcodes.Error, spans[0].Status().Code)
+	_, ok := spanAttr(spans[1], AttrRowCount)
+	assert.False(t, ok)
+
+	// non-recording span without tracer provider
+	c = &GrpcClient{}
+	ctx, span = c.startSpan(parentCtx, "Query")
+	assert.False(t, span.IsRecording())
+	assert.Equal(t, parent.SpanContext(), trace.SpanContextFromContext(ctx))
+	endSpan(span, nil)
+}
@@ -0,0 +1,111 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TimeoutError is returned when a request exceeds its deadline,
+// either the one in caller context or the timeout configured in Config.
+type TimeoutError struct {
+	Method     string        // grpc method name, "LoadCollection" for example
+	Collection string        // collection name of the request, empty if not applicable
+	Timeout    time.Duration // timeout applied by client, zero if deadline comes from caller context
+	err        error
+}
+
+// Error implements error.
+func (e *TimeoutError) Error() string {
+	msg := e.Method
+	if e.Collection != "" {
+		msg = fmt.Sprintf("%s on collection %s", msg, e.Collection)
+	}
+	if e.Timeout > 0 {
+		msg = fmt.Sprintf("%s timeout after %s", msg, e.Timeout)
+	} else {
+		msg = fmt.Sprintf("%s deadline exceeded", msg)
+	}
+	return fmt.Sprintf("%s: %v", msg, e.err)
+}
+
+// Unwrap returns the underlying grpc error.
+func (e *TimeoutError) Unwrap() error {
+	return e.err
+}
+
+// Is makes errors.Is(err, context.DeadlineExceeded) true for timeout error.
+func (e *TimeoutError) Is(target error) bool {
+	return target == context.DeadlineExceeded
+}
+
+// GRPCStatus keeps status.Code(err) returning codes.DeadlineExceeded.
+func (e *TimeoutError) GRPCStatus() *status.Status {
+	return status.Convert(e.err)
+}
+
+// timeoutOf returns the timeout of grpc method, per-method timeout takes precedence over RequestTimeout.
+func (c *Config) timeoutOf(method string) time.Duration {
+	if timeout, ok := c.MethodTimeouts[method]; ok {
+		return timeout
+	}
+	return c.RequestTimeout
+}
+
+// collectionOf returns the collection name of request if any.
+func collectionOf(req interface{}) string {
+	switch r := req.(type) {
+	case interface{ GetCollectionName() string }:
+		return r.GetCollectionName()
+	case interface{ GetCollectionNames() []string }:
+		if names := r.GetCollectionNames(); len(names) > 0 {
+			return names[0]
+		}
+	case interface{ GetOldName() string }:
+		return r.GetOldName()
+	}
+	return ""
+}
+
+// timeoutInterceptor applies the configured timeout to requests without deadline,
+// and wraps the deadline exceeded error with method and collection name.
+func timeoutInterceptor(cfg *Config) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		name := methodName(method)
+		var timeout time.Duration
+		if _, ok := ctx.Deadline(); !ok {
+			timeout = cfg.timeoutOf(name)
+		}
+		if timeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, timeout)
+			defer cancel()
+		}
+
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		if status.Code(err) == codes.DeadlineExceeded {
+			return &TimeoutError{Method: name, Collection: collectionOf(req), Timeout: timeout, err: err}
+		}
+		return err
+	}
+}
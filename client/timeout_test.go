@@ -0,0 +1,128 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	common "github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	server "github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// stalledServer holds LoadCollection and Flush requests for delay.
+type stalledServer struct {
+	server.UnimplementedMilvusServiceServer
+	delay time.Duration
+}
+
+func (s *stalledServer) Connect(context.Context, *server.ConnectRequest) (*server.ConnectResponse, error) {
+	return &server.ConnectResponse{Status: &common.Status{}, Identifier: 1}, nil
+}
+
+func (s *stalledServer) wait(ctx context.Context) error {
+	select {
+	case <-time.After(s.delay):
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (s *stalledServer) LoadCollection(ctx context.Context, _ *server.LoadCollectionRequest) (*common.Status, error) {
+	return &common.Status{}, s.wait(ctx)
+}
+
+func (s *stalledServer) Flush(ctx context.Context, req *server.FlushRequest) (*server.FlushResponse, error) {
+	return &server.FlushResponse{Status: &common.Status{}}, s.wait(ctx)
+}
+
+func TestRequestTimeout(t *testing.T) {
+	lis := bufconn.Listen(bufSize)
+	svr := grpc.NewServer()
+	server.RegisterMilvusServiceServer(svr, &stalledServer{delay: 200 * time.Millisecond})
+	go svr.Serve(lis)
+	defer svr.Stop()
+
+	ctx := context.Background()
+	c, err := NewClient(ctx, Config{
+		Address:        "bufnet",
+		RequestTimeout: 50 * time.Millisecond,
+		MethodTimeouts: map[string]time.Duration{"Flush": time.Second},
+		DialOptions: []grpc.DialOption{
+			grpc.WithBlock(),
+			grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+				return lis.Dial()
+			}),
+		},
+	})
+	require.NoError(t, err)
+	defer c.Close()
+	gc := c.(*GrpcClient)
+
+	// default timeout applied
+	start := time.Now()
+	_, err = gc.Service.LoadCollection(ctx, &server.LoadCollectionRequest{CollectionName: "coll"})
+	assert.Less(t, time.Since(start), 200*time.Millisecond)
+	var timeoutErr *TimeoutError
+	require.True(t, errors.As(err, &timeoutErr))
+	assert.Equal(t, "LoadCollection", timeoutErr.Method)
+	assert.Equal(t, "coll", timeoutErr.Collection)
+	assert.Equal(t, 50*time.Millisecond, timeoutErr.Timeout)
+	assert.Contains(t, err.Error(), "LoadCollection on collection coll timeout after 50ms")
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+	assert.Equal(t, codes.DeadlineExceeded, status.Code(err))
+
+	// per-method timeout takes precedence
+	_, err = gc.Service.Flush(ctx, &server.FlushRequest{CollectionNames: []string{"coll"}})
+	assert.NoError(t, err)
+
+	// deadline in caller context is respected
+	callerCtx, cancel := context.WithTimeout(ctx, time.Second)
+	defer cancel()
+	_, err = gc.Service.LoadCollection(callerCtx, &server.LoadCollectionRequest{CollectionName: "coll"})
+	assert.NoError(t, err)
+
+	callerCtx, cancel = context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	_, err = gc.Service.Flush(callerCtx, &server.FlushRequest{CollectionNames: []string{"coll"}})
+	require.True(t, errors.As(err, &timeoutErr))
+	assert.Equal(t, "Flush", timeoutErr.Method)
+	assert.Equal(t, "coll", timeoutErr.Collection)
+	assert.Zero(t, timeoutErr.Timeout)
+}
+
+func TestConfigTimeoutOf(t *testing.T) {
+	c := &Config{RequestTimeout: time.Second, MethodTimeouts: map[string]time.Duration{"LoadCollection": time.Minute, "Search": 0}}
+	assert.Equal(t, time.Second, c.timeoutOf("Insert"))
+	assert.Equal(t, time.Minute, c.timeoutOf("LoadCollection"))
+	assert.Zero(t, c.timeoutOf("Search"))
+
+	copied := c.Copy()
+	copied.MethodTimeouts["LoadCollection"] = time.Hour
+	assert.Equal(t, time.Minute, c.timeoutOf("LoadCollection"))
+	assert.Equal(t, time.Second, copied.RequestTimeout)
+}
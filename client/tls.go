@@ -0,0 +1,224 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"google.golang.org/grpc/credentials"
+)
+
+// TLSConfig is the transport security config for milvus client.
+// PEM bytes take precedence over the file paths, certificate files are
+// reloaded automatically for new connections once they are rotated.
+type TLSConfig struct {
+	CACertFile string // Path of PEM encoded CA certificates to verify server, system roots are used if not provided.
+	CACertPEM  []byte // PEM encoded CA certificates.
+	CertFile   string // Path of PEM encoded client certificate for mutual TLS.
+	CertPEM    []byte // PEM encoded client certificate.
+	KeyFile    string // Path of PEM encoded client private key for mutual TLS.
+	KeyPEM     []byte // PEM encoded client private key.
+
+	ServerName         string // Override the server name used to verify server certificate.
+	InsecureSkipVerify bool   // Skip server certificate verification, for development only.
+	MinVersion         uint16 // Minimal TLS version, tls.VersionTLS12 by default.
+}
+
+// files returns the certificate files used by the config.
+func (t *TLSConfig) files() []string {
+	var files []string
+	if len(t.CACertPEM) == 0 && t.CACertFile != "" {
+		files = append(files, t.CACertFile)
+	}
+	if len(t.CertPEM) == 0 && t.CertFile != "" {
+		files = append(files, t.CertFile)
+	}
+	if len(t.KeyPEM) == 0 && t.KeyFile != "" {
+		files = append(files, t.KeyFile)
+	}
+	return files
+}
+
+// build reads all the certificates and constructs the tls.Config.
+func (t *TLSConfig) build() (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		ServerName:         t.ServerName,
+		InsecureSkipVerify: t.InsecureSkipVerify, //nolint:gosec
+		MinVersion:         t.MinVersion,
+	}
+	if tlsConfig.MinVersion == 0 {
+		tlsConfig.MinVersion = tls.VersionTLS12
+	}
+
+	caPEM, err := pemOrFile(t.CACertPEM, t.CACertFile)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read CA certificate")
+	}
+	if len(caPEM) > 0 {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caPEM) {
+			return nil, errors.New("no valid CA certificate found")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	certPEM, err := pemOrFile(t.CertPEM, t.CertFile)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read client certificate")
+	}
+	keyPEM, err := pemOrFile(t.KeyPEM, t.KeyFile)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read client key")
+	}
+	if len(certPEM) > 0 || len(keyPEM) > 0 {
+		cert, err := tls.X509KeyPair(certPEM, keyPEM)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to load client key pair")
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	return tlsConfig, nil
+}
+
+func pemOrFile(pem []byte, file string) ([]byte, error) {
+	if len(pem) > 0 || file == "" {
+		return pem, nil
+	}
+	return os.ReadFile(file)
+}
+
+// tlsLoader caches the tls.Config built from TLSConfig and rebuilds it once the certificate files are modified.
+type tlsLoader struct {
+	cfg TLSConfig
+
+	mu        sync.Mutex
+	modTimes  map[string]time.Time
+	tlsConfig *tls.Config
+}
+
+func newTLSLoader(cfg TLSConfig) (*tlsLoader, error) {
+	l := &tlsLoader{cfg: cfg}
+	if _, err := l.load(); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+// load returns the latest tls.Config.
+// The cached one is returned if files are not modified or failed to reload, since files may be in the middle of rotation.
+func (l *tlsLoader) load() (*tls.Config, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	modTimes := make(map[string]time.Time)
+	for _, file := range l.cfg.files() {
+		info, err := os.Stat(file)
+		if err != nil {
+			if l.tlsConfig != nil {
+				return l.tlsConfig, nil
+			}
+			return nil, errors.Wrapf(err, "failed to stat %s", file)
+		}
+		modTimes[file] = info.ModTime()
+	}
+	if l.tlsConfig != nil && !l.modified(modTimes) {
+		return l.tlsConfig, nil
+	}
+
+	tlsConfig, err := l.cfg.build()
+	if err != nil {
+		if l.tlsConfig != nil {
+			return l.tlsConfig, nil
+		}
+		return nil, err
+	}
+	l.tlsConfig, l.modTimes = tlsConfig, modTimes
+	return tlsConfig, nil
+}
+
+func (l *tlsLoader) modified(modTimes map[string]time.Time) bool {
+	for file, modTime := range modTimes {
+		if !modTime.Equal(l.modTimes[file]) {
+			return true
+		}
+	}
+	return false
+}
+
+// reloadingCredentials performs the TLS handshake with the latest certificates loaded.
+type reloadingCredentials struct {
+	loader     *tlsLoader
+	serverName string
+}
+
+var _ credentials.TransportCredentials = (*reloadingCredentials)(nil)
+
+func newTLSCredentials(cfg TLSConfig) (credentials.TransportCredentials, error) {
+	loader, err := newTLSLoader(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &reloadingCredentials{loader: loader, serverName: cfg.ServerName}, nil
+}
+
+func (c *reloadingCredentials) current() (credentials.TransportCredentials, error) {
+	tlsConfig, err := c.loader.load()
+	if err != nil {
+		return nil, err
+	}
+	tlsConfig = tlsConfig.Clone()
+	if c.serverName != "" {
+		tlsConfig.ServerName = c.serverName
+	}
+	return credentials.NewTLS(tlsConfig), nil
+}
+
+func (c *reloadingCredentials) ClientHandshake(ctx context.Context, authority string, rawConn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	creds, err := c.current()
+	if err != nil {
+		return nil, nil, err
+	}
+	return creds.ClientHandshake(ctx, authority, rawConn)
+}
+
+func (c *reloadingCredentials) ServerHandshake(net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	return nil, nil, errors.New("server handshake is not supported by milvus client credentials")
+}
+
+func (c *reloadingCredentials) Info() credentials.ProtocolInfo {
+	return credentials.ProtocolInfo{
+		SecurityProtocol: "tls",
+		SecurityVersion:  "1.2",
+		ServerName:       c.serverName,
+	}
+}
+
+func (c *reloadingCredentials) Clone() credentials.TransportCredentials {
+	return &reloadingCredentials{loader: c.loader, serverName: c.serverName}
+}
+
+func (c *reloadingCredentials) OverrideServerName(serverName string) error {
+	c.serverName = serverName
+	return nil
+}
@@ -0,0 +1,240 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	common "github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	server "github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-sdk-go/v2/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+type testCert struct {
+	cert    *x509.Certificate
+	key     *ecdsa.PrivateKey
+	certPEM []byte
+	keyPEM  []byte
+}
+
+// genCert generates a certificate signed by parent, self-signed if parent is nil.
+func genCert(t *testing.T, cn string, parent *testCert) *testCert {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	serial, err := rand.Int(rand.Reader, big.NewInt(1<<62))
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: cn},
+		DNSNames:     []string{cn},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	signer, signerKey := tmpl, key
+	if parent == nil {
+		tmpl.IsCA = true
+		tmpl.BasicConstraintsValid = true
+	} else {
+		signer, signerKey = parent.cert, parent.key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, signer, &key.PublicKey, signerKey)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	return &testCert{
+		cert:    cert,
+		key:     key,
+		certPEM: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		keyPEM:  pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}),
+	}
+}
+
+func TestTLSConfigMutualAuth(t *testing.T) {
+	ca := genCert(t, "milvus-ca", nil)
+	serverCert := genCert(t, "localhost", ca)
+	clientCert := genCert(t, "client", ca)
+
+	pool := x509.NewCertPool()
+	pool.AddCert(ca.cert)
+	keyPair, err := tls.X509KeyPair(serverCert.certPEM, serverCert.keyPEM)
+	require.NoError(t, err)
+
+	lis := bufconn.Listen(bufSize)
+	svr := grpc.NewServer(grpc.Creds(credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{keyPair},
+		ClientCAs:    pool,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		MinVersion:   tls.VersionTLS12,
+	})))
+	m := &mocks.MilvusServiceServer{}
+	m.EXPECT().Connect(mock.Anything, mock.Anything).
+		Return(&server.ConnectResponse{Status: &common.Status{}, Identifier: 1}, nil).Maybe()
+	server.RegisterMilvusServiceServer(svr, m)
+	go svr.Serve(lis)
+	defer svr.Stop()
+
+	newClient := func(tlsConfig *TLSConfig) (Client, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+		defer cancel()
+		return NewClient(ctx, Config{
+			Address: "localhost:19530",
+			TLS:     tlsConfig,
+			DialOptions: []grpc.DialOption{
+				grpc.WithBlock(),
+				grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+					return lis.Dial()
+				}),
+			},
+		})
+	}
+
+	t.Run("mutual_tls", func(t *testing.T) {
+		c, err := newClient(&TLSConfig{
+			CACertPEM: ca.certPEM,
+			CertPEM:   clientCert.certPEM,
+			KeyPEM:    clientCert.keyPEM,
+		})
+		require.NoError(t, err)
+		c.Close()
+	})
+
+	t.Run("files", func(t *testing.T) {
+		dir := t.TempDir()
+		writeFile(t, filepath.Join(dir, "ca.pem"), ca.certPEM)
+		writeFile(t, filepath.Join(dir, "client.pem"), clientCert.certPEM)
+		writeFile(t, filepath.Join(dir, "client.key"), clientCert.keyPEM)
+		c, err := newClient(&TLSConfig{
+			CACertFile: filepath.Join(dir, "ca.pem"),
+			CertFile:   filepath.Join(dir, "client.pem"),
+			KeyFile:    filepath.Join(dir, "client.key"),
+			ServerName: "localhost",
+			MinVersion: tls.VersionTLS13,
+		})
+		require.NoError(t, err)
+		c.Close()
+	})
+
+	t.Run("server_name_mismatch", func(t *testing.T) {
+		_, err := newClient(&TLSConfig{
+			CACertPEM:  ca.certPEM,
+			CertPEM:    clientCert.certPEM,
+			KeyPEM:     clientCert.keyPEM,
+			ServerName: "milvus.example.com",
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("insecure_skip_verify", func(t *testing.T) {
+		c, err := newClient(&TLSConfig{
+			CertPEM:            clientCert.certPEM,
+			KeyPEM:             clientCert.keyPEM,
+			ServerName:         "milvus.example.com",
+			InsecureSkipVerify: true,
+		})
+		require.NoError(t, err)
+		c.Close()
+	})
+
+	t.Run("no_client_cert", func(t *testing.T) {
+		_, err := newClient(&TLSConfig{
+			CACertPEM: ca.certPEM,
+		})
+		assert.Error(t, err)
+	})
+}
+
+func TestTLSConfigInvalid(t *testing.T) {
+	ca := genCert(t, "milvus-ca", nil)
+
+	c := &Config{Address: "localhost:19530", TLS: &TLSConfig{CACertPEM: []byte("invalid")}}
+	assert.Error(t, c.parse())
+
+	c = &Config{Address: "localhost:19530", TLS: &TLSConfig{CACertFile: filepath.Join(t.TempDir(), "not_exist.pem")}}
+	assert.Error(t, c.parse())
+
+	c = &Config{Address: "localhost:19530", TLS: &TLSConfig{CertPEM: ca.certPEM}}
+	assert.Error(t, c.parse())
+
+	c = &Config{Address: "localhost:19530", TLS: &TLSConfig{CACertPEM: ca.certPEM}}
+	assert.NoError(t, c.parse())
+	assert.True(t, c.EnableTLSAuth)
+}
+
+func TestTLSLoaderReload(t *testing.T) {
+	ca := genCert(t, "milvus-ca", nil)
+	cert1 := genCert(t, "client", ca)
+	cert2 := genCert(t, "client", ca)
+
+	dir := t.TempDir()
+	certFile, keyFile := filepath.Join(dir, "client.pem"), filepath.Join(dir, "client.key")
+	writeFile(t, certFile, cert1.certPEM)
+	writeFile(t, keyFile, cert1.keyPEM)
+
+	loader, err := newTLSLoader(TLSConfig{CertFile: certFile, KeyFile: keyFile})
+	require.NoError(t, err)
+	tlsConfig, err := loader.load()
+	require.NoError(t, err)
+	assert.Equal(t, cert1.cert.Raw, tlsConfig.Certificates[0].Certificate[0])
+
+	// not modified, cached config returned
+	cached, err := loader.load()
+	require.NoError(t, err)
+	assert.Same(t, tlsConfig, cached)
+
+	// rotated
+	writeFile(t, certFile, cert2.certPEM)
+	writeFile(t, keyFile, cert2.keyPEM)
+	future := time.Now().Add(time.Minute)
+	require.NoError(t, os.Chtimes(certFile, future, future))
+	require.NoError(t, os.Chtimes(keyFile, future, future))
+	tlsConfig, err = loader.load()
+	require.NoError(t, err)
+	assert.Equal(t, cert2.cert.Raw, tlsConfig.Certificates[0].Certificate[0])
+
+	// in the middle of rotation, last valid config is kept
+	require.NoError(t, os.Remove(keyFile))
+	kept, err := loader.load()
+	require.NoError(t, err)
+	assert.Same(t, tlsConfig, kept)
+}
+
+func writeFile(t *testing.T, path string, data []byte) {
+	require.NoError(t, os.WriteFile(path, data, 0o600))
+}
@@ -0,0 +1,596 @@
+package entity
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/apache/arrow/go/v12/arrow"
+	"github.com/apache/arrow/go/v12/arrow/array"
+	"github.com/apache/arrow/go/v12/arrow/bitutil"
+	"github.com/apache/arrow/go/v12/arrow/memory"
+	"github.com/cockroachdb/errors"
+)
+
+// ColumnToArrow converts column into arrow array.
+// Vectors are converted into FixedSizeList, sparse vectors into Map of index to value,
+// array columns into List, and JSON & dynamic columns into String of json text.
+// Numeric scalar columns share memory with the returned array.
+func ColumnToArrow(mem memory.Allocator, column Column) (arrow.Array, error) {
+	switch c := column.(type) {
+	case *ColumnBool:
+		builder := array.NewBooleanBuilder(mem)
+		defer builder.Release()
+		builder.AppendValues(c.values, c.validData)
+		return builder.NewArray(), nil
+	case *ColumnInt8:
+		return primitiveArray(arrow.PrimitiveTypes.Int8, c.Len(), arrow.Int8Traits.CastToBytes(c.values), c.validData), nil
+	case *ColumnInt16:
+		return primitiveArray(arrow.PrimitiveTypes.Int16, c.Len(), arrow.Int16Traits.CastToBytes(c.values), c.validData), nil
+	case *ColumnInt32:
+		return primitiveArray(arrow.PrimitiveTypes.Int32, c.Len(), arrow.Int32Traits.CastToBytes(c.values), c.validData), nil
+	case *ColumnInt64:
+		return primitiveArray(arrow.PrimitiveTypes.Int64, c.Len(), arrow.Int64Traits.CastToBytes(c.values), c.validData), nil
+	case *ColumnFloat:
+		return primitiveArray(arrow.PrimitiveTypes.Float32, c.Len(), arrow.Float32Traits.CastToBytes(c.values), c.validData), nil
+	case *ColumnDouble:
+		return primitiveArray(arrow.PrimitiveTypes.Float64, c.Len(), arrow.Float64Traits.CastToBytes(c.values), c.validData), nil
+	case *ColumnString:
+		return ColumnToArrow(mem, &c.ColumnOf)
+	case *ColumnVarChar:
+		builder := array.NewStringBuilder(mem)
+		defer builder.Release()
+		builder.AppendValues(c.values, c.validData)
+		return builder.NewArray(), nil
+	case *ColumnJSONBytes:
+		builder := array.NewStringBuilder(mem)
+		defer builder.Release()
+		for _, v := range c.values {
+			builder.Append(string(v))
+		}
+		return builder.NewArray(), nil
+	case *ColumnDynamic:
+		// rows without the output field are null
+		builder := array.NewStringBuilder(mem)
+		defer builder.Release()
+		for i := 0; i < c.Len(); i++ {
+			raw, err := c.rawValue(i)
+			if err != nil {
+				builder.AppendNull()
+				continue
+			}
+			builder.Append(string(raw))
+		}
+		return builder.NewArray(), nil
+	case *ColumnFloatVector:
+		flat := make([]float32, 0, c.Len()*c.dim)
+		for _, vector := range c.values {
+			flat = append(flat, vector...)
+		}
+		return fixedSizeListArray(arrow.PrimitiveTypes.Float32, c.dim, c.Len(), arrow.Float32Traits.CastToBytes(flat)), nil
+	case *ColumnBinaryVector:
+		return fixedSizeListArray(arrow.PrimitiveTypes.Uint8, c.dim/8, c.Len(), flattenBytes(c.values)), nil
+	case *ColumnFloat16Vector:
+		return fixedSizeListArray(arrow.FixedWidthTypes.Float16, c.dim, c.Len(), flattenBytes(c.values)), nil
+	case *ColumnBFloat16Vector:
+		// arrow has no bfloat16 type, the raw bits are kept in uint16
+		return fixedSizeListArray(arrow.PrimitiveTypes.Uint16, c.dim, c.Len(), flattenBytes(c.values)), nil
+	case *ColumnSparseFloatVector:
+		builder := array.NewMapBuilder(mem, arrow.PrimitiveTypes.Uint32, arrow.PrimitiveTypes.Float32, true)
+		defer builder.Release()
+		keys := builder.KeyBuilder().(*array.Uint32Builder)
+		items := builder.ItemBuilder().(*array.Float32Builder)
+		for _, vector := range c.values {
+			builder.Append(true)
+			keys.AppendValues(vector.positions, nil)
+			items.AppendValues(vector.values, nil)
+		}
+		return builder.NewArray(), nil
+	case ArrayColumn:
+		return arrayColumnToArrow(mem, c)
+	default:
+		return nil, fmt.Errorf("column %s of type %s not supported to convert into arrow", column.Name(), column.Type().Name())
+	}
+}
+
+// primitiveArray creates fixed width arrow array sharing memory with data.
+func primitiveArray(dtype arrow.DataType, n int, data []byte, validData []bool) arrow.Array {
+	var validity *memory.Buffer
+	nulls := 0
+	if validData != nil {
+		bitmap := make([]byte, bitutil.BytesForBits(int64(n)))
+		for i, valid := range validData {
+			if valid {
+				bitutil.SetBit(bitmap, i)
+			} else {
+				nulls++
+			}
+		}
+		validity = memory.NewBufferBytes(bitmap)
+	}
+	arrData := array.NewData(dtype, n, []*memory.Buffer{validity, memory.NewBufferBytes(data)}, nil, nulls, 0)
+	defer arrData.Release()
+	return array.MakeFromData(arrData)
+}
+
+// fixedSizeListArray creates FixedSizeList array of n lists, whose values are fixed width elements in data.
+func fixedSizeListArray(elementType arrow.DataType, listSize int, n int, data []byte) arrow.Array {
+	values := array.NewData(elementType, listSize*n, []*memory.Buffer{nil, memory.NewBufferBytes(data)}, nil, 0, 0)
+	defer values.Release()
+	arrData := array.NewData(arrow.FixedSizeListOf(int32(listSize), elementType), n, []*memory.Buffer{nil}, []arrow.ArrayData{values}, 0, 0)
+	defer arrData.Release()
+	return array.MakeFromData(arrData)
+}
+
+func flattenBytes(values [][]byte) []byte {
+	size := 0
+	for _, v := range values {
+		size += len(v)
+	}
+	flat := make([]byte, 0, size)
+	for _, v := range values {
+		flat = append(flat, v...)
+	}
+	return flat
+}
+
+func arrayColumnToArrow(mem memory.Allocator, column ArrayColumn) (arrow.Array, error) {
+	elementType, err := scalarArrowType(column.ElementType())
+	if err != nil {
+		return nil, err
+	}
+	builder := array.NewListBuilder(mem, elementType)
+	defer builder.Release()
+	for i := 0; i < column.Len(); i++ {
+		v, err := column.Get(i)
+		if err != nil {
+			return nil, err
+		}
+		builder.Append(true)
+		switch values := builder.ValueBuilder().(type) {
+		case *array.BooleanBuilder:
+			values.AppendValues(v.([]bool), nil)
+		case *array.Int8Builder:
+			values.AppendValues(v.([]int8), nil)
+		case *array.Int16Builder:
+			values.AppendValues(v.([]int16), nil)
+		case *array.Int32Builder:
+			values.AppendValues(v.([]int32), nil)
+		case *array.Int64Builder:
+			values.AppendValues(v.([]int64), nil)
+		case *array.Float32Builder:
+			values.AppendValues(v.([]float32), nil)
+		case *array.Float64Builder:
+			values.AppendValues(v.([]float64), nil)
+		case *array.StringBuilder:
+			values.AppendValues(v.([]string), nil)
+		}
+	}
+	return builder.NewArray(), nil
+}
+
+// scalarArrowType returns the arrow type of scalar field type.
+func scalarArrowType(fieldType FieldType) (arrow.DataType, error) {
+	switch fieldType {
+	case FieldTypeBool:
+		return arrow.FixedWidthTypes.Boolean, nil
+	case FieldTypeInt8:
+		return arrow.PrimitiveTypes.Int8, nil
+	case FieldTypeInt16:
+		return arrow.PrimitiveTypes.Int16, nil
+	case FieldTypeInt32:
+		return arrow.PrimitiveTypes.Int32, nil
+	case FieldTypeInt64:
+		return arrow.PrimitiveTypes.Int64, nil
+	case FieldTypeFloat:
+		return arrow.PrimitiveTypes.Float32, nil
+	case FieldTypeDouble:
+		return arrow.PrimitiveTypes.Float64, nil
+	case FieldTypeString, FieldTypeVarChar:
+		return arrow.BinaryTypes.String, nil
+	default:
+		return nil, fmt.Errorf("field type %s has no arrow scalar type", fieldType.Name())
+	}
+}
+
+// ColumnsFromArrow converts arrow record into columns, record columns are matched with schema fields by name.
+// Record columns not in schema are converted into dynamic columns if schema enables dynamic field.
+// Numeric scalar and vector columns share memory with the record when memory layouts match,
+// so the record shall not be released before the columns are used.
+func ColumnsFromArrow(record arrow.Record, sch *Schema) ([]Column, error) {
+	if record == nil {
+		return nil, errors.New("record is nil")
+	}
+	if sch == nil {
+		return nil, errors.New("schema is nil")
+	}
+	fields := make(map[string]*Field)
+	for _, field := range sch.Fields {
+		fields[field.Name] = field
+	}
+
+	columns := make([]Column, 0, record.NumCols())
+	for i, arr := range record.Columns() {
+		name := record.ColumnName(i)
+		field, ok := fields[name]
+		if !ok {
+			if !sch.EnableDynamicField {
+				return nil, fmt.Errorf("field %s does not exist in schema", name)
+			}
+			field = &Field{Name: name}
+		}
+		column, err := columnFromArrow(field, arr)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to convert arrow column %s", name)
+		}
+		columns = append(columns, column)
+	}
+	return columns, nil
+}
+
+func columnFromArrow(field *Field, arr arrow.Array) (Column, error) {
+	switch field.DataType {
+	case FieldTypeNone:
+		// dynamic column, type decided by arrow type
+		return dynamicColumnFromArrow(field.Name, arr)
+	case FieldTypeBool:
+		if a, ok := arr.(*array.Boolean); ok {
+			values := make([]bool, a.Len())
+			for i := range values {
+				values[i] = a.Value(i)
+			}
+			return arrowScalarColumn(field, values, arr)
+		}
+	case FieldTypeInt8:
+		if a, ok := arr.(*array.Int8); ok {
+			return arrowScalarColumn(field, a.Int8Values(), arr)
+		}
+	case FieldTypeInt16:
+		if a, ok := arr.(*array.Int16); ok {
+			return arrowScalarColumn(field, a.Int16Values(), arr)
+		}
+	case FieldTypeInt32:
+		if a, ok := arr.(*array.Int32); ok {
+			return arrowScalarColumn(field, a.Int32Values(), arr)
+		}
+	case FieldTypeInt64:
+		if a, ok := arr.(*array.Int64); ok {
+			return arrowScalarColumn(field, a.Int64Values(), arr)
+		}
+	case FieldTypeFloat:
+		if a, ok := arr.(*array.Float32); ok {
+			return arrowScalarColumn(field, a.Float32Values(), arr)
+		}
+	case FieldTypeDouble:
+		if a, ok := arr.(*array.Float64); ok {
+			return arrowScalarColumn(field, a.Float64Values(), arr)
+		}
+	case FieldTypeString, FieldTypeVarChar:
+		if a, ok := arr.(*array.String); ok {
+			values := make([]string, a.Len())
+			for i := range values {
+				values[i] = a.Value(i)
+			}
+			return arrowScalarColumn(field, values, arr)
+		}
+	case FieldTypeJSON:
+		return jsonColumnFromArrow(field, arr)
+	case FieldTypeFloatVector:
+		if fsl, size, ok := fixedSizeList(arr, arrow.FLOAT32); ok {
+			if err := checkArrowDim(field, fsl, size); err != nil {
+				return nil, err
+			}
+			flat := fsl.ListValues().(*array.Float32).Float32Values()
+			offset := fsl.Data().Offset()
+			values := make([][]float32, fsl.Len())
+			for i := range values {
+				start := (offset + i) * size
+				values[i] = flat[start : start+size : start+size]
+			}
+			return NewColumnFloatVector(field.Name, size, values), nil
+		}
+	case FieldTypeBinaryVector:
+		values, size, err := byteVectorsFromArrow(field, arr, arrow.UINT8, 1)
+		if err != nil {
+			return nil, err
+		}
+		return NewColumnBinaryVector(field.Name, size*8, values), nil
+	case FieldTypeFloat16Vector:
+		values, size, err := byteVectorsFromArrow(field, arr, arrow.FLOAT16, 2)
+		if err != nil {
+			return nil, err
+		}
+		return NewColumnFloat16Vector(field.Name, size, values), nil
+	case FieldTypeBFloat16Vector:
+		values, size, err := byteVectorsFromArrow(field, arr, arrow.UINT16, 2)
+		if err != nil {
+			return nil, err
+		}
+		return NewColumnBFloat16Vector(field.Name, size, values), nil
+	case FieldTypeSparseFloatVector:
+		return sparseColumnFromArrow(field, arr)
+	case FieldTypeArray:
+		return arrayColumnFromArrow(field, arr)
+	}
+	return nil, errArrowTypeNotMatch(field, arr)
+}
+
+func errArrowTypeNotMatch(field *Field, arr arrow.Array) error {
+	return fmt.Errorf("arrow type %s not match field %s of type %s", arr.DataType(), field.Name, field.DataType.Name())
+}
+
+// arrowScalarColumn creates scalar column with values, nulls in arr become null values.
+func arrowScalarColumn[T ScalarType](field *Field, values []T, arr arrow.Array) (Column, error) {
+	if arr.NullN() == 0 {
+		return newColumnOf(field.Name, field.DataType, values), nil
+	}
+	validData := make([]bool, arr.Len())
+	for i := range validData {
+		validData[i] = arr.IsValid(i)
+	}
+	return newNullableColumnOf(field.Name, field.DataType, values, validData)
+}
+
+// dynamicColumnFromArrow converts scalar arrow array into column of the corresponding type.
+func dynamicColumnFromArrow(name string, arr arrow.Array) (Column, error) {
+	fieldType := FieldTypeNone
+	switch arr.(type) {
+	case *array.Boolean:
+		fieldType = FieldTypeBool
+	case *array.Int8:
+		fieldType = FieldTypeInt8
+	case *array.Int16:
+		fieldType = FieldTypeInt16
+	case *array.Int32:
+		fieldType = FieldTypeInt32
+	case *array.Int64:
+		fieldType = FieldTypeInt64
+	case *array.Float32:
+		fieldType = FieldTypeFloat
+	case *array.Float64:
+		fieldType = FieldTypeDouble
+	case *array.String:
+		fieldType = FieldTypeVarChar
+	default:
+		return nil, fmt.Errorf("arrow type %s not supported for dynamic field %s", arr.DataType(), name)
+	}
+	return columnFromArrow(&Field{Name: name, DataType: fieldType}, arr)
+}
+
+// jsonColumnFromArrow converts String or Binary of json text into JSON column,
+// Map array is also accepted for dynamic field and converted into json object.
+func jsonColumnFromArrow(field *Field, arr arrow.Array) (Column, error) {
+	values := make([][]byte, arr.Len())
+	for i := range values {
+		if arr.IsNull(i) {
+			if !field.IsDynamic {
+				return nil, fmt.Errorf("null value at %d of json field %s", i, field.Name)
+			}
+			values[i] = []byte(`{}`)
+			continue
+		}
+		switch a := arr.(type) {
+		case *array.String:
+			values[i] = []byte(a.Value(i))
+		case *array.Binary:
+			values[i] = a.Value(i)
+		case *array.Map:
+			if !field.IsDynamic {
+				return nil, errArrowTypeNotMatch(field, arr)
+			}
+			bs, err := mapRowToJSON(a, i)
+			if err != nil {
+				return nil, err
+			}
+			values[i] = bs
+		default:
+			return nil, errArrowTypeNotMatch(field, arr)
+		}
+	}
+	return NewColumnJSONBytes(field.Name, values).WithIsDynamic(field.IsDynamic), nil
+}
+
+// mapRowToJSON marshals the map at row idx into json object, map keys shall be strings.
+func mapRowToJSON(m *array.Map, idx int) ([]byte, error) {
+	keys, ok := m.Keys().(*array.String)
+	if !ok {
+		return nil, fmt.Errorf("map key type %s is not string", m.Keys().DataType())
+	}
+	items := m.Items()
+	start, end := m.ValueOffsets(idx)
+	object := make(map[string]interface{}, end-start)
+	for j := int(start); j < int(end); j++ {
+		object[keys.Value(j)] = items.GetOneForMarshal(j)
+	}
+	return json.Marshal(object)
+}
+
+// fixedSizeList returns arr as FixedSizeList with list size when its element type matches.
+func fixedSizeList(arr arrow.Array, elementType arrow.Type) (*array.FixedSizeList, int, bool) {
+	fsl, ok := arr.(*array.FixedSizeList)
+	if !ok {
+		return nil, 0, false
+	}
+	listType := fsl.DataType().(*arrow.FixedSizeListType)
+	if listType.Elem().ID() != elementType {
+		return nil, 0, false
+	}
+	return fsl, int(listType.Len()), true
+}
+
+// checkArrowDim checks the arrow list size against field dim and vectors are not null.
+func checkArrowDim(field *Field, arr arrow.Array, dim int) error {
+	if arr.NullN() > 0 {
+		return fmt.Errorf("vector field %s contains null", field.Name)
+	}
+	if dimStr, ok := field.TypeParams[TypeParamDim]; ok && dimStr != strconv.Itoa(dim) {
+		return fmt.Errorf("arrow vector dim %d not match field %s dim %s", dim, field.Name, dimStr)
+	}
+	return nil
+}
+
+// byteVectorsFromArrow converts FixedSizeList of width-byte elements or FixedSizeBinary into byte vectors,
+// returns the vectors sharing memory with arr and the element count per vector.
+func byteVectorsFromArrow(field *Field, arr arrow.Array, elementType arrow.Type, width int) ([][]byte, int, error) {
+	if a, ok := arr.(*array.FixedSizeBinary); ok {
+		byteWidth := a.DataType().(*arrow.FixedSizeBinaryType).ByteWidth
+		size := byteWidth / width
+		if field.DataType == FieldTypeBinaryVector {
+			// binary vector dim is bit count
+			if err := checkArrowDim(field, arr, size*8); err != nil {
+				return nil, 0, err
+			}
+		} else if err := checkArrowDim(field, arr, size); err != nil {
+			return nil, 0, err
+		}
+		values := make([][]byte, a.Len())
+		for i := range values {
+			values[i] = a.Value(i)
+		}
+		return values, size, nil
+	}
+
+	fsl, size, ok := fixedSizeList(arr, elementType)
+	if !ok {
+		return nil, 0, errArrowTypeNotMatch(field, arr)
+	}
+	dim := size
+	if field.DataType == FieldTypeBinaryVector {
+		dim = size * 8
+	}
+	if err := checkArrowDim(field, arr, dim); err != nil {
+		return nil, 0, err
+	}
+	var flat []byte
+	switch child := fsl.ListValues().(type) {
+	case *array.Uint8:
+		flat = child.Uint8Values()
+	case *array.Float16:
+		flat = arrow.Float16Traits.CastToBytes(child.Values())
+	case *array.Uint16:
+		flat = arrow.Uint16Traits.CastToBytes(child.Uint16Values())
+	}
+	offset := fsl.Data().Offset()
+	rowBytes := size * width
+	values := make([][]byte, fsl.Len())
+	for i := range values {
+		start := (offset + i) * rowBytes
+		values[i] = flat[start : start+rowBytes : start+rowBytes]
+	}
+	return values, size, nil
+}
+
+// sparseColumnFromArrow converts Map of integer index to float value into sparse vector column.
+func sparseColumnFromArrow(field *Field, arr arrow.Array) (Column, error) {
+	m, ok := arr.(*array.Map)
+	if !ok {
+		return nil, errArrowTypeNotMatch(field, arr)
+	}
+	items, ok := m.Items().(*array.Float32)
+	if !ok {
+		return nil, fmt.Errorf("sparse vector value type %s is not float32", m.Items().DataType())
+	}
+	var position func(j int) (uint32, error)
+	switch keys := m.Keys().(type) {
+	case *array.Uint32:
+		position = func(j int) (uint32, error) { return keys.Value(j), nil }
+	case *array.Int32:
+		position = func(j int) (uint32, error) { return toSparsePosition(int64(keys.Value(j))) }
+	case *array.Int64:
+		position = func(j int) (uint32, error) { return toSparsePosition(keys.Value(j)) }
+	default:
+		return nil, fmt.Errorf("sparse vector index type %s not supported", m.Keys().DataType())
+	}
+
+	vectors := make([]SparseFloatVector, 0, m.Len())
+	for i := 0; i < m.Len(); i++ {
+		if m.IsNull(i) {
+			return nil, fmt.Errorf("vector field %s contains null", field.Name)
+		}
+		start, end := m.ValueOffsets(i)
+		positions := make([]uint32, 0, end-start)
+		values := make([]float32, 0, end-start)
+		for j := int(start); j < int(end); j++ {
+			p, err := position(j)
+			if err != nil {
+				return nil, err
+			}
+			positions = append(positions, p)
+			values = append(values, items.Value(j))
+		}
+		vector, err := NewSparseFloatVector(positions, values)
+		if err != nil {
+			return nil, err
+		}
+		vectors = append(vectors, vector)
+	}
+	return NewColumnSparseFloatVector(field.Name, vectors), nil
+}
+
+func toSparsePosition(v int64) (uint32, error) {
+	if v < 0 || v > math.MaxUint32 {
+		return 0, fmt.Errorf("sparse vector index %d out of range", v)
+	}
+	return uint32(v), nil
+}
+
+// arrayColumnFromArrow converts List of scalar elements into array column.
+func arrayColumnFromArrow(field *Field, arr arrow.Array) (Column, error) {
+	list, ok := arr.(*array.List)
+	if !ok {
+		return nil, errArrowTypeNotMatch(field, arr)
+	}
+	column, err := NewArrayColumn(field.Name, field.ElementType, list.Len())
+	if err != nil {
+		return nil, err
+	}
+	for i := 0; i < list.Len(); i++ {
+		if list.IsNull(i) {
+			return nil, fmt.Errorf("null value at %d of array field %s", i, field.Name)
+		}
+		start, end := list.ValueOffsets(i)
+		slice := array.NewSlice(list.ListValues(), start, end)
+		value, err := arrowListValues(slice)
+		slice.Release()
+		if err != nil {
+			return nil, err
+		}
+		if err := column.AppendValue(value); err != nil {
+			return nil, errors.Wrapf(err, "array field %s", field.Name)
+		}
+	}
+	return column, nil
+}
+
+// arrowListValues returns the values of arrow array as typed slice.
+func arrowListValues(arr arrow.Array) (interface{}, error) {
+	switch a := arr.(type) {
+	case *array.Boolean:
+		values := make([]bool, a.Len())
+		for i := range values {
+			values[i] = a.Value(i)
+		}
+		return values, nil
+	case *array.Int8:
+		return a.Int8Values(), nil
+	case *array.Int16:
+		return a.Int16Values(), nil
+	case *array.Int32:
+		return a.Int32Values(), nil
+	case *array.Int64:
+		return a.Int64Values(), nil
+	case *array.Float32:
+		return a.Float32Values(), nil
+	case *array.Float64:
+		return a.Float64Values(), nil
+	case *array.String:
+		values := make([]string, a.Len())
+		for i := range values {
+			values[i] = a.Value(i)
+		}
+		return values, nil
+	default:
+		return nil, fmt.Errorf("arrow list element type %s not supported", arr.DataType())
+	}
+}
@@ -0,0 +1,171 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/apache/arrow/go/v12/arrow"
+	"github.com/apache/arrow/go/v12/arrow/array"
+	"github.com/apache/arrow/go/v12/arrow/memory"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func arrowRecord(t *testing.T, columns ...Column) arrow.Record {
+	fields := make([]arrow.Field, 0, len(columns))
+	arrays := make([]arrow.Array, 0, len(columns))
+	for _, column := range columns {
+		arr, err := ColumnToArrow(memory.DefaultAllocator, column)
+		require.NoError(t, err)
+		defer arr.Release()
+		fields = append(fields, arrow.Field{Name: column.Name(), Type: arr.DataType(), Nullable: arr.NullN() > 0})
+		arrays = append(arrays, arr)
+	}
+	return array.NewRecord(arrow.NewSchema(fields, nil), arrays, -1)
+}
+
+func TestArrowRoundTrip(t *testing.T) {
+	sparse, err := NewSparseFloatVector([]uint32{1, 100}, []float32{0.1, 0.2})
+	require.NoError(t, err)
+	nullable, err := NewNullableColumnInt64("nullable", []int64{1, 0}, []bool{true, false})
+	require.NoError(t, err)
+	nullableString, err := NewNullableColumnVarChar("nullable_string", []string{"", "b"}, []bool{false, true})
+	require.NoError(t, err)
+
+	columns := []Column{
+		NewColumnBool("bool", []bool{true, false}),
+		NewColumnInt8("int8", []int8{1, 2}),
+		NewColumnInt16("int16", []int16{1, 2}),
+		NewColumnInt32("int32", []int32{1, 2}),
+		NewColumnInt64("int64", []int64{1, 2}),
+		NewColumnFloat("float", []float32{0.1, 0.2}),
+		NewColumnDouble("double", []float64{0.1, 0.2}),
+		NewColumnVarChar("varchar", []string{"a", "b"}),
+		nullable,
+		nullableString,
+		NewColumnJSONBytes("json", [][]byte{[]byte(`{"a":1}`), []byte(`[1]`)}),
+		NewColumnFloatVector("float_vector", 2, [][]float32{{0.1, 0.2}, {0.3, 0.4}}),
+		NewColumnBinaryVector("binary_vector", 16, [][]byte{{1, 2}, {3, 4}}),
+		NewColumnFloat16Vector("fp16_vector", 2, [][]byte{{1, 2, 3, 4}, {5, 6, 7, 8}}),
+		NewColumnBFloat16Vector("bf16_vector", 2, [][]byte{{1, 2, 3, 4}, {5, 6, 7, 8}}),
+		NewColumnSparseFloatVector("sparse_vector", []SparseFloatVector{sparse, sparse}),
+		NewColumnInt64Array("int64_array", [][]int64{{1, 2}, {}}),
+		NewColumnVarCharArray("varchar_array", [][]string{{"a"}, {"b", "c"}}),
+	}
+	sch := NewSchema().
+		WithField(NewField().WithName("bool").WithDataType(FieldTypeBool)).
+		WithField(NewField().WithName("int8").WithDataType(FieldTypeInt8)).
+		WithField(NewField().WithName("int16").WithDataType(FieldTypeInt16)).
+		WithField(NewField().WithName("int32").WithDataType(FieldTypeInt32)).
+		WithField(NewField().WithName("int64").WithDataType(FieldTypeInt64)).
+		WithField(NewField().WithName("float").WithDataType(FieldTypeFloat)).
+		WithField(NewField().WithName("double").WithDataType(FieldTypeDouble)).
+		WithField(NewField().WithName("varchar").WithDataType(FieldTypeVarChar)).
+		WithField(NewField().WithName("nullable").WithDataType(FieldTypeInt64).WithNullable(true)).
+		WithField(NewField().WithName("nullable_string").WithDataType(FieldTypeVarChar).WithNullable(true)).
+		WithField(NewField().WithName("json").WithDataType(FieldTypeJSON)).
+		WithField(NewField().WithName("float_vector").WithDataType(FieldTypeFloatVector).WithDim(2)).
+		WithField(NewField().WithName("binary_vector").WithDataType(FieldTypeBinaryVector).WithDim(16)).
+		WithField(NewField().WithName("fp16_vector").WithDataType(FieldTypeFloat16Vector).WithDim(2)).
+		WithField(NewField().WithName("bf16_vector").WithDataType(FieldTypeBFloat16Vector).WithDim(2)).
+		WithField(NewField().WithName("sparse_vector").WithDataType(FieldTypeSparseFloatVector)).
+		WithField(NewField().WithName("int64_array").WithDataType(FieldTypeArray).WithElementType(FieldTypeInt64)).
+		WithField(NewField().WithName("varchar_array").WithDataType(FieldTypeArray).WithElementType(FieldTypeVarChar))
+
+	record := arrowRecord(t, columns...)
+	defer record.Release()
+	assert.EqualValues(t, 2, record.NumRows())
+	assert.Equal(t, arrow.FixedSizeListOf(2, arrow.PrimitiveTypes.Float32), record.Schema().Field(11).Type)
+
+	result, err := ColumnsFromArrow(record, sch)
+	require.NoError(t, err)
+	require.Len(t, result, len(columns))
+	for i, column := range columns {
+		t.Run(column.Name(), func(t *testing.T) {
+			assert.Equal(t, column.Name(), result[i].Name())
+			assert.Equal(t, column.Type(), result[i].Type())
+			assert.Equal(t, columnValues(t, column), columnValues(t, result[i]))
+		})
+	}
+}
+
+func TestArrowZeroCopy(t *testing.T) {
+	values := []int64{1, 2, 3}
+	arr, err := ColumnToArrow(memory.DefaultAllocator, NewColumnInt64("int64", values))
+	require.NoError(t, err)
+	defer arr.Release()
+	assert.Same(t, &values[0], &arr.(*array.Int64).Int64Values()[0])
+
+	record := arrowRecord(t,
+		NewColumnInt64("int64", values),
+		NewColumnFloatVector("vector", 2, [][]float32{{0.1, 0.2}, {0.3, 0.4}, {0.5, 0.6}}),
+	)
+	defer record.Release()
+	sch := NewSchema().
+		WithField(NewField().WithName("int64").WithDataType(FieldTypeInt64)).
+		WithField(NewField().WithName("vector").WithDataType(FieldTypeFloatVector).WithDim(2))
+	columns, err := ColumnsFromArrow(record.NewSlice(1, 3), sch)
+	require.NoError(t, err)
+
+	ints := columns[0].(*ColumnInt64)
+	assert.Equal(t, []int64{2, 3}, ints.Data())
+	assert.Same(t, &record.Column(0).(*array.Int64).Int64Values()[1], &ints.Data()[0])
+
+	vectors := columns[1].(*ColumnFloatVector)
+	assert.Equal(t, [][]float32{{0.3, 0.4}, {0.5, 0.6}}, vectors.Data())
+	flat := record.Column(1).(*array.FixedSizeList).ListValues().(*array.Float32).Float32Values()
+	assert.Same(t, &flat[2], &vectors.Data()[0][0])
+}
+
+func TestArrowDynamicField(t *testing.T) {
+	mem := memory.DefaultAllocator
+	builder := array.NewMapBuilder(mem, arrow.BinaryTypes.String, arrow.PrimitiveTypes.Int64, false)
+	defer builder.Release()
+	builder.Append(true)
+	builder.KeyBuilder().(*array.StringBuilder).Append("a")
+	builder.ItemBuilder().(*array.Int64Builder).Append(1)
+	builder.AppendNull()
+	meta := builder.NewArray()
+	defer meta.Release()
+
+	extra := array.NewStringBuilder(mem)
+	defer extra.Release()
+	extra.AppendValues([]string{"x", "y"}, nil)
+	extraArr := extra.NewArray()
+	defer extraArr.Release()
+
+	record := array.NewRecord(arrow.NewSchema([]arrow.Field{
+		{Name: "$meta", Type: meta.DataType()},
+		{Name: "extra", Type: extraArr.DataType()},
+	}, nil), []arrow.Array{meta, extraArr}, 2)
+	defer record.Release()
+
+	sch := NewSchema().WithDynamicFieldEnabled(true).
+		WithField(NewField().WithName("$meta").WithDataType(FieldTypeJSON).WithIsDynamic(true))
+	columns, err := ColumnsFromArrow(record, sch)
+	require.NoError(t, err)
+	require.Len(t, columns, 2)
+	assert.Equal(t, []interface{}{[]byte(`{"a":1}`), []byte(`{}`)}, columnValues(t, columns[0]))
+	assert.True(t, columns[0].(*ColumnJSONBytes).isDynamic)
+	assert.Equal(t, FieldTypeVarChar, columns[1].Type())
+
+	_, err = ColumnsFromArrow(record, NewSchema().WithField(NewField().WithName("$meta").WithDataType(FieldTypeJSON)))
+	assert.Error(t, err)
+}
+
+func TestArrowTypeMismatch(t *testing.T) {
+	record := arrowRecord(t, NewColumnFloatVector("vector", 2, [][]float32{{0.1, 0.2}}))
+	defer record.Release()
+
+	cases := []*Schema{
+		NewSchema().WithField(NewField().WithName("vector").WithDataType(FieldTypeFloatVector).WithDim(4)),
+		NewSchema().WithField(NewField().WithName("vector").WithDataType(FieldTypeBinaryVector).WithDim(16)),
+		NewSchema().WithField(NewField().WithName("vector").WithDataType(FieldTypeInt64)),
+		NewSchema().WithField(NewField().WithName("other").WithDataType(FieldTypeInt64)),
+	}
+	for _, sch := range cases {
+		_, err := ColumnsFromArrow(record, sch)
+		assert.Error(t, err)
+	}
+	_, err := ColumnsFromArrow(record, nil)
+	assert.Error(t, err)
+}
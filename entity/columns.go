@@ -37,6 +37,16 @@ type Column interface {
 	GetAsBool(int) (bool, error)
 }
 
+// SliceableColumn is the Column supporting row selection and concatenation,
+// all the columns provided by entity implement it.
+type SliceableColumn interface {
+	Column
+	Slice(begin, end int) Column
+	Take(indices []int) (Column, error)
+	Filter(keep func(idx int) bool) Column
+	Concat(other Column) (Column, error)
+}
+
 // ColumnBase adds conversion methods support for fixed-type columns.
 type ColumnBase struct{}
 
@@ -106,6 +116,42 @@ func (bv BinaryVector) FieldType() FieldType {
 	return FieldTypeBinaryVector
 }
 
+// Float16Vector []byte vector wrapper, each element is a little endian IEEE 754 half precision float.
+type Float16Vector []byte
+
+// Dim returns vector dimension, each element occupies 2 bytes
+func (fv Float16Vector) Dim() int {
+	return len(fv) / 2
+}
+
+// Serialize just return bytes
+func (fv Float16Vector) Serialize() []byte {
+	return fv
+}
+
+// FieldType returns coresponding field type.
+func (fv Float16Vector) FieldType() FieldType {
+	return FieldTypeFloat16Vector
+}
+
+// BFloat16Vector []byte vector wrapper, each element is a little endian brain floating point.
+type BFloat16Vector []byte
+
+// Dim returns vector dimension, each element occupies 2 bytes
+func (bv BFloat16Vector) Dim() int {
+	return len(bv) / 2
+}
+
+// Serialize just return bytes
+func (bv BFloat16Vector) Serialize() []byte {
+	return bv
+}
+
+// FieldType returns coresponding field type.
+func (bv BFloat16Vector) FieldType() FieldType {
+	return FieldTypeBFloat16Vector
+}
+
 var errFieldDataTypeNotMatch = errors.New("FieldData type not matched")
 
 // IDColumns converts schema.IDs to corresponding column
@@ -137,6 +183,9 @@ func IDColumns(idField *schema.IDs, begin, end int) (Column, error) {
 // FieldDataColumn converts schema.FieldData to Column, used int search result conversion logic
 // begin, end specifies the start and end positions
 func FieldDataColumn(fd *schema.FieldData, begin, end int) (Column, error) {
+	if validData := fd.GetValidData(); validData != nil {
+		return nullableFieldDataColumn(fd, validData, begin, end)
+	}
 	switch fd.GetType() {
 	case schema.DataType_Bool:
 		data, ok := fd.GetScalars().GetData().(*schema.ScalarField_BoolData)
@@ -250,6 +299,16 @@ func FieldDataColumn(fd *schema.FieldData, begin, end int) (Column, error) {
 		}
 		return NewColumnJSONBytes(fd.GetFieldName(), data.JsonData.GetData()[begin:end]).WithIsDynamic(isDynamic), nil
 
+	case schema.DataType_Array:
+		data := fd.GetScalars().GetArrayData()
+		if data == nil {
+			return nil, errFieldDataTypeNotMatch
+		}
+		if end < 0 {
+			return parseArrayData(fd.GetFieldName(), data.GetElementType(), data.GetData()[begin:])
+		}
+		return parseArrayData(fd.GetFieldName(), data.GetElementType(), data.GetData()[begin:end])
+
 	case schema.DataType_FloatVector:
 		vectors := fd.GetVectors()
 		x, ok := vectors.GetData().(*schema.VectorField_FloatVector)
@@ -292,6 +351,35 @@ func FieldDataColumn(fd *schema.FieldData, begin, end int) (Column, error) {
 		}
 		return NewColumnBinaryVector(fd.GetFieldName(), dim, vector), nil
 
+	case schema.DataType_Float16Vector:
+		vectors := fd.GetVectors()
+		x, ok := vectors.GetData().(*schema.VectorField_Float16Vector)
+		if !ok {
+			return nil, errFieldDataTypeNotMatch
+		}
+		dim := int(vectors.GetDim())
+		vector, err := splitHalfVectors(x.Float16Vector, dim, begin, end)
+		if err != nil {
+			return nil, err
+		}
+		return NewColumnFloat16Vector(fd.GetFieldName(), dim, vector), nil
+
+	case schema.DataType_BFloat16Vector:
+		vectors := fd.GetVectors()
+		x, ok := vectors.GetData().(*schema.VectorField_Bfloat16Vector)
+		if !ok {
+			return nil, errFieldDataTypeNotMatch
+		}
+		dim := int(vectors.GetDim())
+		vector, err := splitHalfVectors(x.Bfloat16Vector, dim, begin, end)
+		if err != nil {
+			return nil, err
+		}
+		return NewColumnBFloat16Vector(fd.GetFieldName(), dim, vector), nil
+
+	case schema.DataType_SparseFloatVector:
+		return parseSparseFloatVectors(fd.GetFieldName(), fd.GetVectors().GetSparseFloatVector(), begin, end)
+
 	default:
 		return nil, fmt.Errorf("unsupported data type %s", fd.GetType())
 	}
@@ -353,7 +441,52 @@ func FieldDataVector(fd *schema.FieldData) (Column, error) {
 			vector = append(vector, v)
 		}
 		return NewColumnBinaryVector(fd.GetFieldName(), dim, vector), nil
+	case schema.DataType_Float16Vector:
+		vectors := fd.GetVectors()
+		x, ok := vectors.GetData().(*schema.VectorField_Float16Vector)
+		if !ok {
+			return nil, errFieldDataTypeNotMatch
+		}
+		dim := int(vectors.GetDim())
+		vector, err := splitHalfVectors(x.Float16Vector, dim, 0, -1)
+		if err != nil {
+			return nil, err
+		}
+		return NewColumnFloat16Vector(fd.GetFieldName(), dim, vector), nil
+	case schema.DataType_BFloat16Vector:
+		vectors := fd.GetVectors()
+		x, ok := vectors.GetData().(*schema.VectorField_Bfloat16Vector)
+		if !ok {
+			return nil, errFieldDataTypeNotMatch
+		}
+		dim := int(vectors.GetDim())
+		vector, err := splitHalfVectors(x.Bfloat16Vector, dim, 0, -1)
+		if err != nil {
+			return nil, err
+		}
+		return NewColumnBFloat16Vector(fd.GetFieldName(), dim, vector), nil
+	case schema.DataType_SparseFloatVector:
+		return parseSparseFloatVectors(fd.GetFieldName(), fd.GetVectors().GetSparseFloatVector(), 0, -1)
 	default:
 		return nil, errors.New("unsupported data type")
 	}
 }
+
+// splitHalfVectors splits the flattened 2-byte element vectors data into rows of [begin, end),
+// end < 0 means till the last row.
+func splitHalfVectors(data []byte, dim int, begin, end int) ([][]byte, error) {
+	if data == nil || dim <= 0 {
+		return nil, errFieldDataTypeNotMatch
+	}
+	blen := dim * 2
+	if end < 0 {
+		end = len(data) / blen
+	}
+	vector := make([][]byte, 0, end-begin)
+	for i := begin; i < end; i++ {
+		v := make([]byte, blen)
+		copy(v, data[i*blen:(i+1)*blen])
+		vector = append(vector, v)
+	}
+	return vector, nil
+}
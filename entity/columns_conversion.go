@@ -1,37 +1,55 @@
 package entity
 
-func (c *ColumnInt8) GetAsInt64(idx int) (int64, error) {
-	v, err := c.ValueByIdx(idx)
-	return int64(v), err
-}
+import "github.com/cockroachdb/errors"
 
-func (c *ColumnInt16) GetAsInt64(idx int) (int64, error) {
-	v, err := c.ValueByIdx(idx)
-	return int64(v), err
-}
+var errConversionNotSupport = errors.New("conversion between fixed-type column not support")
 
-func (c *ColumnInt32) GetAsInt64(idx int) (int64, error) {
+// GetAsInt64 returns value at idx as int64, only integer columns support it.
+func (c *ColumnOf[T]) GetAsInt64(idx int) (int64, error) {
 	v, err := c.ValueByIdx(idx)
-	return int64(v), err
-}
-
-func (c *ColumnInt64) GetAsInt64(idx int) (int64, error) {
-	return c.ValueByIdx(idx)
-}
-
-func (c *ColumnString) GetAsString(idx int) (string, error) {
-	return c.ValueByIdx(idx)
-}
-
-func (c *ColumnFloat) GetAsDouble(idx int) (float64, error) {
+	switch v := any(v).(type) {
+	case int8:
+		return int64(v), err
+	case int16:
+		return int64(v), err
+	case int32:
+		return int64(v), err
+	case int64:
+		return v, err
+	default:
+		return 0, errConversionNotSupport
+	}
+}
+
+// GetAsString returns value at idx as string, only string columns support it.
+func (c *ColumnOf[T]) GetAsString(idx int) (string, error) {
 	v, err := c.ValueByIdx(idx)
-	return float64(v), err
+	s, ok := any(v).(string)
+	if !ok {
+		return "", errConversionNotSupport
+	}
+	return s, err
 }
 
-func (c *ColumnDouble) GetAsDouble(idx int) (float64, error) {
-	return c.ValueByIdx(idx)
-}
-
-func (c *ColumnBool) GetAsBool(idx int) (bool, error) {
-	return c.ValueByIdx(idx)
+// GetAsDouble returns value at idx as float64, only floating point columns support it.
+func (c *ColumnOf[T]) GetAsDouble(idx int) (float64, error) {
+	v, err := c.ValueByIdx(idx)
+	switch v := any(v).(type) {
+	case float32:
+		return float64(v), err
+	case float64:
+		return v, err
+	default:
+		return 0, errConversionNotSupport
+	}
+}
+
+// GetAsBool returns value at idx as bool, only bool columns support it.
+func (c *ColumnOf[T]) GetAsBool(idx int) (bool, error) {
+	v, err := c.ValueByIdx(idx)
+	b, ok := any(v).(bool)
+	if !ok {
+		return false, errConversionNotSupport
+	}
+	return b, err
 }
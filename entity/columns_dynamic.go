@@ -12,10 +12,14 @@
 package entity
 
 import (
+	"encoding/json"
+
 	"github.com/cockroachdb/errors"
 	"github.com/tidwall/gjson"
 )
 
+var _ (JSONColumn) = (*ColumnDynamic)(nil)
+
 // ColumnDynamic is a logically wrapper for dynamic json field with provided output field.
 type ColumnDynamic struct {
 	*ColumnJSONBytes
@@ -106,3 +110,61 @@ func (c *ColumnDynamic) GetAsDouble(idx int) (float64, error) {
 	}
 	return r.Float(), nil
 }
+
+// GetByPath returns the value at path relative to the output field of json document at idx.
+func (c *ColumnDynamic) GetByPath(idx int, path string) (interface{}, error) {
+	raw, err := c.rawValue(idx)
+	if err != nil {
+		return nil, err
+	}
+	return getJSONPath(raw, path)
+}
+
+// Unmarshal decodes the output field value at idx into v.
+func (c *ColumnDynamic) Unmarshal(idx int, v interface{}) error {
+	raw, err := c.rawValue(idx)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(raw, v)
+}
+
+// Slice returns column of values in [begin, end), which shares memory with c.
+func (c *ColumnDynamic) Slice(begin, end int) Column {
+	return NewColumnDynamic(c.ColumnJSONBytes.withValues(c.values[begin:end:end]), c.outputField)
+}
+
+// Take returns column of values at indices.
+func (c *ColumnDynamic) Take(indices []int) (Column, error) {
+	values, err := takeValues(c.values, indices)
+	if err != nil {
+		return nil, err
+	}
+	return NewColumnDynamic(c.ColumnJSONBytes.withValues(values), c.outputField), nil
+}
+
+// Filter returns column of values which keep returns true.
+func (c *ColumnDynamic) Filter(keep func(idx int) bool) Column {
+	return NewColumnDynamic(c.ColumnJSONBytes.withValues(filterValues(c.values, keep)), c.outputField)
+}
+
+// Concat returns column of values in c followed by values in other, which shall be of the same output field.
+func (c *ColumnDynamic) Concat(other Column) (Column, error) {
+	o, ok := other.(*ColumnDynamic)
+	if !ok || o.outputField != c.outputField {
+		return nil, errConcatNotMatch(c, other)
+	}
+	return NewColumnDynamic(c.ColumnJSONBytes.withValues(concatValues(c.values, o.values)), c.outputField), nil
+}
+
+func (c *ColumnDynamic) rawValue(idx int) ([]byte, error) {
+	bs, err := c.ColumnJSONBytes.ValueByIdx(idx)
+	if err != nil {
+		return nil, err
+	}
+	r := gjson.GetBytes(bs, c.outputField)
+	if !r.Exists() {
+		return nil, errors.Wrap(ErrJSONPathNotExist, c.outputField)
+	}
+	return []byte(r.Raw), nil
+}
@@ -140,6 +140,35 @@ func (s *ColumnDynamicSuite) TestIndexOutOfRange() {
 
 	_, err = column.GetAsDouble(0)
 	s.Error(err)
+
+	_, err = column.GetByPath(0, "a")
+	s.Error(err)
+
+	var v interface{}
+	s.Error(column.Unmarshal(0, &v))
+}
+
+func (s *ColumnDynamicSuite) TestGetByPath() {
+	column := NewColumnDynamic(&ColumnJSONBytes{
+		values: [][]byte{
+			[]byte(`{"field": {"a": [1, {"b": "value"}]}}`),
+			[]byte(`{"other_field": 1}`),
+		},
+	}, "field")
+
+	v, err := column.GetByPath(0, "a[1].b")
+	s.NoError(err)
+	s.Equal("value", v)
+
+	_, err = column.GetByPath(1, "a")
+	s.ErrorIs(err, ErrJSONPathNotExist)
+
+	var field struct {
+		A []interface{} `json:"a"`
+	}
+	s.NoError(column.Unmarshal(0, &field))
+	s.Len(field.A, 2)
+	s.Error(column.Unmarshal(1, &field))
 }
 
 func TestColumnDynamic(t *testing.T) {
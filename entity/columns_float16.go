@@ -0,0 +1,144 @@
+package entity
+
+import (
+	"encoding/binary"
+	"math"
+)
+
+// ToFloat16Vector converts float32 vector into float16 vector,
+// values are rounded to nearest even and out of range values become infinity.
+func (fv FloatVector) ToFloat16Vector() Float16Vector {
+	data := make([]byte, 2*len(fv))
+	for i, f := range fv {
+		binary.LittleEndian.PutUint16(data[2*i:], float32ToFloat16(f))
+	}
+	return data
+}
+
+// ToBFloat16Vector converts float32 vector into bfloat16 vector,
+// values are rounded to nearest even.
+func (fv FloatVector) ToBFloat16Vector() BFloat16Vector {
+	data := make([]byte, 2*len(fv))
+	for i, f := range fv {
+		binary.LittleEndian.PutUint16(data[2*i:], float32ToBFloat16(f))
+	}
+	return data
+}
+
+// ToFloat32Vector converts float16 vector back into float32 vector.
+func (fv Float16Vector) ToFloat32Vector() FloatVector {
+	vector := make([]float32, fv.Dim())
+	for i := range vector {
+		vector[i] = float16ToFloat32(binary.LittleEndian.Uint16(fv[2*i:]))
+	}
+	return vector
+}
+
+// ToFloat32Vector converts bfloat16 vector back into float32 vector.
+func (bv BFloat16Vector) ToFloat32Vector() FloatVector {
+	vector := make([]float32, bv.Dim())
+	for i := range vector {
+		vector[i] = bfloat16ToFloat32(binary.LittleEndian.Uint16(bv[2*i:]))
+	}
+	return vector
+}
+
+// NewColumnFloat16VectorFromFloat32 creates float16 vector column from float32 vectors.
+func NewColumnFloat16VectorFromFloat32(name string, dim int, values [][]float32) *ColumnFloat16Vector {
+	data := make([][]byte, 0, len(values))
+	for _, vector := range values {
+		data = append(data, FloatVector(vector).ToFloat16Vector())
+	}
+	return NewColumnFloat16Vector(name, dim, data)
+}
+
+// NewColumnBFloat16VectorFromFloat32 creates bfloat16 vector column from float32 vectors.
+func NewColumnBFloat16VectorFromFloat32(name string, dim int, values [][]float32) *ColumnBFloat16Vector {
+	data := make([][]byte, 0, len(values))
+	for _, vector := range values {
+		data = append(data, FloatVector(vector).ToBFloat16Vector())
+	}
+	return NewColumnBFloat16Vector(name, dim, data)
+}
+
+// float32ToFloat16 converts float32 into IEEE 754 half precision bits with round half to even.
+func float32ToFloat16(f float32) uint16 {
+	bits := math.Float32bits(f)
+	sign := uint16(bits>>16) & 0x8000
+	exp := int32(bits>>23) & 0xff
+	mant := bits & 0x7fffff
+
+	if exp == 0xff {
+		if mant != 0 {
+			// keep NaN quiet
+			return sign | 0x7e00
+		}
+		return sign | 0x7c00
+	}
+
+	e := exp - 127 + 15
+	switch {
+	case e >= 0x1f:
+		return sign | 0x7c00
+	case e <= 0:
+		// subnormal half, values under half of the minimal subnormal round to zero
+		if e < -10 {
+			return sign
+		}
+		return sign | uint16(roundShift(mant|0x800000, uint32(14-e)))
+	default:
+		// carry of mantissa rounding propagates into exponent, overflow becomes infinity
+		return sign | uint16(uint32(e)<<10+roundShift(mant, 13))
+	}
+}
+
+// float16ToFloat32 converts IEEE 754 half precision bits into float32.
+func float16ToFloat32(h uint16) float32 {
+	sign := uint32(h&0x8000) << 16
+	exp := uint32(h>>10) & 0x1f
+	mant := uint32(h & 0x3ff)
+
+	switch exp {
+	case 0x1f:
+		return math.Float32frombits(sign | 0x7f800000 | mant<<13)
+	case 0:
+		if mant == 0 {
+			return math.Float32frombits(sign)
+		}
+		// normalize subnormal half
+		e := uint32(127 - 15 + 1)
+		for mant&0x400 == 0 {
+			mant <<= 1
+			e--
+		}
+		return math.Float32frombits(sign | e<<23 | (mant&0x3ff)<<13)
+	default:
+		return math.Float32frombits(sign | (exp+127-15)<<23 | mant<<13)
+	}
+}
+
+// float32ToBFloat16 converts float32 into bfloat16 bits with round half to even.
+func float32ToBFloat16(f float32) uint16 {
+	bits := math.Float32bits(f)
+	if bits&0x7fffffff > 0x7f800000 {
+		// keep NaN quiet
+		return uint16(bits>>16) | 0x40
+	}
+	return uint16((bits + 0x7fff + (bits>>16)&1) >> 16)
+}
+
+// bfloat16ToFloat32 converts bfloat16 bits into float32.
+func bfloat16ToFloat32(b uint16) float32 {
+	return math.Float32frombits(uint32(b) << 16)
+}
+
+// roundShift shifts v right by n bits, rounding half to even.
+func roundShift(v uint32, n uint32) uint32 {
+	half := uint32(1) << (n - 1)
+	rem := v & (1<<n - 1)
+	r := v >> n
+	if rem > half || (rem == half && r&1 == 1) {
+		r++
+	}
+	return r
+}
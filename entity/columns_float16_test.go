@@ -0,0 +1,123 @@
+package entity
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFloat16Conversion(t *testing.T) {
+	t.Run("round_trip", func(t *testing.T) {
+		for i := 0; i <= math.MaxUint16; i++ {
+			h := uint16(i)
+			f := float16ToFloat32(h)
+			if math.IsNaN(float64(f)) {
+				continue
+			}
+			assert.Equal(t, h, float32ToFloat16(f))
+		}
+	})
+
+	cases := []struct {
+		tag    string
+		input  float32
+		expect float32
+	}{
+		{"exact", 1.5, 1.5},
+		{"tie_to_even_down", 1 + 1.0/2048, 1},
+		{"tie_to_even_up", 1 + 3.0/2048, 1 + 1.0/512},
+		{"round_up", 1 + 1.0/2048 + 1.0/65536, 1 + 1.0/1024},
+		{"max", 65519, 65504},
+		{"overflow", 65520, float32(math.Inf(1))},
+		{"negative_overflow", -1e10, float32(math.Inf(-1))},
+		{"min_subnormal", float32(math.Ldexp(1, -24)), float32(math.Ldexp(1, -24))},
+		{"subnormal_tie_to_zero", float32(math.Ldexp(1, -25)), 0},
+		{"subnormal_round_up", float32(math.Ldexp(1.5, -25)), float32(math.Ldexp(1, -24))},
+		{"underflow", 1e-10, 0},
+	}
+	for _, c := range cases {
+		t.Run(c.tag, func(t *testing.T) {
+			assert.Equal(t, c.expect, float16ToFloat32(float32ToFloat16(c.input)))
+		})
+	}
+
+	t.Run("nan", func(t *testing.T) {
+		assert.True(t, math.IsNaN(float64(float16ToFloat32(float32ToFloat16(float32(math.NaN()))))))
+	})
+}
+
+func TestBFloat16Conversion(t *testing.T) {
+	t.Run("round_trip", func(t *testing.T) {
+		for i := 0; i <= math.MaxUint16; i++ {
+			b := uint16(i)
+			f := bfloat16ToFloat32(b)
+			if math.IsNaN(float64(f)) {
+				continue
+			}
+			assert.Equal(t, b, float32ToBFloat16(f))
+		}
+	})
+
+	cases := []struct {
+		tag    string
+		input  float32
+		expect float32
+	}{
+		{"exact", 1.5, 1.5},
+		{"tie_to_even_down", 1 + 1.0/256, 1},
+		{"tie_to_even_up", 1 + 3.0/256, 1 + 1.0/64},
+		{"round_up", 1 + 1.0/256 + 1.0/65536, 1 + 1.0/128},
+		{"overflow", math.MaxFloat32, float32(math.Inf(1))},
+	}
+	for _, c := range cases {
+		t.Run(c.tag, func(t *testing.T) {
+			assert.Equal(t, c.expect, bfloat16ToFloat32(float32ToBFloat16(c.input)))
+		})
+	}
+
+	t.Run("nan", func(t *testing.T) {
+		assert.True(t, math.IsNaN(float64(bfloat16ToFloat32(float32ToBFloat16(float32(math.NaN()))))))
+	})
+}
+
+func TestFloat16Vectors(t *testing.T) {
+	fv := FloatVector{0.5, -2, 3.25}
+
+	f16 := fv.ToFloat16Vector()
+	assert.Equal(t, 3, f16.Dim())
+	assert.Equal(t, FieldTypeFloat16Vector, f16.FieldType())
+	assert.Equal(t, []byte(f16), f16.Serialize())
+	assert.Equal(t, fv, f16.ToFloat32Vector())
+
+	bf16 := fv.ToBFloat16Vector()
+	assert.Equal(t, 3, bf16.Dim())
+	assert.Equal(t, FieldTypeBFloat16Vector, bf16.FieldType())
+	assert.Equal(t, []byte(bf16), bf16.Serialize())
+	assert.Equal(t, fv, bf16.ToFloat32Vector())
+
+	column := NewColumnFloat16VectorFromFloat32("f16", 3, [][]float32{fv})
+	assert.Equal(t, [][]byte{f16}, column.Data())
+	err := column.AppendValue(f16)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, column.Len())
+
+	bcolumn := NewColumnBFloat16VectorFromFloat32("bf16", 3, [][]float32{fv})
+	assert.Equal(t, [][]byte{bf16}, bcolumn.Data())
+	err = bcolumn.AppendValue(bf16)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, bcolumn.Len())
+
+	// parse back from field data
+	c, err := FieldDataColumn(column.FieldData(), 1, -1)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, c.Len())
+	v, err := c.Get(0)
+	assert.NoError(t, err)
+	assert.Equal(t, fv, Float16Vector(v.([]byte)).ToFloat32Vector())
+
+	c, err = FieldDataColumn(bcolumn.FieldData(), 0, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, FieldTypeBFloat16Vector, c.Type())
+	assert.Equal(t, 1, c.Len())
+}
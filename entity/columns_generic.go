@@ -0,0 +1,289 @@
+package entity
+
+import (
+	"fmt"
+
+	"github.com/cockroachdb/errors"
+	schema "github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+)
+
+// ScalarType is the constraint of go types which scalar columns hold.
+type ScalarType interface {
+	bool | int8 | int16 | int32 | int64 | float32 | float64 | string
+}
+
+var _ NullableColumn = (*ColumnOf[int64])(nil)
+
+// ColumnOf is the generic column type for scalar fields,
+// ColumnBool, ColumnInt64, ColumnVarChar etc. are aliases of its instances, ColumnString embeds it.
+type ColumnOf[T ScalarType] struct {
+	ColumnBase
+	name      string
+	fieldType FieldType
+	values    []T
+	validData []bool // nil means all values are valid
+}
+
+// NewColumnOf creates column with values, the field type is derived from T,
+// string values make a VarChar column.
+func NewColumnOf[T ScalarType](name string, values []T) *ColumnOf[T] {
+	return newColumnOf(name, scalarFieldType[T](), values)
+}
+
+// NewNullableColumnOf creates column with validity of each value,
+// values at invalid positions are ignored.
+func NewNullableColumnOf[T ScalarType](name string, values []T, validData []bool) (*ColumnOf[T], error) {
+	return newNullableColumnOf(name, scalarFieldType[T](), values, validData)
+}
+
+// ColumnAs converts column into typed column, error returned when column does not hold T values.
+func ColumnAs[T ScalarType](column Column) (*ColumnOf[T], error) {
+	if column == nil {
+		return nil, errors.New("column is nil")
+	}
+	typed, ok := column.(interface{ columnOf() *ColumnOf[T] })
+	if !ok {
+		var r T
+		return nil, fmt.Errorf("column %s is %s, not %T column", column.Name(), column.Type().Name(), r)
+	}
+	return typed.columnOf(), nil
+}
+
+func newColumnOf[T ScalarType](name string, fieldType FieldType, values []T) *ColumnOf[T] {
+	return &ColumnOf[T]{
+		name:      name,
+		fieldType: fieldType,
+		values:    values,
+	}
+}
+
+func newNullableColumnOf[T ScalarType](name string, fieldType FieldType, values []T, validData []bool) (*ColumnOf[T], error) {
+	if len(values) != len(validData) {
+		return nil, fmt.Errorf("values length(%d) not match valid data length(%d)", len(values), len(validData))
+	}
+	column := newColumnOf(name, fieldType, values)
+	column.validData = validData
+	return column, nil
+}
+
+// scalarFieldType returns the default field type of go type T.
+func scalarFieldType[T ScalarType]() FieldType {
+	var r T
+	switch any(r).(type) {
+	case bool:
+		return FieldTypeBool
+	case int8:
+		return FieldTypeInt8
+	case int16:
+		return FieldTypeInt16
+	case int32:
+		return FieldTypeInt32
+	case int64:
+		return FieldTypeInt64
+	case float32:
+		return FieldTypeFloat
+	case float64:
+		return FieldTypeDouble
+	default:
+		return FieldTypeVarChar
+	}
+}
+
+// columnOf returns the generic column, which is embedded by distinct column types like ColumnString.
+func (c *ColumnOf[T]) columnOf() *ColumnOf[T] {
+	return c
+}
+
+// Name returns column name
+func (c *ColumnOf[T]) Name() string {
+	return c.name
+}
+
+// Type returns column FieldType
+func (c *ColumnOf[T]) Type() FieldType {
+	if c.fieldType == FieldTypeNone {
+		return scalarFieldType[T]()
+	}
+	return c.fieldType
+}
+
+// Len returns column values length
+func (c *ColumnOf[T]) Len() int {
+	return len(c.values)
+}
+
+// Get returns value at index as interface{}, nil for null value.
+func (c *ColumnOf[T]) Get(idx int) (interface{}, error) {
+	var r T // use default value
+	if idx < 0 || idx >= c.Len() {
+		return r, errors.New("index out of range")
+	}
+	if c.isNull(idx) {
+		return nil, nil
+	}
+	return c.values[idx], nil
+}
+
+// IsNull returns whether value at index is null
+func (c *ColumnOf[T]) IsNull(idx int) (bool, error) {
+	if idx < 0 || idx >= c.Len() {
+		return false, errors.New("index out of range")
+	}
+	return c.isNull(idx), nil
+}
+
+func (c *ColumnOf[T]) isNull(idx int) bool {
+	return c.validData != nil && !c.validData[idx]
+}
+
+// ValidData returns the validity of each value, nil if column carries no null value
+func (c *ColumnOf[T]) ValidData() []bool {
+	return c.validData
+}
+
+// ValueByIdx returns value of the provided index
+// error occurs when index out of range, ErrNullValue returned when value is null
+func (c *ColumnOf[T]) ValueByIdx(idx int) (T, error) {
+	var r T // use default value
+	if idx < 0 || idx >= c.Len() {
+		return r, errors.New("index out of range")
+	}
+	if c.isNull(idx) {
+		return r, ErrNullValue
+	}
+	return c.values[idx], nil
+}
+
+// Value returns value at index, zero value for null.
+// It panics when index out of range, as indexing a slice does.
+func (c *ColumnOf[T]) Value(idx int) T {
+	return c.values[idx]
+}
+
+// AppendValue append value into column, nil is appended as null
+func (c *ColumnOf[T]) AppendValue(i interface{}) error {
+	if i == nil {
+		c.AppendNull()
+		return nil
+	}
+	v, ok := i.(T)
+	if !ok {
+		var r T
+		return fmt.Errorf("invalid type, expected %T, got %T", r, i)
+	}
+	c.Append(v)
+	return nil
+}
+
+// Append appends typed value into column
+func (c *ColumnOf[T]) Append(v T) {
+	c.values = append(c.values, v)
+	c.validData = appendValidData(c.validData, len(c.values)-1, true)
+}
+
+// AppendNull append null value into column
+func (c *ColumnOf[T]) AppendNull() {
+	var r T
+	c.values = append(c.values, r)
+	c.validData = appendValidData(c.validData, len(c.values)-1, false)
+}
+
+// Data returns column data, the slice shares memory with column
+func (c *ColumnOf[T]) Data() []T {
+	return c.values
+}
+
+// Slice returns column of values in [begin, end), which shares memory with c.
+// It panics when range out of bound, as slicing does.
+func (c *ColumnOf[T]) Slice(begin, end int) Column {
+	column := newColumnOf(c.name, c.fieldType, c.values[begin:end:end])
+	if c.validData != nil {
+		column.validData = c.validData[begin:end:end]
+	}
+	return column
+}
+
+// Take returns column of values at indices, which could be in any order and duplicated.
+func (c *ColumnOf[T]) Take(indices []int) (Column, error) {
+	values, err := takeValues(c.values, indices)
+	if err != nil {
+		return nil, err
+	}
+	column := newColumnOf(c.name, c.fieldType, values)
+	column.validData = takeValidData(c.validData, indices)
+	return column, nil
+}
+
+// Filter returns column of values which keep returns true.
+func (c *ColumnOf[T]) Filter(keep func(idx int) bool) Column {
+	column, _ := c.Take(filterIndices(c.Len(), keep))
+	return column
+}
+
+// Concat returns column of values in c followed by values in other.
+func (c *ColumnOf[T]) Concat(other Column) (Column, error) {
+	o, ok := other.(*ColumnOf[T])
+	if !ok || o.Type() != c.Type() {
+		return nil, errConcatNotMatch(c, other)
+	}
+	column := newColumnOf(c.name, c.fieldType, concatValues(c.values, o.values))
+	column.validData = concatValidData(c.validData, c.Len(), o.validData, o.Len())
+	return column, nil
+}
+
+// Range calls f for each value in column sequentially, zero value passed for null.
+// Range stops the iteration if f returns false.
+func (c *ColumnOf[T]) Range(f func(idx int, v T) bool) {
+	for idx, v := range c.values {
+		if !f(idx, v) {
+			return
+		}
+	}
+}
+
+// FieldData return column data mapped to schema.FieldData
+func (c *ColumnOf[T]) FieldData() *schema.FieldData {
+	fd := &schema.FieldData{
+		Type:      schema.DataType(c.Type()),
+		FieldName: c.name,
+	}
+	values := make([]T, 0, c.Len())
+	for i, v := range c.values {
+		// null values are omitted, server fills them with null or default value
+		if c.isNull(i) {
+			continue
+		}
+		values = append(values, v)
+	}
+
+	scalars := &schema.ScalarField{}
+	switch data := any(values).(type) {
+	case []bool:
+		scalars.Data = &schema.ScalarField_BoolData{BoolData: &schema.BoolArray{Data: data}}
+	case []int8:
+		scalars.Data = &schema.ScalarField_IntData{IntData: &schema.IntArray{Data: toInt32s(data)}}
+	case []int16:
+		scalars.Data = &schema.ScalarField_IntData{IntData: &schema.IntArray{Data: toInt32s(data)}}
+	case []int32:
+		scalars.Data = &schema.ScalarField_IntData{IntData: &schema.IntArray{Data: data}}
+	case []int64:
+		scalars.Data = &schema.ScalarField_LongData{LongData: &schema.LongArray{Data: data}}
+	case []float32:
+		scalars.Data = &schema.ScalarField_FloatData{FloatData: &schema.FloatArray{Data: data}}
+	case []float64:
+		scalars.Data = &schema.ScalarField_DoubleData{DoubleData: &schema.DoubleArray{Data: data}}
+	case []string:
+		scalars.Data = &schema.ScalarField_StringData{StringData: &schema.StringArray{Data: data}}
+	}
+	fd.Field = &schema.FieldData_Scalars{Scalars: scalars}
+	fd.ValidData = c.validData
+	return fd
+}
+
+func toInt32s[T int8 | int16](values []T) []int32 {
+	result := make([]int32, 0, len(values))
+	for _, v := range values {
+		result = append(result, int32(v))
+	}
+	return result
+}
@@ -0,0 +1,162 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type ColumnOfSuite struct {
+	suite.Suite
+}
+
+func (s *ColumnOfSuite) TestFieldType() {
+	s.Equal(FieldTypeBool, NewColumnOf("bool", []bool{true}).Type())
+	s.Equal(FieldTypeInt8, NewColumnOf("int8", []int8{1}).Type())
+	s.Equal(FieldTypeInt16, NewColumnOf("int16", []int16{1}).Type())
+	s.Equal(FieldTypeInt32, NewColumnOf("int32", []int32{1}).Type())
+	s.Equal(FieldTypeInt64, NewColumnOf("int64", []int64{1}).Type())
+	s.Equal(FieldTypeFloat, NewColumnOf("float", []float32{1}).Type())
+	s.Equal(FieldTypeDouble, NewColumnOf("double", []float64{1}).Type())
+	s.Equal(FieldTypeVarChar, NewColumnOf("varchar", []string{"a"}).Type())
+	s.Equal(FieldTypeString, NewColumnString("string", []string{"a"}).Type())
+	s.Equal(FieldTypeInt64, (&ColumnInt64{}).Type())
+
+	// constructors are aliases of generic column
+	var column Column = NewColumnInt64("int64", []int64{1})
+	_, ok := column.(*ColumnOf[int64])
+	s.True(ok)
+}
+
+func (s *ColumnOfSuite) TestTypedAccess() {
+	column := NewColumnOf("int64", []int64{1, 2, 3})
+	s.EqualValues(2, column.Value(1))
+	s.Panics(func() { column.Value(3) })
+
+	column.Append(4)
+	s.Equal([]int64{1, 2, 3, 4}, column.Data())
+	s.Nil(column.ValidData())
+
+	var sum int64
+	column.Range(func(_ int, v int64) bool {
+		sum += v
+		return v < 3
+	})
+	s.EqualValues(6, sum)
+
+	// Data shares memory with column
+	column.Data()[0] = 10
+	s.EqualValues(10, column.Value(0))
+}
+
+func (s *ColumnOfSuite) TestSlice() {
+	column, err := NewNullableColumnOf("varchar", []string{"a", "", "c", "d"}, []bool{true, false, true, true})
+	s.Require().NoError(err)
+
+	sliced, err := ColumnAs[string](column.Slice(1, 3))
+	s.Require().NoError(err)
+	s.Equal("varchar", sliced.Name())
+	s.Equal(FieldTypeVarChar, sliced.Type())
+	s.Equal([]string{"", "c"}, sliced.Data())
+	s.Equal([]bool{false, true}, sliced.ValidData())
+
+	// appending to sliced column does not overwrite origin
+	sliced.Append("x")
+	s.Equal("d", column.Value(3))
+	s.True(column.ValidData()[3])
+
+	s.Panics(func() { column.Slice(2, 5) })
+
+	_, err = NewNullableColumnOf("varchar", []string{"a"}, nil)
+	s.Error(err)
+}
+
+func (s *ColumnOfSuite) TestConversion() {
+	i8 := NewColumnInt8("int8", []int8{1})
+	v, err := i8.GetAsInt64(0)
+	s.NoError(err)
+	s.EqualValues(1, v)
+	_, err = i8.GetAsDouble(0)
+	s.Error(err)
+	_, err = i8.GetAsString(0)
+	s.Error(err)
+	_, err = i8.GetAsBool(0)
+	s.Error(err)
+
+	f := NewColumnFloat("float", []float32{0.5})
+	d, err := f.GetAsDouble(0)
+	s.NoError(err)
+	s.Equal(0.5, d)
+	_, err = f.GetAsInt64(0)
+	s.Error(err)
+
+	str, err := NewColumnVarChar("varchar", []string{"a"}).GetAsString(0)
+	s.NoError(err)
+	s.Equal("a", str)
+
+	b, err := NewColumnBool("bool", []bool{true}).GetAsBool(0)
+	s.NoError(err)
+	s.True(b)
+
+	_, err = i8.GetAsInt64(1)
+	s.Error(err)
+}
+
+func (s *ColumnOfSuite) TestColumnAs() {
+	typed, err := ColumnAs[int64](NewColumnInt64("int64", []int64{1}))
+	s.NoError(err)
+	s.Equal([]int64{1}, typed.Data())
+
+	_, err = ColumnAs[int32](NewColumnInt64("int64", []int64{1}))
+	s.Error(err)
+
+	_, err = ColumnAs[int64](NewColumnJSONBytes("json", nil))
+	s.Error(err)
+
+	_, err = ColumnAs[int64](nil)
+	s.Error(err)
+
+	typed2, err := ColumnAs[string](NewColumnString("string", []string{"a"}))
+	s.NoError(err)
+	s.Equal([]string{"a"}, typed2.Data())
+}
+
+func (s *ColumnOfSuite) TestColumnString() {
+	// ColumnString and ColumnVarChar are distinct types
+	kind := func(column Column) string {
+		switch column.(type) {
+		case *ColumnVarChar:
+			return "varchar"
+		case *ColumnString:
+			return "string"
+		}
+		return ""
+	}
+	s.Equal("varchar", kind(NewColumnVarChar("varchar", []string{"a"})))
+	s.Equal("string", kind(NewColumnString("string", []string{"a"})))
+	_, ok := Column(NewColumnVarChar("varchar", nil)).(*ColumnString)
+	s.False(ok)
+
+	column, err := NewNullableColumnString("string", []string{"a", "", "c"}, []bool{true, false, true})
+	s.Require().NoError(err)
+	s.Equal("string", kind(column.Slice(1, 3)))
+	filtered := column.Filter(func(idx int) bool { return idx != 0 })
+	s.Equal("string", kind(filtered))
+	s.Equal([]bool{false, true}, filtered.(*ColumnString).ValidData())
+
+	concat, err := column.Concat(NewColumnString("string", []string{"d"}))
+	s.Require().NoError(err)
+	s.Equal("string", kind(concat))
+	s.Equal([]string{"a", "", "c", "d"}, concat.(*ColumnString).Data())
+	_, err = column.Concat(NewColumnVarChar("string", []string{"d"}))
+	s.Error(err)
+
+	column.Append("e")
+	s.Equal(4, column.Len())
+	fd := column.FieldData()
+	s.Equal([]string{"a", "c", "e"}, fd.GetScalars().GetStringData().GetData())
+}
+
+func TestColumnOf(t *testing.T) {
+	suite.Run(t, new(ColumnOfSuite))
+}
@@ -1,13 +1,28 @@
 package entity
 
 import (
+	"encoding/json"
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/cockroachdb/errors"
 	schema "github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/tidwall/gjson"
 )
 
 var _ (Column) = (*ColumnJSONBytes)(nil)
+var _ (JSONColumn) = (*ColumnJSONBytes)(nil)
+
+// ErrJSONPathNotExist returned when the json document does not contain the path.
+var ErrJSONPathNotExist = errors.New("json path not exist")
+
+// JSONColumn is the column whose values are json documents.
+type JSONColumn interface {
+	Column
+	GetByPath(idx int, path string) (interface{}, error)
+	Unmarshal(idx int, v interface{}) error
+}
 
 // ColumnJSONBytes column type for JSON.
 // all items are marshaled json bytes.
@@ -78,6 +93,26 @@ func (c *ColumnJSONBytes) ValueByIdx(idx int) ([]byte, error) {
 	return c.values[idx], nil
 }
 
+// GetByPath returns the value at path of json document at idx,
+// path is dot separated keys with optional array index, for example `a.b[2].c` or `a["b.c"]`.
+// values are typed as string, bool, int64, float64, nil for null, []interface{} or map[string]interface{}.
+func (c *ColumnJSONBytes) GetByPath(idx int, path string) (interface{}, error) {
+	bs, err := c.ValueByIdx(idx)
+	if err != nil {
+		return nil, err
+	}
+	return getJSONPath(bs, path)
+}
+
+// Unmarshal decodes json document at idx into v.
+func (c *ColumnJSONBytes) Unmarshal(idx int, v interface{}) error {
+	bs, err := c.ValueByIdx(idx)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(bs, v)
+}
+
 // AppendValue append value into column.
 func (c *ColumnJSONBytes) AppendValue(i interface{}) error {
 	v, ok := i.([]byte)
@@ -89,6 +124,39 @@ func (c *ColumnJSONBytes) AppendValue(i interface{}) error {
 	return nil
 }
 
+// Slice returns column of values in [begin, end), which shares memory with c.
+func (c *ColumnJSONBytes) Slice(begin, end int) Column {
+	return c.withValues(c.values[begin:end:end])
+}
+
+// Take returns column of values at indices.
+func (c *ColumnJSONBytes) Take(indices []int) (Column, error) {
+	values, err := takeValues(c.values, indices)
+	if err != nil {
+		return nil, err
+	}
+	return c.withValues(values), nil
+}
+
+// Filter returns column of values which keep returns true.
+func (c *ColumnJSONBytes) Filter(keep func(idx int) bool) Column {
+	return c.withValues(filterValues(c.values, keep))
+}
+
+// Concat returns column of values in c followed by values in other.
+func (c *ColumnJSONBytes) Concat(other Column) (Column, error) {
+	o, ok := other.(*ColumnJSONBytes)
+	if !ok {
+		return nil, errConcatNotMatch(c, other)
+	}
+	return c.withValues(concatValues(c.values, o.values)), nil
+}
+
+// withValues returns column with same attributes and provided values.
+func (c *ColumnJSONBytes) withValues(values [][]byte) *ColumnJSONBytes {
+	return NewColumnJSONBytes(c.name, values).WithIsDynamic(c.isDynamic)
+}
+
 // Data returns column data.
 func (c *ColumnJSONBytes) Data() [][]byte {
 	return c.values
@@ -106,3 +174,144 @@ func NewColumnJSONBytes(name string, values [][]byte) *ColumnJSONBytes {
 		values: values,
 	}
 }
+
+// NewColumnJSONFromValues composes a json Column by marshaling each value, such as struct or map.
+func NewColumnJSONFromValues[T any](name string, values []T) (*ColumnJSONBytes, error) {
+	data := make([][]byte, 0, len(values))
+	for i, v := range values {
+		bs, err := json.Marshal(v)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to marshal value at %d", i)
+		}
+		data = append(data, bs)
+	}
+	return NewColumnJSONBytes(name, data), nil
+}
+
+// UnmarshalJSONColumn decodes all json documents in column into T.
+func UnmarshalJSONColumn[T any](column JSONColumn) ([]T, error) {
+	result := make([]T, column.Len())
+	for i := range result {
+		if err := column.Unmarshal(i, &result[i]); err != nil {
+			return nil, errors.Wrapf(err, "failed to unmarshal value at %d", i)
+		}
+	}
+	return result, nil
+}
+
+// getJSONPath returns typed value of the path in json document.
+func getJSONPath(bs []byte, path string) (interface{}, error) {
+	gpath, err := toGJSONPath(path)
+	if err != nil {
+		return nil, err
+	}
+	r := gjson.GetBytes(bs, gpath)
+	if !r.Exists() {
+		return nil, errors.Wrap(ErrJSONPathNotExist, path)
+	}
+	return jsonValue(r), nil
+}
+
+// toGJSONPath converts `a.b[2]["c.d"]` style path into gjson path.
+func toGJSONPath(path string) (string, error) {
+	var components []string
+	i := 0
+	expectKey := true // at beginning or after '.'
+	for i < len(path) {
+		switch path[i] {
+		case '[':
+			end := strings.IndexByte(path[i:], ']')
+			if end < 0 {
+				return "", fmt.Errorf("invalid json path %s: unclosed bracket", path)
+			}
+			inner := path[i+1 : i+end]
+			if len(inner) >= 2 && inner[0] == '"' && inner[len(inner)-1] == '"' {
+				key, err := strconv.Unquote(inner)
+				if err != nil {
+					return "", fmt.Errorf("invalid json path %s: %w", path, err)
+				}
+				components = append(components, key)
+			} else {
+				if _, err := strconv.ParseUint(inner, 10, 64); err != nil {
+					return "", fmt.Errorf("invalid json path %s: bad array index %q", path, inner)
+				}
+				components = append(components, inner)
+			}
+			i += end + 1
+			expectKey = false
+		case '.':
+			if expectKey {
+				return "", fmt.Errorf("invalid json path %s: empty key", path)
+			}
+			i++
+			expectKey = true
+		default:
+			if !expectKey {
+				return "", fmt.Errorf("invalid json path %s: unexpected character at %d", path, i)
+			}
+			end := strings.IndexAny(path[i:], ".[")
+			if end < 0 {
+				end = len(path) - i
+			}
+			components = append(components, path[i:i+end])
+			i += end
+			expectKey = false
+		}
+	}
+	if expectKey {
+		return "", fmt.Errorf("invalid json path %s: empty key", path)
+	}
+
+	escaped := make([]string, 0, len(components))
+	for _, component := range components {
+		escaped = append(escaped, escapeGJSONComponent(component))
+	}
+	return strings.Join(escaped, "."), nil
+}
+
+// escapeGJSONComponent escapes the characters with special meaning in gjson path.
+func escapeGJSONComponent(component string) string {
+	var sb strings.Builder
+	for i := 0; i < len(component); i++ {
+		c := component[i]
+		if !(c <= ' ' || c > '~' || c == '_' || c == '-' || c == ':' ||
+			(c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')) {
+			sb.WriteByte('\\')
+		}
+		sb.WriteByte(c)
+	}
+	return sb.String()
+}
+
+// jsonValue converts gjson result into go value, integral numbers are kept as int64.
+func jsonValue(r gjson.Result) interface{} {
+	switch r.Type {
+	case gjson.Null:
+		return nil
+	case gjson.False, gjson.True:
+		return r.Bool()
+	case gjson.String:
+		return r.String()
+	case gjson.Number:
+		if !strings.ContainsAny(r.Raw, ".eE") {
+			if v, err := strconv.ParseInt(r.Raw, 10, 64); err == nil {
+				return v
+			}
+		}
+		return r.Float()
+	default:
+		if r.IsArray() {
+			values := make([]interface{}, 0)
+			for _, item := range r.Array() {
+				values = append(values, jsonValue(item))
+			}
+			return values
+		}
+		values := make(map[string]interface{})
+		r.ForEach(func(key, value gjson.Result) bool {
+			values[key.String()] = jsonValue(value)
+			return true
+		})
+		return values
+	}
+}
@@ -72,6 +72,82 @@ func (s *ColumnJSONBytesSuite) TestAttrMethods() {
 	})
 }
 
+func (s *ColumnJSONBytesSuite) TestGetByPath() {
+	column := NewColumnJSONBytes("json", [][]byte{
+		[]byte(`{"a": {"b": [1, 2, {"c": "value", "d": 1.5}]}, "e.f": true, "g": null, "h": [[0, 4418489049307132905]]}`),
+	})
+
+	cases := []struct {
+		path      string
+		expectErr bool
+		expect    interface{}
+	}{
+		{"a.b[2].c", false, "value"},
+		{"a.b[2].d", false, 1.5},
+		{"a.b[0]", false, int64(1)},
+		{"a.b", false, []interface{}{int64(1), int64(2), map[string]interface{}{"c": "value", "d": 1.5}}},
+		{`["e.f"]`, false, true},
+		{"g", false, nil},
+		{"h[0][1]", false, int64(4418489049307132905)},
+		{"a.x", true, nil},
+		{"a.b[3]", true, nil},
+		{"a..b", true, nil},
+		{"a.", true, nil},
+		{"a.b[x]", true, nil},
+		{"a.b[0", true, nil},
+		{"a.b[0]c", true, nil},
+	}
+	for _, c := range cases {
+		s.Run(c.path, func() {
+			v, err := column.GetByPath(0, c.path)
+			if c.expectErr {
+				s.Error(err)
+				return
+			}
+			s.NoError(err)
+			s.Equal(c.expect, v)
+		})
+	}
+
+	_, err := column.GetByPath(1, "a")
+	s.Error(err)
+	_, err = column.GetByPath(0, "a.x")
+	s.ErrorIs(err, ErrJSONPathNotExist)
+}
+
+func (s *ColumnJSONBytesSuite) TestValuesAndUnmarshal() {
+	type meta struct {
+		Source string   `json:"source"`
+		Tags   []string `json:"tags"`
+	}
+	values := []meta{
+		{Source: "web", Tags: []string{"a", "b"}},
+		{Source: "mobile"},
+	}
+	column, err := NewColumnJSONFromValues("meta", values)
+	s.Require().NoError(err)
+	s.Equal(2, column.Len())
+
+	var m meta
+	s.NoError(column.Unmarshal(0, &m))
+	s.Equal(values[0], m)
+	s.Error(column.Unmarshal(2, &m))
+
+	v, err := column.GetByPath(0, "tags[1]")
+	s.NoError(err)
+	s.Equal("b", v)
+
+	result, err := UnmarshalJSONColumn[meta](column)
+	s.NoError(err)
+	s.Equal(values, result)
+
+	_, err = UnmarshalJSONColumn[int](column)
+	s.Error(err)
+
+	_, err = NewColumnJSONFromValues("bad", []interface{}{make(chan int)})
+	s.Error(err)
+}
+
 func TestColumnJSONBytes(t *testing.T) {
 	suite.Run(t, new(ColumnJSONBytesSuite))
 }
@@ -0,0 +1,125 @@
+package entity
+
+import (
+	"fmt"
+
+	"github.com/cockroachdb/errors"
+	schema "github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+)
+
+// ErrNullValue returned when reading null value with typed accessor.
+var ErrNullValue = errors.New("value is null")
+
+// NullableColumn is the column which could carry null values.
+type NullableColumn interface {
+	Column
+	IsNull(idx int) (bool, error)
+	ValidData() []bool
+	AppendNull()
+}
+
+// appendValidData appends validity of the value at idx,
+// validData stays nil until the first null value appended.
+func appendValidData(validData []bool, idx int, valid bool) []bool {
+	if validData == nil {
+		if valid {
+			return nil
+		}
+		validData = make([]bool, idx, idx+1)
+		for i := range validData {
+			validData[i] = true
+		}
+	}
+	return append(validData, valid)
+}
+
+// nullableFieldDataColumn converts field data with validity to Column in [begin, end).
+// the values in field data could be either dense, with placeholder at null positions,
+// or compacted, which contains valid values only.
+func nullableFieldDataColumn(fd *schema.FieldData, validData []bool, begin, end int) (Column, error) {
+	// parse values without validity
+	valueData := &schema.FieldData{
+		Type:      fd.GetType(),
+		FieldName: fd.GetFieldName(),
+		FieldId:   fd.GetFieldId(),
+		Field:     fd.GetField(),
+		IsDynamic: fd.GetIsDynamic(),
+	}
+	values, err := FieldDataColumn(valueData, 0, -1)
+	if err != nil {
+		return nil, err
+	}
+	result, err := newNullableColumn(FieldType(fd.GetType()), fd.GetFieldName())
+	if err != nil {
+		return nil, err
+	}
+
+	validCount := 0
+	for _, valid := range validData {
+		if valid {
+			validCount++
+		}
+	}
+	dense := values.Len() == len(validData)
+	if !dense && values.Len() != validCount {
+		return nil, fmt.Errorf("field data length(%d) not match valid data length(%d)", values.Len(), len(validData))
+	}
+	if end < 0 {
+		end = len(validData)
+	}
+	if begin < 0 || begin > end || end > len(validData) {
+		return nil, errors.New("index out of range")
+	}
+
+	offset := 0 // index of next valid value in compacted values
+	for i := 0; i < end; i++ {
+		idx := i
+		if !dense {
+			idx = offset
+		}
+		if validData[i] {
+			offset++
+		}
+		if i < begin {
+			continue
+		}
+		if !validData[i] {
+			result.AppendNull()
+			continue
+		}
+		v, err := values.Get(idx)
+		if err != nil {
+			return nil, err
+		}
+		if err := result.AppendValue(v); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// newNullableColumn creates empty column of field type which could carry null values.
+func newNullableColumn(fieldType FieldType, name string) (NullableColumn, error) {
+	switch fieldType {
+	case FieldTypeBool:
+		return NewColumnBool(name, nil), nil
+	case FieldTypeInt8:
+		return NewColumnInt8(name, nil), nil
+	case FieldTypeInt16:
+		return NewColumnInt16(name, nil), nil
+	case FieldTypeInt32:
+		return NewColumnInt32(name, nil), nil
+	case FieldTypeInt64:
+		return NewColumnInt64(name, nil), nil
+	case FieldTypeFloat:
+		return NewColumnFloat(name, nil), nil
+	case FieldTypeDouble:
+		return NewColumnDouble(name, nil), nil
+	case FieldTypeString:
+		return NewColumnString(name, nil), nil
+	case FieldTypeVarChar:
+		return NewColumnVarChar(name, nil), nil
+	default:
+		return nil, fmt.Errorf("field type %s does not support null value", fieldType.Name())
+	}
+}
@@ -0,0 +1,116 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	schema "github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNullableColumnAppend(t *testing.T) {
+	column := NewColumnInt64("int64", []int64{1, 2})
+	assert.Nil(t, column.ValidData())
+
+	require.NoError(t, column.AppendValue(nil))
+	column.AppendNull()
+	require.NoError(t, column.AppendValue(int64(5)))
+	assert.Equal(t, 5, column.Len())
+	assert.Equal(t, []bool{true, true, false, false, true}, column.ValidData())
+
+	v, err := column.Get(2)
+	assert.NoError(t, err)
+	assert.Nil(t, v)
+	_, err = column.ValueByIdx(3)
+	assert.ErrorIs(t, err, ErrNullValue)
+	isNull, err := column.IsNull(4)
+	assert.NoError(t, err)
+	assert.False(t, isNull)
+	_, err = column.IsNull(5)
+	assert.Error(t, err)
+}
+
+func TestNullableFieldDataRoundTrip(t *testing.T) {
+	validData := []bool{true, false, true, true, false}
+
+	t.Run("int64", func(t *testing.T) {
+		column, err := NewNullableColumnInt64("int64", []int64{1, 0, 3, 4, 0}, validData)
+		require.NoError(t, err)
+
+		fd := column.FieldData()
+		// null values are omitted
+		assert.Equal(t, []int64{1, 3, 4}, fd.GetScalars().GetLongData().GetData())
+
+		// validity shall survive wire marshal & unmarshal
+		bs, err := proto.Marshal(fd)
+		require.NoError(t, err)
+		result := &schema.FieldData{}
+		require.NoError(t, proto.Unmarshal(bs, result))
+		assert.Equal(t, validData, result.GetValidData())
+
+		parsed, err := FieldDataColumn(result, 0, -1)
+		require.NoError(t, err)
+		c, ok := parsed.(*ColumnInt64)
+		require.True(t, ok)
+		assert.Equal(t, validData, c.ValidData())
+		assert.Equal(t, []int64{1, 0, 3, 4, 0}, c.Data())
+
+		parsed, err = FieldDataColumn(result, 2, 4)
+		require.NoError(t, err)
+		assert.Equal(t, []int64{3, 4}, parsed.(*ColumnInt64).Data())
+		assert.Nil(t, parsed.(*ColumnInt64).ValidData())
+
+		_, err = FieldDataColumn(result, 0, 6)
+		assert.Error(t, err)
+	})
+
+	t.Run("varchar", func(t *testing.T) {
+		column, err := NewNullableColumnVarChar("varchar", []string{"a", "", "c", "d", ""}, validData)
+		require.NoError(t, err)
+
+		fd := column.FieldData()
+		assert.Equal(t, []string{"a", "c", "d"}, fd.GetScalars().GetStringData().GetData())
+
+		parsed, err := FieldDataColumn(fd, 1, -1)
+		require.NoError(t, err)
+		c, ok := parsed.(*ColumnVarChar)
+		require.True(t, ok)
+		assert.Equal(t, []bool{false, true, true, false}, c.ValidData())
+		assert.Equal(t, []string{"", "c", "d", ""}, c.Data())
+	})
+
+	t.Run("dense_data", func(t *testing.T) {
+		fd := &schema.FieldData{
+			Type:      schema.DataType_Double,
+			FieldName: "double",
+			Field: &schema.FieldData_Scalars{
+				Scalars: &schema.ScalarField{
+					Data: &schema.ScalarField_DoubleData{
+						DoubleData: &schema.DoubleArray{Data: []float64{1, 0, 3, 4, 0}},
+					},
+				},
+			},
+		}
+		fd.ValidData = validData
+
+		parsed, err := FieldDataColumn(fd, 0, -1)
+		require.NoError(t, err)
+		assert.Equal(t, []float64{1, 0, 3, 4, 0}, parsed.(*ColumnDouble).Data())
+		assert.Equal(t, validData, parsed.(*ColumnDouble).ValidData())
+	})
+
+	t.Run("length_not_match", func(t *testing.T) {
+		fd := NewColumnInt64("int64", []int64{1, 2}).FieldData()
+		fd.ValidData = validData
+		_, err := FieldDataColumn(fd, 0, -1)
+		assert.Error(t, err)
+	})
+
+	t.Run("not_supported_type", func(t *testing.T) {
+		fd := NewColumnJSONBytes("json", [][]byte{[]byte(`{}`)}).FieldData()
+		fd.ValidData = []bool{true}
+		_, err := FieldDataColumn(fd, 0, -1)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,88 @@
+package entity
+
+import (
+	"fmt"
+)
+
+// takeValues returns values at indices, which could be in any order and duplicated.
+func takeValues[T any](values []T, indices []int) ([]T, error) {
+	result := make([]T, 0, len(indices))
+	for _, idx := range indices {
+		if idx < 0 || idx >= len(values) {
+			return nil, fmt.Errorf("index %d out of range [0, %d)", idx, len(values))
+		}
+		result = append(result, values[idx])
+	}
+	return result, nil
+}
+
+// filterIndices returns the indices in [0, n) which keep returns true.
+func filterIndices(n int, keep func(idx int) bool) []int {
+	indices := make([]int, 0, n)
+	for i := 0; i < n; i++ {
+		if keep(i) {
+			indices = append(indices, i)
+		}
+	}
+	return indices
+}
+
+// filterValues returns values which keep returns true.
+func filterValues[T any](values []T, keep func(idx int) bool) []T {
+	result := make([]T, 0, len(values))
+	for i, v := range values {
+		if keep(i) {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+// concatValues returns a new slice of a followed by b.
+func concatValues[T any](a, b []T) []T {
+	result := make([]T, 0, len(a)+len(b))
+	result = append(result, a...)
+	return append(result, b...)
+}
+
+// takeValidData returns validity at indices, nil stays nil since all values are valid.
+func takeValidData(validData []bool, indices []int) []bool {
+	if validData == nil {
+		return nil
+	}
+	// indices are checked by values already
+	result := make([]bool, 0, len(indices))
+	for _, idx := range indices {
+		result = append(result, validData[idx])
+	}
+	return result
+}
+
+// concatValidData returns validity of a followed by b, nil validity of either side means all valid.
+func concatValidData(a []bool, aLen int, b []bool, bLen int) []bool {
+	if a == nil && b == nil {
+		return nil
+	}
+	result := make([]bool, 0, aLen+bLen)
+	for _, validData := range []struct {
+		data []bool
+		n    int
+	}{{a, aLen}, {b, bLen}} {
+		if validData.data != nil {
+			result = append(result, validData.data...)
+			continue
+		}
+		for i := 0; i < validData.n; i++ {
+			result = append(result, true)
+		}
+	}
+	return result
+}
+
+// errConcatNotMatch returns error for concatenating columns of different types.
+func errConcatNotMatch(c Column, other Column) error {
+	if other == nil {
+		return fmt.Errorf("cannot concat column %s with nil column", c.Name())
+	}
+	return fmt.Errorf("cannot concat column %s of %s with column %s of %s", c.Name(), c.Type().Name(), other.Name(), other.Type().Name())
+}
@@ -0,0 +1,108 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func columnValues(t *testing.T, column Column) []interface{} {
+	values := make([]interface{}, 0, column.Len())
+	for i := 0; i < column.Len(); i++ {
+		v, err := column.Get(i)
+		require.NoError(t, err)
+		values = append(values, v)
+	}
+	return values
+}
+
+func TestColumnOperations(t *testing.T) {
+	sparse := func(position uint32) SparseFloatVector {
+		v, err := NewSparseFloatVector([]uint32{position}, []float32{0.5})
+		require.NoError(t, err)
+		return v
+	}
+	nullable, err := NewNullableColumnInt64("nullable", []int64{1, 0, 3, 4}, []bool{true, false, true, true})
+	require.NoError(t, err)
+
+	columns := []SliceableColumn{
+		NewColumnInt64("int64", []int64{1, 2, 3, 4}),
+		nullable,
+		NewColumnVarChar("varchar", []string{"a", "b", "c", "d"}),
+		NewColumnFloatVector("float_vector", 2, [][]float32{{1, 1}, {2, 2}, {3, 3}, {4, 4}}),
+		NewColumnBinaryVector("binary_vector", 8, [][]byte{{1}, {2}, {3}, {4}}),
+		NewColumnFloat16Vector("fp16_vector", 1, [][]byte{{1, 0}, {2, 0}, {3, 0}, {4, 0}}),
+		NewColumnBFloat16Vector("bf16_vector", 1, [][]byte{{1, 0}, {2, 0}, {3, 0}, {4, 0}}),
+		NewColumnSparseFloatVector("sparse", []SparseFloatVector{sparse(1), sparse(2), sparse(3), sparse(4)}),
+		NewColumnInt32Array("array", [][]int32{{1}, {2, 2}, {3}, {}}),
+		NewColumnJSONBytes("json", [][]byte{[]byte(`1`), []byte(`2`), []byte(`3`), []byte(`4`)}).WithIsDynamic(true),
+		NewColumnDynamic(NewColumnJSONBytes("$meta", [][]byte{
+			[]byte(`{"a": 1}`), []byte(`{"a": 2}`), []byte(`{"a": 3}`), []byte(`{"a": 4}`),
+		}), "a"),
+	}
+
+	for _, column := range columns {
+		t.Run(column.Name(), func(t *testing.T) {
+			values := columnValues(t, column)
+
+			sliced := column.Slice(1, 3)
+			assert.Equal(t, column.Name(), sliced.Name())
+			assert.Equal(t, column.Type(), sliced.Type())
+			assert.Equal(t, values[1:3], columnValues(t, sliced))
+			assert.Panics(t, func() { column.Slice(3, 5) })
+
+			taken, err := column.Take([]int{3, 0, 0})
+			require.NoError(t, err)
+			assert.Equal(t, column.Name(), taken.Name())
+			assert.Equal(t, []interface{}{values[3], values[0], values[0]}, columnValues(t, taken))
+			_, err = column.Take([]int{4})
+			assert.Error(t, err)
+			_, err = column.Take([]int{-1})
+			assert.Error(t, err)
+
+			filtered := column.Filter(func(idx int) bool { return idx%2 == 1 })
+			assert.Equal(t, []interface{}{values[1], values[3]}, columnValues(t, filtered))
+
+			concated, err := column.Concat(sliced)
+			require.NoError(t, err)
+			assert.Equal(t, append(append([]interface{}{}, values...), values[1:3]...), columnValues(t, concated))
+			// concat result does not share memory with origin
+			assert.Equal(t, values, columnValues(t, column))
+
+			_, err = column.Concat(NewColumnBool("bool", []bool{true}))
+			assert.Error(t, err)
+			_, err = column.Concat(nil)
+			assert.Error(t, err)
+		})
+	}
+
+	t.Run("keep_attributes", func(t *testing.T) {
+		json := NewColumnJSONBytes("json", [][]byte{[]byte(`{}`)}).WithIsDynamic(true)
+		assert.True(t, json.Slice(0, 1).FieldData().GetIsDynamic())
+
+		dynamic := NewColumnDynamic(json, "a")
+		_, err := dynamic.Concat(NewColumnDynamic(json, "b"))
+		assert.Error(t, err)
+		assert.Equal(t, "a", dynamic.Filter(func(int) bool { return true }).Name())
+
+		vector := NewColumnFloatVector("vector", 2, [][]float32{{1, 1}})
+		_, err = vector.Concat(NewColumnFloatVector("vector", 3, [][]float32{{1, 1, 1}}))
+		assert.Error(t, err)
+		assert.Equal(t, 2, vector.Slice(0, 1).(*ColumnFloatVector).Dim())
+
+		str := NewColumnString("string", []string{"a"})
+		_, err = str.Concat(NewColumnVarChar("varchar", []string{"b"}))
+		assert.Error(t, err)
+	})
+
+	t.Run("nullable_concat", func(t *testing.T) {
+		concated, err := NewColumnInt64("int64", []int64{1}).Concat(nullable)
+		require.NoError(t, err)
+		assert.Equal(t, []bool{true, true, false, true, true}, concated.(*ColumnInt64).ValidData())
+
+		concated, err = NewColumnInt64("int64", []int64{1}).Concat(NewColumnInt64("int64", []int64{2}))
+		require.NoError(t, err)
+		assert.Nil(t, concated.(*ColumnInt64).ValidData())
+	})
+}
@@ -116,6 +116,44 @@ func TestFieldDataBoolColumn(t *testing.T) {
 		assert.Equal(t, len, column.Len())
 		assert.Equal(t, FieldTypeBool, column.Type())
 	})
+
+	t.Run("nullable round trip", func(t *testing.T) {
+		validData := make([]bool, len)
+		validCount := 0
+		for i := range validData {
+			validData[i] = i%3 != 0
+			if validData[i] {
+				validCount++
+			}
+		}
+		_, err := NewNullableColumnBool(name, make([]bool, len), validData[1:])
+		assert.Error(t, err)
+		column, err := NewNullableColumnBool(name, make([]bool, len), validData)
+		assert.NoError(t, err)
+
+		fd := column.FieldData()
+		assert.Len(t, fd.GetScalars().GetBoolData().GetData(), validCount)
+		assert.Equal(t, validData, fd.GetValidData())
+
+		result, err := FieldDataColumn(fd, 1, -1)
+		assert.NoError(t, err)
+		assert.Equal(t, len-1, result.Len())
+		for i := 1; i < len; i++ {
+			isNull, err := result.(*ColumnBool).IsNull(i - 1)
+			assert.NoError(t, err)
+			assert.Equal(t, !validData[i], isNull)
+			v, err := result.Get(i - 1)
+			assert.NoError(t, err)
+			_, valueErr := result.(*ColumnBool).ValueByIdx(i - 1)
+			if validData[i] {
+				assert.NotNil(t, v)
+				assert.NoError(t, valueErr)
+			} else {
+				assert.Nil(t, v)
+				assert.ErrorIs(t, valueErr, ErrNullValue)
+			}
+		}
+	})
 }
 
 func TestColumnInt8(t *testing.T) {
@@ -221,6 +259,44 @@ func TestFieldDataInt8Column(t *testing.T) {
 		assert.Equal(t, len, column.Len())
 		assert.Equal(t, FieldTypeInt8, column.Type())
 	})
+
+	t.Run("nullable round trip", func(t *testing.T) {
+		validData := make([]bool, len)
+		validCount := 0
+		for i := range validData {
+			validData[i] = i%3 != 0
+			if validData[i] {
+				validCount++
+			}
+		}
+		_, err := NewNullableColumnInt8(name, make([]int8, len), validData[1:])
+		assert.Error(t, err)
+		column, err := NewNullableColumnInt8(name, make([]int8, len), validData)
+		assert.NoError(t, err)
+
+		fd := column.FieldData()
+		assert.Len(t, fd.GetScalars().GetIntData().GetData(), validCount)
+		assert.Equal(t, validData, fd.GetValidData())
+
+		result, err := FieldDataColumn(fd, 1, -1)
+		assert.NoError(t, err)
+		assert.Equal(t, len-1, result.Len())
+		for i := 1; i < len; i++ {
+			isNull, err := result.(*ColumnInt8).IsNull(i - 1)
+			assert.NoError(t, err)
+			assert.Equal(t, !validData[i], isNull)
+			v, err := result.Get(i - 1)
+			assert.NoError(t, err)
+			_, valueErr := result.(*ColumnInt8).ValueByIdx(i - 1)
+			if validData[i] {
+				assert.NotNil(t, v)
+				assert.NoError(t, valueErr)
+			} else {
+				assert.Nil(t, v)
+				assert.ErrorIs(t, valueErr, ErrNullValue)
+			}
+		}
+	})
 }
 
 func TestColumnInt16(t *testing.T) {
@@ -326,6 +402,44 @@ func TestFieldDataInt16Column(t *testing.T) {
 		assert.Equal(t, len, column.Len())
 		assert.Equal(t, FieldTypeInt16, column.Type())
 	})
+
+	t.Run("nullable round trip", func(t *testing.T) {
+		validData := make([]bool, len)
+		validCount := 0
+		for i := range validData {
+			validData[i] = i%3 != 0
+			if validData[i] {
+				validCount++
+			}
+		}
+		_, err := NewNullableColumnInt16(name, make([]int16, len), validData[1:])
+		assert.Error(t, err)
+		column, err := NewNullableColumnInt16(name, make([]int16, len), validData)
+		assert.NoError(t, err)
+
+		fd := column.FieldData()
+		assert.Len(t, fd.GetScalars().GetIntData().GetData(), validCount)
+		assert.Equal(t, validData, fd.GetValidData())
+
+		result, err := FieldDataColumn(fd, 1, -1)
+		assert.NoError(t, err)
+		assert.Equal(t, len-1, result.Len())
+		for i := 1; i < len; i++ {
+			isNull, err := result.(*ColumnInt16).IsNull(i - 1)
+			assert.NoError(t, err)
+			assert.Equal(t, !validData[i], isNull)
+			v, err := result.Get(i - 1)
+			assert.NoError(t, err)
+			_, valueErr := result.(*ColumnInt16).ValueByIdx(i - 1)
+			if validData[i] {
+				assert.NotNil(t, v)
+				assert.NoError(t, valueErr)
+			} else {
+				assert.Nil(t, v)
+				assert.ErrorIs(t, valueErr, ErrNullValue)
+			}
+		}
+	})
 }
 
 func TestColumnInt32(t *testing.T) {
@@ -431,6 +545,44 @@ func TestFieldDataInt32Column(t *testing.T) {
 		assert.Equal(t, len, column.Len())
 		assert.Equal(t, FieldTypeInt32, column.Type())
 	})
+
+	t.Run("nullable round trip", func(t *testing.T) {
+		validData := make([]bool, len)
+		validCount := 0
+		for i := range validData {
+			validData[i] = i%3 != 0
+			if validData[i] {
+				validCount++
+			}
+		}
+		_, err := NewNullableColumnInt32(name, make([]int32, len), validData[1:])
+		assert.Error(t, err)
+		column, err := NewNullableColumnInt32(name, make([]int32, len), validData)
+		assert.NoError(t, err)
+
+		fd := column.FieldData()
+		assert.Len(t, fd.GetScalars().GetIntData().GetData(), validCount)
+		assert.Equal(t, validData, fd.GetValidData())
+
+		result, err := FieldDataColumn(fd, 1, -1)
+		assert.NoError(t, err)
+		assert.Equal(t, len-1, result.Len())
+		for i := 1; i < len; i++ {
+			isNull, err := result.(*ColumnInt32).IsNull(i - 1)
+			assert.NoError(t, err)
+			assert.Equal(t, !validData[i], isNull)
+			v, err := result.Get(i - 1)
+			assert.NoError(t, err)
+			_, valueErr := result.(*ColumnInt32).ValueByIdx(i - 1)
+			if validData[i] {
+				assert.NotNil(t, v)
+				assert.NoError(t, valueErr)
+			} else {
+				assert.Nil(t, v)
+				assert.ErrorIs(t, valueErr, ErrNullValue)
+			}
+		}
+	})
 }
 
 func TestColumnInt64(t *testing.T) {
@@ -536,6 +688,44 @@ func TestFieldDataInt64Column(t *testing.T) {
 		assert.Equal(t, len, column.Len())
 		assert.Equal(t, FieldTypeInt64, column.Type())
 	})
+
+	t.Run("nullable round trip", func(t *testing.T) {
+		validData := make([]bool, len)
+		validCount := 0
+		for i := range validData {
+			validData[i] = i%3 != 0
+			if validData[i] {
+				validCount++
+			}
+		}
+		_, err := NewNullableColumnInt64(name, make([]int64, len), validData[1:])
+		assert.Error(t, err)
+		column, err := NewNullableColumnInt64(name, make([]int64, len), validData)
+		assert.NoError(t, err)
+
+		fd := column.FieldData()
+		assert.Len(t, fd.GetScalars().GetLongData().GetData(), validCount)
+		assert.Equal(t, validData, fd.GetValidData())
+
+		result, err := FieldDataColumn(fd, 1, -1)
+		assert.NoError(t, err)
+		assert.Equal(t, len-1, result.Len())
+		for i := 1; i < len; i++ {
+			isNull, err := result.(*ColumnInt64).IsNull(i - 1)
+			assert.NoError(t, err)
+			assert.Equal(t, !validData[i], isNull)
+			v, err := result.Get(i - 1)
+			assert.NoError(t, err)
+			_, valueErr := result.(*ColumnInt64).ValueByIdx(i - 1)
+			if validData[i] {
+				assert.NotNil(t, v)
+				assert.NoError(t, valueErr)
+			} else {
+				assert.Nil(t, v)
+				assert.ErrorIs(t, valueErr, ErrNullValue)
+			}
+		}
+	})
 }
 
 func TestColumnFloat(t *testing.T) {
@@ -641,6 +831,44 @@ func TestFieldDataFloatColumn(t *testing.T) {
 		assert.Equal(t, len, column.Len())
 		assert.Equal(t, FieldTypeFloat, column.Type())
 	})
+
+	t.Run("nullable round trip", func(t *testing.T) {
+		validData := make([]bool, len)
+		validCount := 0
+		for i := range validData {
+			validData[i] = i%3 != 0
+			if validData[i] {
+				validCount++
+			}
+		}
+		_, err := NewNullableColumnFloat(name, make([]float32, len), validData[1:])
+		assert.Error(t, err)
+		column, err := NewNullableColumnFloat(name, make([]float32, len), validData)
+		assert.NoError(t, err)
+
+		fd := column.FieldData()
+		assert.Len(t, fd.GetScalars().GetFloatData().GetData(), validCount)
+		assert.Equal(t, validData, fd.GetValidData())
+
+		result, err := FieldDataColumn(fd, 1, -1)
+		assert.NoError(t, err)
+		assert.Equal(t, len-1, result.Len())
+		for i := 1; i < len; i++ {
+			isNull, err := result.(*ColumnFloat).IsNull(i - 1)
+			assert.NoError(t, err)
+			assert.Equal(t, !validData[i], isNull)
+			v, err := result.Get(i - 1)
+			assert.NoError(t, err)
+			_, valueErr := result.(*ColumnFloat).ValueByIdx(i - 1)
+			if validData[i] {
+				assert.NotNil(t, v)
+				assert.NoError(t, valueErr)
+			} else {
+				assert.Nil(t, v)
+				assert.ErrorIs(t, valueErr, ErrNullValue)
+			}
+		}
+	})
 }
 
 func TestColumnDouble(t *testing.T) {
@@ -746,6 +974,44 @@ func TestFieldDataDoubleColumn(t *testing.T) {
 		assert.Equal(t, len, column.Len())
 		assert.Equal(t, FieldTypeDouble, column.Type())
 	})
+
+	t.Run("nullable round trip", func(t *testing.T) {
+		validData := make([]bool, len)
+		validCount := 0
+		for i := range validData {
+			validData[i] = i%3 != 0
+			if validData[i] {
+				validCount++
+			}
+		}
+		_, err := NewNullableColumnDouble(name, make([]float64, len), validData[1:])
+		assert.Error(t, err)
+		column, err := NewNullableColumnDouble(name, make([]float64, len), validData)
+		assert.NoError(t, err)
+
+		fd := column.FieldData()
+		assert.Len(t, fd.GetScalars().GetDoubleData().GetData(), validCount)
+		assert.Equal(t, validData, fd.GetValidData())
+
+		result, err := FieldDataColumn(fd, 1, -1)
+		assert.NoError(t, err)
+		assert.Equal(t, len-1, result.Len())
+		for i := 1; i < len; i++ {
+			isNull, err := result.(*ColumnDouble).IsNull(i - 1)
+			assert.NoError(t, err)
+			assert.Equal(t, !validData[i], isNull)
+			v, err := result.Get(i - 1)
+			assert.NoError(t, err)
+			_, valueErr := result.(*ColumnDouble).ValueByIdx(i - 1)
+			if validData[i] {
+				assert.NotNil(t, v)
+				assert.NoError(t, valueErr)
+			} else {
+				assert.Nil(t, v)
+				assert.ErrorIs(t, valueErr, ErrNullValue)
+			}
+		}
+	})
 }
 
 func TestColumnString(t *testing.T) {
@@ -851,4 +1117,42 @@ func TestFieldDataStringColumn(t *testing.T) {
 		assert.Equal(t, len, column.Len())
 		assert.Equal(t, FieldTypeString, column.Type())
 	})
+
+	t.Run("nullable round trip", func(t *testing.T) {
+		validData := make([]bool, len)
+		validCount := 0
+		for i := range validData {
+			validData[i] = i%3 != 0
+			if validData[i] {
+				validCount++
+			}
+		}
+		_, err := NewNullableColumnString(name, make([]string, len), validData[1:])
+		assert.Error(t, err)
+		column, err := NewNullableColumnString(name, make([]string, len), validData)
+		assert.NoError(t, err)
+
+		fd := column.FieldData()
+		assert.Len(t, fd.GetScalars().GetStringData().GetData(), validCount)
+		assert.Equal(t, validData, fd.GetValidData())
+
+		result, err := FieldDataColumn(fd, 1, -1)
+		assert.NoError(t, err)
+		assert.Equal(t, len-1, result.Len())
+		for i := 1; i < len; i++ {
+			isNull, err := result.(*ColumnString).IsNull(i - 1)
+			assert.NoError(t, err)
+			assert.Equal(t, !validData[i], isNull)
+			v, err := result.Get(i - 1)
+			assert.NoError(t, err)
+			_, valueErr := result.(*ColumnString).ValueByIdx(i - 1)
+			if validData[i] {
+				assert.NotNil(t, v)
+				assert.NoError(t, valueErr)
+			} else {
+				assert.Nil(t, v)
+				assert.ErrorIs(t, valueErr, ErrNullValue)
+			}
+		}
+	})
 }
@@ -0,0 +1,238 @@
+package entity
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/cockroachdb/errors"
+	schema "github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+)
+
+// SparseFloatVector sparse float32 vector, represented by index/value pairs sorted by index.
+type SparseFloatVector struct {
+	positions []uint32
+	values    []float32
+}
+
+// NewSparseFloatVector creates sparse float vector from index/value pairs,
+// the pairs are sorted by index and duplicated index is not allowed.
+func NewSparseFloatVector(positions []uint32, values []float32) (SparseFloatVector, error) {
+	if len(positions) != len(values) {
+		return SparseFloatVector{}, errors.New("sparse vector positions and values length not match")
+	}
+	vector := SparseFloatVector{
+		positions: make([]uint32, len(positions)),
+		values:    make([]float32, len(values)),
+	}
+	copy(vector.positions, positions)
+	copy(vector.values, values)
+	sort.Sort(sparsePairs(vector))
+	for i := 1; i < len(vector.positions); i++ {
+		if vector.positions[i] == vector.positions[i-1] {
+			return SparseFloatVector{}, fmt.Errorf("sparse vector has duplicated index %d", vector.positions[i])
+		}
+	}
+	return vector, nil
+}
+
+// DeserializeSparseFloatVector parses sparse float vector from bytes of serialized index/value pairs.
+func DeserializeSparseFloatVector(data []byte) (SparseFloatVector, error) {
+	if len(data)%8 != 0 {
+		return SparseFloatVector{}, errors.New("sparse vector data length is not multiple of 8")
+	}
+	positions := make([]uint32, 0, len(data)/8)
+	values := make([]float32, 0, len(data)/8)
+	for i := 0; i < len(data); i += 8 {
+		positions = append(positions, binary.LittleEndian.Uint32(data[i:]))
+		values = append(values, math.Float32frombits(binary.LittleEndian.Uint32(data[i+4:])))
+	}
+	return NewSparseFloatVector(positions, values)
+}
+
+// Dim returns the dimension sparse vector spans, which is max index plus one.
+func (sv SparseFloatVector) Dim() int {
+	if len(sv.positions) == 0 {
+		return 0
+	}
+	return int(sv.positions[len(sv.positions)-1]) + 1
+}
+
+// Len returns the number of non-zero elements.
+func (sv SparseFloatVector) Len() int {
+	return len(sv.positions)
+}
+
+// Get returns the index and value of the idx-th non-zero element.
+func (sv SparseFloatVector) Get(idx int) (position uint32, value float32, ok bool) {
+	if idx < 0 || idx >= len(sv.positions) {
+		return 0, 0, false
+	}
+	return sv.positions[idx], sv.values[idx], true
+}
+
+// Serialize serializes vector into index/value pairs, each pair is little endian uint32 index followed by float32 value.
+func (sv SparseFloatVector) Serialize() []byte {
+	data := make([]byte, 8*len(sv.positions))
+	for i, position := range sv.positions {
+		binary.LittleEndian.PutUint32(data[8*i:], position)
+		binary.LittleEndian.PutUint32(data[8*i+4:], math.Float32bits(sv.values[i]))
+	}
+	return data
+}
+
+// FieldType returns coresponding field type.
+func (sv SparseFloatVector) FieldType() FieldType {
+	return FieldTypeSparseFloatVector
+}
+
+// sparsePairs implements sort.Interface to sort index/value pairs by index.
+type sparsePairs SparseFloatVector
+
+func (p sparsePairs) Len() int           { return len(p.positions) }
+func (p sparsePairs) Less(i, j int) bool { return p.positions[i] < p.positions[j] }
+func (p sparsePairs) Swap(i, j int) {
+	p.positions[i], p.positions[j] = p.positions[j], p.positions[i]
+	p.values[i], p.values[j] = p.values[j], p.values[i]
+}
+
+var _ Column = (*ColumnSparseFloatVector)(nil)
+
+// ColumnSparseFloatVector column type for sparse float vector.
+type ColumnSparseFloatVector struct {
+	ColumnBase
+	name   string
+	values []SparseFloatVector
+}
+
+// NewColumnSparseFloatVector creates sparse float vector column.
+func NewColumnSparseFloatVector(name string, values []SparseFloatVector) *ColumnSparseFloatVector {
+	return &ColumnSparseFloatVector{
+		name:   name,
+		values: values,
+	}
+}
+
+// Name returns column name
+func (c *ColumnSparseFloatVector) Name() string {
+	return c.name
+}
+
+// Type returns column FieldType
+func (c *ColumnSparseFloatVector) Type() FieldType {
+	return FieldTypeSparseFloatVector
+}
+
+// Len returns column data length
+func (c *ColumnSparseFloatVector) Len() int {
+	return len(c.values)
+}
+
+// Dim returns the max dimension of the vectors in column
+func (c *ColumnSparseFloatVector) Dim() int {
+	dim := 0
+	for _, vector := range c.values {
+		if vector.Dim() > dim {
+			dim = vector.Dim()
+		}
+	}
+	return dim
+}
+
+// Get returns values at index as interface{}.
+func (c *ColumnSparseFloatVector) Get(idx int) (interface{}, error) {
+	if idx < 0 || idx >= c.Len() {
+		return nil, errors.New("index out of range")
+	}
+	return c.values[idx], nil
+}
+
+// AppendValue append value into column
+func (c *ColumnSparseFloatVector) AppendValue(i interface{}) error {
+	v, ok := i.(SparseFloatVector)
+	if !ok {
+		return fmt.Errorf("invalid type, expected SparseFloatVector, got %T", i)
+	}
+	c.values = append(c.values, v)
+
+	return nil
+}
+
+// Data returns column data
+func (c *ColumnSparseFloatVector) Data() []SparseFloatVector {
+	return c.values
+}
+
+// Slice returns column of values in [begin, end), which shares memory with c
+func (c *ColumnSparseFloatVector) Slice(begin, end int) Column {
+	return NewColumnSparseFloatVector(c.name, c.values[begin:end:end])
+}
+
+// Take returns column of values at indices
+func (c *ColumnSparseFloatVector) Take(indices []int) (Column, error) {
+	values, err := takeValues(c.values, indices)
+	if err != nil {
+		return nil, err
+	}
+	return NewColumnSparseFloatVector(c.name, values), nil
+}
+
+// Filter returns column of values which keep returns true
+func (c *ColumnSparseFloatVector) Filter(keep func(idx int) bool) Column {
+	return NewColumnSparseFloatVector(c.name, filterValues(c.values, keep))
+}
+
+// Concat returns column of values in c followed by values in other
+func (c *ColumnSparseFloatVector) Concat(other Column) (Column, error) {
+	o, ok := other.(*ColumnSparseFloatVector)
+	if !ok {
+		return nil, errConcatNotMatch(c, other)
+	}
+	return NewColumnSparseFloatVector(c.name, concatValues(c.values, o.values)), nil
+}
+
+// FieldData return column data mapped to schema.FieldData
+func (c *ColumnSparseFloatVector) FieldData() *schema.FieldData {
+	contents := make([][]byte, 0, len(c.values))
+	for _, vector := range c.values {
+		contents = append(contents, vector.Serialize())
+	}
+	dim := int64(c.Dim())
+	return &schema.FieldData{
+		Type:      schema.DataType_SparseFloatVector,
+		FieldName: c.name,
+		Field: &schema.FieldData_Vectors{
+			Vectors: &schema.VectorField{
+				Dim: dim,
+				Data: &schema.VectorField_SparseFloatVector{
+					SparseFloatVector: &schema.SparseFloatArray{
+						Contents: contents,
+						Dim:      dim,
+					},
+				},
+			},
+		},
+	}
+}
+
+// parseSparseFloatVectors parses serialized sparse vectors in [begin, end) into column,
+// end < 0 means till the last row.
+func parseSparseFloatVectors(name string, data *schema.SparseFloatArray, begin, end int) (Column, error) {
+	if data == nil {
+		return nil, errFieldDataTypeNotMatch
+	}
+	contents := data.GetContents()
+	if end < 0 {
+		end = len(contents)
+	}
+	vectors := make([]SparseFloatVector, 0, end-begin)
+	for _, content := range contents[begin:end] {
+		vector, err := DeserializeSparseFloatVector(content)
+		if err != nil {
+			return nil, err
+		}
+		vectors = append(vectors, vector)
+	}
+	return NewColumnSparseFloatVector(name, vectors), nil
+}
@@ -0,0 +1,149 @@
+package entity
+
+import (
+	"testing"
+
+	schema "github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSparseFloatVector(t *testing.T) {
+	t.Run("sorted_by_index", func(t *testing.T) {
+		positions := []uint32{100, 1, 10}
+		values := []float32{0.3, 0.1, 0.2}
+		vector, err := NewSparseFloatVector(positions, values)
+		require.NoError(t, err)
+		// input shall not be modified
+		assert.Equal(t, []uint32{100, 1, 10}, positions)
+
+		assert.Equal(t, 3, vector.Len())
+		assert.Equal(t, 101, vector.Dim())
+		assert.Equal(t, FieldTypeSparseFloatVector, vector.FieldType())
+		for i, expect := range []struct {
+			position uint32
+			value    float32
+		}{{1, 0.1}, {10, 0.2}, {100, 0.3}} {
+			position, value, ok := vector.Get(i)
+			assert.True(t, ok)
+			assert.Equal(t, expect.position, position)
+			assert.Equal(t, expect.value, value)
+		}
+		_, _, ok := vector.Get(3)
+		assert.False(t, ok)
+	})
+
+	t.Run("serialize_round_trip", func(t *testing.T) {
+		vector, err := NewSparseFloatVector([]uint32{7, 3}, []float32{-1.5, 2.25})
+		require.NoError(t, err)
+		data := vector.Serialize()
+		assert.Equal(t, []byte{
+			3, 0, 0, 0, 0x00, 0x00, 0x10, 0x40, // 3: 2.25
+			7, 0, 0, 0, 0x00, 0x00, 0xc0, 0xbf, // 7: -1.5
+		}, data)
+
+		result, err := DeserializeSparseFloatVector(data)
+		require.NoError(t, err)
+		assert.Equal(t, vector, result)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		vector, err := NewSparseFloatVector(nil, nil)
+		require.NoError(t, err)
+		assert.Equal(t, 0, vector.Dim())
+		assert.Empty(t, vector.Serialize())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := NewSparseFloatVector([]uint32{1, 2}, []float32{0.1})
+		assert.Error(t, err)
+
+		_, err = NewSparseFloatVector([]uint32{1, 1}, []float32{0.1, 0.2})
+		assert.Error(t, err)
+
+		_, err = DeserializeSparseFloatVector([]byte{1, 0, 0, 0})
+		assert.Error(t, err)
+	})
+}
+
+func TestColumnSparseFloatVector(t *testing.T) {
+	v1, err := NewSparseFloatVector([]uint32{0, 5}, []float32{0.5, 1})
+	require.NoError(t, err)
+	v2, err := NewSparseFloatVector([]uint32{1000}, []float32{2})
+	require.NoError(t, err)
+	v3, err := NewSparseFloatVector(nil, nil)
+	require.NoError(t, err)
+
+	column := NewColumnSparseFloatVector("sparse", []SparseFloatVector{v1, v2})
+
+	t.Run("test meta", func(t *testing.T) {
+		ft := FieldTypeSparseFloatVector
+		assert.Equal(t, "SparseFloatVector", ft.Name())
+		assert.Equal(t, "SparseFloatVector", ft.String())
+		pbName, pbType := ft.PbFieldType()
+		assert.Equal(t, "SparseFloatVector", pbName)
+		assert.Equal(t, "", pbType)
+	})
+
+	t.Run("test column attribute", func(t *testing.T) {
+		assert.Equal(t, "sparse", column.Name())
+		assert.Equal(t, FieldTypeSparseFloatVector, column.Type())
+		assert.Equal(t, 2, column.Len())
+		assert.Equal(t, 1001, column.Dim())
+
+		v, err := column.Get(1)
+		assert.NoError(t, err)
+		assert.Equal(t, v2, v)
+		_, err = column.Get(2)
+		assert.Error(t, err)
+	})
+
+	t.Run("test field data round trip", func(t *testing.T) {
+		column := NewColumnSparseFloatVector("sparse", []SparseFloatVector{v1, v2})
+		err := column.AppendValue(v3)
+		assert.NoError(t, err)
+		err = column.AppendValue([]float32{})
+		assert.Error(t, err)
+		assert.Equal(t, 3, column.Len())
+
+		fd := column.FieldData()
+		assert.Equal(t, schema.DataType_SparseFloatVector, fd.GetType())
+		assert.EqualValues(t, 1001, fd.GetVectors().GetSparseFloatVector().GetDim())
+		assert.Equal(t, 3, len(fd.GetVectors().GetSparseFloatVector().GetContents()))
+
+		result, err := FieldDataVector(fd)
+		require.NoError(t, err)
+		assert.Equal(t, column, result)
+
+		result, err = FieldDataColumn(fd, 1, 2)
+		require.NoError(t, err)
+		assert.Equal(t, []SparseFloatVector{v2}, result.(*ColumnSparseFloatVector).Data())
+
+		result, err = FieldDataColumn(fd, 1, -1)
+		require.NoError(t, err)
+		assert.Equal(t, []SparseFloatVector{v2, v3}, result.(*ColumnSparseFloatVector).Data())
+	})
+
+	t.Run("test field data error", func(t *testing.T) {
+		_, err := FieldDataVector(&schema.FieldData{
+			Type:      schema.DataType_SparseFloatVector,
+			FieldName: "sparse",
+		})
+		assert.Error(t, err)
+
+		_, err = FieldDataColumn(&schema.FieldData{
+			Type:      schema.DataType_SparseFloatVector,
+			FieldName: "sparse",
+			Field: &schema.FieldData_Vectors{
+				Vectors: &schema.VectorField{
+					Data: &schema.VectorField_SparseFloatVector{
+						SparseFloatVector: &schema.SparseFloatArray{
+							Contents: [][]byte{{1, 2, 3}},
+						},
+					},
+				},
+			},
+		}, 0, -1)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,57 @@
+package entity
+
+// ColumnString generated columns type for String.
+// Unlike ColumnVarChar, it's a distinct type instead of an alias of ColumnOf[string],
+// so that *ColumnString and *ColumnVarChar are different cases of type switch.
+type ColumnString struct {
+	ColumnOf[string]
+}
+
+// NewColumnString auto generated constructor
+func NewColumnString(name string, values []string) *ColumnString {
+	return &ColumnString{ColumnOf: *newColumnOf(name, FieldTypeString, values)}
+}
+
+// NewNullableColumnString creates column with validity of each value,
+// values at invalid positions are ignored
+func NewNullableColumnString(name string, values []string, validData []bool) (*ColumnString, error) {
+	column, err := newNullableColumnOf(name, FieldTypeString, values, validData)
+	if err != nil {
+		return nil, err
+	}
+	return &ColumnString{ColumnOf: *column}, nil
+}
+
+// Slice returns column of values in [begin, end), which shares memory with c.
+// It panics when range out of bound, as slicing does.
+func (c *ColumnString) Slice(begin, end int) Column {
+	return &ColumnString{ColumnOf: *c.ColumnOf.Slice(begin, end).(*ColumnOf[string])}
+}
+
+// Take returns column of values at indices, which could be in any order and duplicated.
+func (c *ColumnString) Take(indices []int) (Column, error) {
+	column, err := c.ColumnOf.Take(indices)
+	if err != nil {
+		return nil, err
+	}
+	return &ColumnString{ColumnOf: *column.(*ColumnOf[string])}, nil
+}
+
+// Filter returns column of values which keep returns true.
+func (c *ColumnString) Filter(keep func(idx int) bool) Column {
+	column, _ := c.Take(filterIndices(c.Len(), keep))
+	return column
+}
+
+// Concat returns column of values in c followed by values in other.
+func (c *ColumnString) Concat(other Column) (Column, error) {
+	o, ok := other.(*ColumnString)
+	if !ok {
+		return nil, errConcatNotMatch(c, other)
+	}
+	column, err := c.ColumnOf.Concat(&o.ColumnOf)
+	if err != nil {
+		return nil, err
+	}
+	return &ColumnString{ColumnOf: *column.(*ColumnOf[string])}, nil
+}
@@ -1,102 +1,15 @@
 package entity
 
-import (
-	"errors"
-	"fmt"
-
-	schema "github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
-)
-
 // ColumnVarChar generated columns type for VarChar
-type ColumnVarChar struct {
-	ColumnBase
-	name   string
-	values []string
-}
-
-// Name returns column name
-func (c *ColumnVarChar) Name() string {
-	return c.name
-}
-
-// Type returns column FieldType
-func (c *ColumnVarChar) Type() FieldType {
-	return FieldTypeVarChar
-}
-
-// Len returns column values length
-func (c *ColumnVarChar) Len() int {
-	return len(c.values)
-}
-
-// Get returns value at index as interface{}.
-func (c *ColumnVarChar) Get(idx int) (interface{}, error) {
-	if idx < 0 || idx > c.Len() {
-		return "", errors.New("index out of range")
-	}
-	return c.values[idx], nil
-}
-
-// GetAsString returns value at idx.
-func (c *ColumnVarChar) GetAsString(idx int) (string, error) {
-	if idx < 0 || idx > c.Len() {
-		return "", errors.New("index out of range")
-	}
-	return c.values[idx], nil
-}
-
-// FieldData return column data mapped to schema.FieldData
-func (c *ColumnVarChar) FieldData() *schema.FieldData {
-	fd := &schema.FieldData{
-		Type:      schema.DataType_VarChar,
-		FieldName: c.name,
-	}
-	data := make([]string, 0, c.Len())
-	for i := 0; i < c.Len(); i++ {
-		data = append(data, string(c.values[i]))
-	}
-	fd.Field = &schema.FieldData_Scalars{
-		Scalars: &schema.ScalarField{
-			Data: &schema.ScalarField_StringData{
-				StringData: &schema.StringArray{
-					Data: data,
-				},
-			},
-		},
-	}
-	return fd
-}
-
-// ValueByIdx returns value of the provided index
-// error occurs when index out of range
-func (c *ColumnVarChar) ValueByIdx(idx int) (string, error) {
-	var r string // use default value
-	if idx < 0 || idx >= c.Len() {
-		return r, errors.New("index out of range")
-	}
-	return c.values[idx], nil
-}
-
-// AppendValue append value into column
-func (c *ColumnVarChar) AppendValue(i interface{}) error {
-	v, ok := i.(string)
-	if !ok {
-		return fmt.Errorf("invalid type, expected string, got %T", i)
-	}
-	c.values = append(c.values, v)
-
-	return nil
-}
-
-// Data returns column data
-func (c *ColumnVarChar) Data() []string {
-	return c.values
-}
+type ColumnVarChar = ColumnOf[string]
 
 // NewColumnVarChar auto generated constructor
 func NewColumnVarChar(name string, values []string) *ColumnVarChar {
-	return &ColumnVarChar{
-		name:   name,
-		values: values,
-	}
+	return newColumnOf(name, FieldTypeVarChar, values)
+}
+
+// NewNullableColumnVarChar creates column with validity of each value,
+// values at invalid positions are ignored
+func NewNullableColumnVarChar(name string, values []string, validData []bool) (*ColumnVarChar, error) {
+	return newNullableColumnOf(name, FieldTypeVarChar, values, validData)
 }
@@ -87,6 +87,34 @@ func (c *ColumnBinaryVector) FieldData() *schema.FieldData {
 	return fd
 }
 
+// Slice returns column of values in [begin, end), which shares memory with c
+func (c *ColumnBinaryVector) Slice(begin, end int) Column {
+	return NewColumnBinaryVector(c.name, c.dim, c.values[begin:end:end])
+}
+
+// Take returns column of values at indices
+func (c *ColumnBinaryVector) Take(indices []int) (Column, error) {
+	values, err := takeValues(c.values, indices)
+	if err != nil {
+		return nil, err
+	}
+	return NewColumnBinaryVector(c.name, c.dim, values), nil
+}
+
+// Filter returns column of values which keep returns true
+func (c *ColumnBinaryVector) Filter(keep func(idx int) bool) Column {
+	return NewColumnBinaryVector(c.name, c.dim, filterValues(c.values, keep))
+}
+
+// Concat returns column of values in c followed by values in other
+func (c *ColumnBinaryVector) Concat(other Column) (Column, error) {
+	o, ok := other.(*ColumnBinaryVector)
+	if !ok || o.dim != c.dim {
+		return nil, errConcatNotMatch(c, other)
+	}
+	return NewColumnBinaryVector(c.name, c.dim, concatValues(c.values, o.values)), nil
+}
+
 // NewColumnBinaryVector auto generated constructor
 func NewColumnBinaryVector(name string, dim int, values [][]byte) *ColumnBinaryVector {
 	return &ColumnBinaryVector{
@@ -175,6 +203,34 @@ func (c *ColumnFloatVector) FieldData() *schema.FieldData {
 	return fd
 }
 
+// Slice returns column of values in [begin, end), which shares memory with c
+func (c *ColumnFloatVector) Slice(begin, end int) Column {
+	return NewColumnFloatVector(c.name, c.dim, c.values[begin:end:end])
+}
+
+// Take returns column of values at indices
+func (c *ColumnFloatVector) Take(indices []int) (Column, error) {
+	values, err := takeValues(c.values, indices)
+	if err != nil {
+		return nil, err
+	}
+	return NewColumnFloatVector(c.name, c.dim, values), nil
+}
+
+// Filter returns column of values which keep returns true
+func (c *ColumnFloatVector) Filter(keep func(idx int) bool) Column {
+	return NewColumnFloatVector(c.name, c.dim, filterValues(c.values, keep))
+}
+
+// Concat returns column of values in c followed by values in other
+func (c *ColumnFloatVector) Concat(other Column) (Column, error) {
+	o, ok := other.(*ColumnFloatVector)
+	if !ok || o.dim != c.dim {
+		return nil, errConcatNotMatch(c, other)
+	}
+	return NewColumnFloatVector(c.name, c.dim, concatValues(c.values, o.values)), nil
+}
+
 // NewColumnFloatVector auto generated constructor
 func NewColumnFloatVector(name string, dim int, values [][]float32) *ColumnFloatVector {
 	return &ColumnFloatVector{
@@ -183,3 +239,237 @@ func NewColumnFloatVector(name string, dim int, values [][]float32) *ColumnFloat
 		values: values,
 	}
 }
+
+// ColumnFloat16Vector generated columns type for Float16Vector
+type ColumnFloat16Vector struct {
+	ColumnBase
+	name   string
+	dim    int
+	values [][]byte
+}
+
+// Name returns column name
+func (c *ColumnFloat16Vector) Name() string {
+	return c.name
+}
+
+// Type returns column FieldType
+func (c *ColumnFloat16Vector) Type() FieldType {
+	return FieldTypeFloat16Vector
+}
+
+// Len returns column data length
+func (c *ColumnFloat16Vector) Len() int {
+	return len(c.values)
+}
+
+// Dim returns vector dimension
+func (c *ColumnFloat16Vector) Dim() int {
+	return c.dim
+}
+
+// Get returns values at index as interface{}.
+func (c *ColumnFloat16Vector) Get(idx int) (interface{}, error) {
+	if idx < 0 || idx >= c.Len() {
+		return nil, errors.New("index out of range")
+	}
+	return c.values[idx], nil
+}
+
+// AppendValue append value into column
+func (c *ColumnFloat16Vector) AppendValue(i interface{}) error {
+	if v, ok := i.(Float16Vector); ok {
+		i = []byte(v)
+	}
+	v, ok := i.([]byte)
+	if !ok {
+		return fmt.Errorf("invalid type, expected []byte, got %T", i)
+	}
+	c.values = append(c.values, v)
+
+	return nil
+}
+
+// Data returns column data
+func (c *ColumnFloat16Vector) Data() [][]byte {
+	return c.values
+}
+
+// FieldData return column data mapped to schema.FieldData
+func (c *ColumnFloat16Vector) FieldData() *schema.FieldData {
+	fd := &schema.FieldData{
+		Type:      schema.DataType_Float16Vector,
+		FieldName: c.name,
+	}
+
+	data := make([]byte, 0, len(c.values)*c.dim)
+
+	for _, vector := range c.values {
+		data = append(data, vector...)
+	}
+
+	fd.Field = &schema.FieldData_Vectors{
+		Vectors: &schema.VectorField{
+			Dim: int64(c.dim),
+
+			Data: &schema.VectorField_Float16Vector{
+				Float16Vector: data,
+			},
+		},
+	}
+	return fd
+}
+
+// Slice returns column of values in [begin, end), which shares memory with c
+func (c *ColumnFloat16Vector) Slice(begin, end int) Column {
+	return NewColumnFloat16Vector(c.name, c.dim, c.values[begin:end:end])
+}
+
+// Take returns column of values at indices
+func (c *ColumnFloat16Vector) Take(indices []int) (Column, error) {
+	values, err := takeValues(c.values, indices)
+	if err != nil {
+		return nil, err
+	}
+	return NewColumnFloat16Vector(c.name, c.dim, values), nil
+}
+
+// Filter returns column of values which keep returns true
+func (c *ColumnFloat16Vector) Filter(keep func(idx int) bool) Column {
+	return NewColumnFloat16Vector(c.name, c.dim, filterValues(c.values, keep))
+}
+
+// Concat returns column of values in c followed by values in other
+func (c *ColumnFloat16Vector) Concat(other Column) (Column, error) {
+	o, ok := other.(*ColumnFloat16Vector)
+	if !ok || o.dim != c.dim {
+		return nil, errConcatNotMatch(c, other)
+	}
+	return NewColumnFloat16Vector(c.name, c.dim, concatValues(c.values, o.values)), nil
+}
+
+// NewColumnFloat16Vector auto generated constructor
+func NewColumnFloat16Vector(name string, dim int, values [][]byte) *ColumnFloat16Vector {
+	return &ColumnFloat16Vector{
+		name:   name,
+		dim:    dim,
+		values: values,
+	}
+}
+
+// ColumnBFloat16Vector generated columns type for BFloat16Vector
+type ColumnBFloat16Vector struct {
+	ColumnBase
+	name   string
+	dim    int
+	values [][]byte
+}
+
+// Name returns column name
+func (c *ColumnBFloat16Vector) Name() string {
+	return c.name
+}
+
+// Type returns column FieldType
+func (c *ColumnBFloat16Vector) Type() FieldType {
+	return FieldTypeBFloat16Vector
+}
+
+// Len returns column data length
+func (c *ColumnBFloat16Vector) Len() int {
+	return len(c.values)
+}
+
+// Dim returns vector dimension
+func (c *ColumnBFloat16Vector) Dim() int {
+	return c.dim
+}
+
+// Get returns values at index as interface{}.
+func (c *ColumnBFloat16Vector) Get(idx int) (interface{}, error) {
+	if idx < 0 || idx >= c.Len() {
+		return nil, errors.New("index out of range")
+	}
+	return c.values[idx], nil
+}
+
+// AppendValue append value into column
+func (c *ColumnBFloat16Vector) AppendValue(i interface{}) error {
+	if v, ok := i.(BFloat16Vector); ok {
+		i = []byte(v)
+	}
+	v, ok := i.([]byte)
+	if !ok {
+		return fmt.Errorf("invalid type, expected []byte, got %T", i)
+	}
+	c.values = append(c.values, v)
+
+	return nil
+}
+
+// Data returns column data
+func (c *ColumnBFloat16Vector) Data() [][]byte {
+	return c.values
+}
+
+// FieldData return column data mapped to schema.FieldData
+func (c *ColumnBFloat16Vector) FieldData() *schema.FieldData {
+	fd := &schema.FieldData{
+		Type:      schema.DataType_BFloat16Vector,
+		FieldName: c.name,
+	}
+
+	data := make([]byte, 0, len(c.values)*c.dim)
+
+	for _, vector := range c.values {
+		data = append(data, vector...)
+	}
+
+	fd.Field = &schema.FieldData_Vectors{
+		Vectors: &schema.VectorField{
+			Dim: int64(c.dim),
+
+			Data: &schema.VectorField_Bfloat16Vector{
+				Bfloat16Vector: data,
+			},
+		},
+	}
+	return fd
+}
+
+// Slice returns column of values in [begin, end), which shares memory with c
+func (c *ColumnBFloat16Vector) Slice(begin, end int) Column {
+	return NewColumnBFloat16Vector(c.name, c.dim, c.values[begin:end:end])
+}
+
+// Take returns column of values at indices
+func (c *ColumnBFloat16Vector) Take(indices []int) (Column, error) {
+	values, err := takeValues(c.values, indices)
+	if err != nil {
+		return nil, err
+	}
+	return NewColumnBFloat16Vector(c.name, c.dim, values), nil
+}
+
+// Filter returns column of values which keep returns true
+func (c *ColumnBFloat16Vector) Filter(keep func(idx int) bool) Column {
+	return NewColumnBFloat16Vector(c.name, c.dim, filterValues(c.values, keep))
+}
+
+// Concat returns column of values in c followed by values in other
+func (c *ColumnBFloat16Vector) Concat(other Column) (Column, error) {
+	o, ok := other.(*ColumnBFloat16Vector)
+	if !ok || o.dim != c.dim {
+		return nil, errConcatNotMatch(c, other)
+	}
+	return NewColumnBFloat16Vector(c.name, c.dim, concatValues(c.values, o.values)), nil
+}
+
+// NewColumnBFloat16Vector auto generated constructor
+func NewColumnBFloat16Vector(name string, dim int, values [][]byte) *ColumnBFloat16Vector {
+	return &ColumnBFloat16Vector{
+		name:   name,
+		dim:    dim,
+		values: values,
+	}
+}
@@ -137,3 +137,129 @@ func TestColumnFloatVector(t *testing.T) {
 	})
 
 }
+
+func TestColumnFloat16Vector(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	columnName := fmt.Sprintf("column_Float16Vector_%d", rand.Int())
+	columnLen := 12 + rand.Intn(10)
+	dim := ([]int{64, 128, 256, 512})[rand.Intn(4)]
+
+	v := make([][]byte, 0, columnLen)
+	dlen := dim
+	dlen *= 2
+
+	for i := 0; i < columnLen; i++ {
+		entry := make([]byte, dlen)
+		v = append(v, entry)
+	}
+	column := NewColumnFloat16Vector(columnName, dim, v)
+
+	t.Run("test meta", func(t *testing.T) {
+		ft := FieldTypeFloat16Vector
+		assert.Equal(t, "Float16Vector", ft.Name())
+		assert.Equal(t, "[]byte", ft.String())
+		pbName, pbType := ft.PbFieldType()
+		assert.Equal(t, "[]byte", pbName)
+		assert.Equal(t, "", pbType)
+	})
+
+	t.Run("test column attribute", func(t *testing.T) {
+		assert.Equal(t, columnName, column.Name())
+		assert.Equal(t, FieldTypeFloat16Vector, column.Type())
+		assert.Equal(t, columnLen, column.Len())
+		assert.Equal(t, dim, column.Dim())
+		assert.Equal(t, v, column.Data())
+
+		var ev []byte
+		err := column.AppendValue(ev)
+		assert.Equal(t, columnLen+1, column.Len())
+		assert.Nil(t, err)
+
+		err = column.AppendValue(struct{}{})
+		assert.Equal(t, columnLen+1, column.Len())
+		assert.NotNil(t, err)
+	})
+
+	t.Run("test column field data", func(t *testing.T) {
+		fd := column.FieldData()
+		assert.NotNil(t, fd)
+		assert.Equal(t, fd.GetFieldName(), columnName)
+
+		c, err := FieldDataVector(fd)
+		assert.NotNil(t, c)
+		assert.NoError(t, err)
+	})
+
+	t.Run("test column field data error", func(t *testing.T) {
+		fd := &schema.FieldData{
+			Type:      schema.DataType_Float16Vector,
+			FieldName: columnName,
+		}
+		_, err := FieldDataVector(fd)
+		assert.Error(t, err)
+	})
+
+}
+
+func TestColumnBFloat16Vector(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	columnName := fmt.Sprintf("column_BFloat16Vector_%d", rand.Int())
+	columnLen := 12 + rand.Intn(10)
+	dim := ([]int{64, 128, 256, 512})[rand.Intn(4)]
+
+	v := make([][]byte, 0, columnLen)
+	dlen := dim
+	dlen *= 2
+
+	for i := 0; i < columnLen; i++ {
+		entry := make([]byte, dlen)
+		v = append(v, entry)
+	}
+	column := NewColumnBFloat16Vector(columnName, dim, v)
+
+	t.Run("test meta", func(t *testing.T) {
+		ft := FieldTypeBFloat16Vector
+		assert.Equal(t, "BFloat16Vector", ft.Name())
+		assert.Equal(t, "[]byte", ft.String())
+		pbName, pbType := ft.PbFieldType()
+		assert.Equal(t, "[]byte", pbName)
+		assert.Equal(t, "", pbType)
+	})
+
+	t.Run("test column attribute", func(t *testing.T) {
+		assert.Equal(t, columnName, column.Name())
+		assert.Equal(t, FieldTypeBFloat16Vector, column.Type())
+		assert.Equal(t, columnLen, column.Len())
+		assert.Equal(t, dim, column.Dim())
+		assert.Equal(t, v, column.Data())
+
+		var ev []byte
+		err := column.AppendValue(ev)
+		assert.Equal(t, columnLen+1, column.Len())
+		assert.Nil(t, err)
+
+		err = column.AppendValue(struct{}{})
+		assert.Equal(t, columnLen+1, column.Len())
+		assert.NotNil(t, err)
+	})
+
+	t.Run("test column field data", func(t *testing.T) {
+		fd := column.FieldData()
+		assert.NotNil(t, fd)
+		assert.Equal(t, fd.GetFieldName(), columnName)
+
+		c, err := FieldDataVector(fd)
+		assert.NotNil(t, c)
+		assert.NoError(t, err)
+	})
+
+	t.Run("test column field data error", func(t *testing.T) {
+		fd := &schema.FieldData{
+			Type:      schema.DataType_BFloat16Vector,
+			FieldName: columnName,
+		}
+		_, err := FieldDataVector(fd)
+		assert.Error(t, err)
+	})
+
+}
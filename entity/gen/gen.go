@@ -27,99 +27,19 @@ var scalarColumnTemplate = template.Must(template.New("").Parse(`// Code generat
 // This file is generated by go generate 
 
 package entity 
-
-import (
-	"errors"
-	"fmt"
-
-	schema "github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
-)
 {{ range .Types }}{{with .}}
 // Column{{.TypeName}} generated columns type for {{.TypeName}}
-type Column{{.TypeName}} struct {
-	ColumnBase
-	name   string
-	values []{{.TypeDef}}
-}
-
-// Name returns column name
-func (c *Column{{.TypeName}}) Name() string {
-	return c.name
-}
-
-// Type returns column FieldType
-func (c *Column{{.TypeName}}) Type() FieldType {
-	return FieldType{{.TypeName}}
-}
-
-// Len returns column values length
-func (c *Column{{.TypeName}}) Len() int {
-	return len(c.values)
-}
-
-// Get returns value at index as interface{}.
-func (c *Column{{.TypeName}}) Get(idx int) (interface{}, error) {
-	var r {{.TypeDef}} // use default value
-	if idx < 0 || idx >= c.Len() {
-		return r, errors.New("index out of range")
-	}
-	return c.values[idx], nil
-}
-
-// FieldData return column data mapped to schema.FieldData
-func (c *Column{{.TypeName}}) FieldData() *schema.FieldData {
-	fd := &schema.FieldData{
-		Type: schema.DataType_{{.TypeName}},
-		FieldName: c.name,
-	}
-	data := make([]{{.PbType}}, 0, c.Len())
-	for i := 0; i < c.Len(); i++ {
-		data = append(data, {{.PbType}}(c.values[i]))
-	}
-	fd.Field = &schema.FieldData_Scalars{
-		Scalars: &schema.ScalarField{
-			Data: &schema.ScalarField_{{.PbName}}Data{
-				{{.PbName}}Data: &schema.{{.PbName}}Array{
-					Data: data,
-				},
-			},
-		},
-	}
-	return fd
-}
-
-// ValueByIdx returns value of the provided index
-// error occurs when index out of range
-func (c *Column{{.TypeName}}) ValueByIdx(idx int) ({{.TypeDef}}, error) {
-	var r {{.TypeDef}} // use default value
-	if idx < 0 || idx >= c.Len() {
-		return r, errors.New("index out of range")
-	}
-	return c.values[idx], nil
-}
-
-// AppendValue append value into column
-func(c *Column{{.TypeName}}) AppendValue(i interface{}) error {
-	v, ok := i.({{.TypeDef}})
-	if !ok {
-		return fmt.Errorf("invalid type, expected {{.TypeDef}}, got %T", i)
-	}
-	c.values = append(c.values, v)
-
-	return nil
-}
-
-// Data returns column data
-func (c *Column{{.TypeName}}) Data() []{{.TypeDef}} {
-	return c.values
-}
+type Column{{.TypeName}} = ColumnOf[{{.TypeDef}}]
 
 // NewColumn{{.TypeName}} auto generated constructor
 func NewColumn{{.TypeName}}(name string, values []{{.TypeDef}}) *Column{{.TypeName}} {
-	return &Column{{.TypeName}} {
-		name: name,
-		values: values,
-	}
+	return newColumnOf(name, FieldType{{.TypeName}}, values)
+}
+
+// NewNullableColumn{{.TypeName}} creates column with validity of each value,
+// values at invalid positions are ignored
+func NewNullableColumn{{.TypeName}}(name string, values []{{.TypeDef}}, validData []bool) (*Column{{.TypeName}}, error) {
+	return newNullableColumnOf(name, FieldType{{.TypeName}}, values, validData)
 }
 {{end}}{{end}}
 `))
@@ -174,6 +94,11 @@ func (c *Column{{.TypeName}}) Get(idx int) (interface{}, error) {
 
 // AppendValue append value into column
 func(c *Column{{.TypeName}}) AppendValue(i interface{}) error {
+	{{- if or (eq .TypeName "Float16Vector") (eq .TypeName "BFloat16Vector") }}
+	if v, ok := i.({{.TypeName}}); ok {
+		i = []byte(v)
+	}
+	{{- end }}
 	v, ok := i.({{.TypeDef}})
 	if !ok {
 		return fmt.Errorf("invalid type, expected {{.TypeDef}}, got %T", i)
@@ -208,6 +133,14 @@ func (c *Column{{.TypeName}}) FieldData() *schema.FieldData {
 			Data: &schema.VectorField_BinaryVector{
 				BinaryVector: data,
 			},
+			{{else if eq .TypeName "Float16Vector" }}
+			Data: &schema.VectorField_Float16Vector{
+				Float16Vector: data,
+			},
+			{{else if eq .TypeName "BFloat16Vector" }}
+			Data: &schema.VectorField_Bfloat16Vector{
+				Bfloat16Vector: data,
+			},
 			{{else}}
 			Data: &schema.VectorField_FloatVector{
 				FloatVector: &schema.FloatArray{
@@ -220,6 +153,34 @@ func (c *Column{{.TypeName}}) FieldData() *schema.FieldData {
 	return fd
 }
 
+// Slice returns column of values in [begin, end), which shares memory with c
+func (c *Column{{.TypeName}}) Slice(begin, end int) Column {
+	return NewColumn{{.TypeName}}(c.name, c.dim, c.values[begin:end:end])
+}
+
+// Take returns column of values at indices
+func (c *Column{{.TypeName}}) Take(indices []int) (Column, error) {
+	values, err := takeValues(c.values, indices)
+	if err != nil {
+		return nil, err
+	}
+	return NewColumn{{.TypeName}}(c.name, c.dim, values), nil
+}
+
+// Filter returns column of values which keep returns true
+func (c *Column{{.TypeName}}) Filter(keep func(idx int) bool) Column {
+	return NewColumn{{.TypeName}}(c.name, c.dim, filterValues(c.values, keep))
+}
+
+// Concat returns column of values in c followed by values in other
+func (c *Column{{.TypeName}}) Concat(other Column) (Column, error) {
+	o, ok := other.(*Column{{.TypeName}})
+	if !ok || o.dim != c.dim {
+		return nil, errConcatNotMatch(c, other)
+	}
+	return NewColumn{{.TypeName}}(c.name, c.dim, concatValues(c.values, o.values)), nil
+}
+
 // NewColumn{{.TypeName}} auto generated constructor
 func NewColumn{{.TypeName}}(name string, dim int, values []{{.TypeDef}}) *Column{{.TypeName}} {
 	return &Column{{.TypeName}} {
@@ -350,6 +311,44 @@ func TestFieldData{{.TypeName}}Column(t *testing.T) {
 		assert.Equal(t, len, column.Len())
 		assert.Equal(t, FieldType{{.TypeName}}, column.Type())
 	})
+
+	t.Run("nullable round trip", func(t *testing.T) {
+		validData := make([]bool, len)
+		validCount := 0
+		for i := range validData {
+			validData[i] = i%3 != 0
+			if validData[i] {
+				validCount++
+			}
+		}
+		_, err := NewNullableColumn{{.TypeName}}(name, make([]{{.TypeDef}}, len), validData[1:])
+		assert.Error(t, err)
+		column, err := NewNullableColumn{{.TypeName}}(name, make([]{{.TypeDef}}, len), validData)
+		assert.NoError(t, err)
+
+		fd := column.FieldData()
+		assert.Len(t, fd.GetScalars().Get{{.PbName}}Data().GetData(), validCount)
+		assert.Equal(t, validData, fd.GetValidData())
+
+		result, err := FieldDataColumn(fd, 1, -1)
+		assert.NoError(t, err)
+		assert.Equal(t, len-1, result.Len())
+		for i := 1; i < len; i++ {
+			isNull, err := result.(*Column{{.TypeName}}).IsNull(i - 1)
+			assert.NoError(t, err)
+			assert.Equal(t, !validData[i], isNull)
+			v, err := result.Get(i - 1)
+			assert.NoError(t, err)
+			_, valueErr := result.(*Column{{.TypeName}}).ValueByIdx(i - 1)
+			if validData[i] {
+				assert.NotNil(t, v)
+				assert.NoError(t, valueErr)
+			} else {
+				assert.Nil(t, v)
+				assert.ErrorIs(t, valueErr, ErrNullValue)
+			}
+		}
+	})
 }
 {{end}}{{end}}
 `))
@@ -377,6 +376,7 @@ func TestColumn{{.TypeName}}(t *testing.T) {
 	v := make([]{{.TypeDef}},0, columnLen)
 	dlen := dim
 	{{if eq .TypeName "BinaryVector" }}dlen /= 8{{end}}
+	{{- if or (eq .TypeName "Float16Vector") (eq .TypeName "BFloat16Vector") }}dlen *= 2{{end}}
 	
 	for i := 0; i < columnLen; i++ {
 		entry := make({{.TypeDef}}, dlen)
@@ -433,6 +433,263 @@ func TestColumn{{.TypeName}}(t *testing.T) {
 {{end}}{{end}}
 `))
 
+var arrayColumnTemplate = template.Must(template.New("").Parse(`// Code generated by go generate; DO NOT EDIT
+// This file is generated by go generate
+
+package entity
+
+import (
+	"errors"
+	"fmt"
+
+	schema "github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+)
+
+// ArrayColumn is the column of array field, which contains elements of ElementType.
+type ArrayColumn interface {
+	Column
+	ElementType() FieldType
+}
+{{ range .Types }}{{with .}}
+// Column{{.TypeName}}Array generated columns type for array of {{.TypeName}}
+type Column{{.TypeName}}Array struct {
+	ColumnBase
+	name   string
+	values [][]{{.TypeDef}}
+}
+
+// Name returns column name
+func (c *Column{{.TypeName}}Array) Name() string {
+	return c.name
+}
+
+// Type returns column FieldType
+func (c *Column{{.TypeName}}Array) Type() FieldType {
+	return FieldTypeArray
+}
+
+// ElementType returns the FieldType of array elements
+func (c *Column{{.TypeName}}Array) ElementType() FieldType {
+	return FieldType{{.TypeName}}
+}
+
+// Len returns column values length
+func (c *Column{{.TypeName}}Array) Len() int {
+	return len(c.values)
+}
+
+// Get returns value at index as interface{}.
+func (c *Column{{.TypeName}}Array) Get(idx int) (interface{}, error) {
+	if idx < 0 || idx >= c.Len() {
+		return nil, errors.New("index out of range")
+	}
+	return c.values[idx], nil
+}
+
+// FieldData return column data mapped to schema.FieldData
+func (c *Column{{.TypeName}}Array) FieldData() *schema.FieldData {
+	fd := &schema.FieldData{
+		Type: schema.DataType_Array,
+		FieldName: c.name,
+	}
+	data := make([]*schema.ScalarField, 0, c.Len())
+	for _, array := range c.values {
+		converted := make([]{{.PbType}}, 0, len(array))
+		for _, v := range array {
+			converted = append(converted, {{.PbType}}(v))
+		}
+		data = append(data, &schema.ScalarField{
+			Data: &schema.ScalarField_{{.PbName}}Data{
+				{{.PbName}}Data: &schema.{{.PbName}}Array{
+					Data: converted,
+				},
+			},
+		})
+	}
+	fd.Field = &schema.FieldData_Scalars{
+		Scalars: &schema.ScalarField{
+			Data: &schema.ScalarField_ArrayData{
+				ArrayData: &schema.ArrayArray{
+					Data: data,
+					ElementType: schema.DataType_{{.TypeName}},
+				},
+			},
+		},
+	}
+	return fd
+}
+
+// ValueByIdx returns value of the provided index
+// error occurs when index out of range
+func (c *Column{{.TypeName}}Array) ValueByIdx(idx int) ([]{{.TypeDef}}, error) {
+	var r []{{.TypeDef}} // use default value
+	if idx < 0 || idx >= c.Len() {
+		return r, errors.New("index out of range")
+	}
+	return c.values[idx], nil
+}
+
+// AppendValue append value into column
+func(c *Column{{.TypeName}}Array) AppendValue(i interface{}) error {
+	v, ok := i.([]{{.TypeDef}})
+	if !ok {
+		return fmt.Errorf("invalid type, expected []{{.TypeDef}}, got %T", i)
+	}
+	c.values = append(c.values, v)
+
+	return nil
+}
+
+// Data returns column data
+func (c *Column{{.TypeName}}Array) Data() [][]{{.TypeDef}} {
+	return c.values
+}
+
+// Slice returns column of values in [begin, end), which shares memory with c
+func (c *Column{{.TypeName}}Array) Slice(begin, end int) Column {
+	return NewColumn{{.TypeName}}Array(c.name, c.values[begin:end:end])
+}
+
+// Take returns column of values at indices
+func (c *Column{{.TypeName}}Array) Take(indices []int) (Column, error) {
+	values, err := takeValues(c.values, indices)
+	if err != nil {
+		return nil, err
+	}
+	return NewColumn{{.TypeName}}Array(c.name, values), nil
+}
+
+// Filter returns column of values which keep returns true
+func (c *Column{{.TypeName}}Array) Filter(keep func(idx int) bool) Column {
+	return NewColumn{{.TypeName}}Array(c.name, filterValues(c.values, keep))
+}
+
+// Concat returns column of values in c followed by values in other
+func (c *Column{{.TypeName}}Array) Concat(other Column) (Column, error) {
+	o, ok := other.(*Column{{.TypeName}}Array)
+	if !ok {
+		return nil, errConcatNotMatch(c, other)
+	}
+	return NewColumn{{.TypeName}}Array(c.name, concatValues(c.values, o.values)), nil
+}
+
+// NewColumn{{.TypeName}}Array auto generated constructor
+func NewColumn{{.TypeName}}Array(name string, values [][]{{.TypeDef}}) *Column{{.TypeName}}Array {
+	return &Column{{.TypeName}}Array {
+		name: name,
+		values: values,
+	}
+}
+
+func parse{{.TypeName}}ArrayData(name string, arrays []*schema.ScalarField) (ArrayColumn, error) {
+	values := make([][]{{.TypeDef}}, 0, len(arrays))
+	for _, array := range arrays {
+		data := array.Get{{.PbName}}Data()
+		if data == nil {
+			return nil, errFieldDataTypeNotMatch
+		}
+		converted := make([]{{.TypeDef}}, 0, len(data.GetData()))
+		for _, v := range data.GetData() {
+			converted = append(converted, {{.TypeDef}}(v))
+		}
+		values = append(values, converted)
+	}
+	return NewColumn{{.TypeName}}Array(name, values), nil
+}
+{{end}}{{end}}
+// parseArrayData converts the arrays of field data to column by element type
+func parseArrayData(name string, elementType schema.DataType, arrays []*schema.ScalarField) (ArrayColumn, error) {
+	switch elementType {
+	{{ range .Types }}{{with .}}case schema.DataType_{{.TypeName}}:
+		return parse{{.TypeName}}ArrayData(name, arrays)
+	{{end}}{{end}}default:
+		return nil, fmt.Errorf("unsupported element type %s", elementType)
+	}
+}
+
+// NewArrayColumn creates an empty array column with element type
+func NewArrayColumn(name string, elementType FieldType, capacity int) (ArrayColumn, error) {
+	switch elementType {
+	{{ range .Types }}{{with .}}case FieldType{{.TypeName}}:
+		return NewColumn{{.TypeName}}Array(name, make([][]{{.TypeDef}}, 0, capacity)), nil
+	{{end}}{{end}}default:
+		return nil, fmt.Errorf("unsupported element type %s", elementType.Name())
+	}
+}
+`))
+
+var arrayColumnTestTemplate = template.Must(template.New("").Parse(`// Code generated by go generate; DO NOT EDIT
+// This file is generated by go generated
+
+package entity
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	schema "github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/stretchr/testify/assert"
+)
+{{ range .Types }}{{with.}}
+func TestColumn{{.TypeName}}Array(t *testing.T) {
+	columnName := fmt.Sprintf("column_{{.TypeName}}Array_%d", rand.Int())
+	columnLen := 8 + rand.Intn(10)
+
+	v := make([][]{{.TypeDef}}, columnLen)
+	for i := range v {
+		v[i] = make([]{{.TypeDef}}, rand.Intn(5))
+	}
+	column := NewColumn{{.TypeName}}Array(columnName, v)
+
+	t.Run("test column attribute", func(t *testing.T) {
+		assert.Equal(t, columnName, column.Name())
+		assert.Equal(t, FieldTypeArray, column.Type())
+		assert.Equal(t, FieldType{{.TypeName}}, column.ElementType())
+		assert.Equal(t, columnLen, column.Len())
+		assert.EqualValues(t, v, column.Data())
+	})
+
+	t.Run("test column field data", func(t *testing.T) {
+		fd := column.FieldData()
+		assert.NotNil(t, fd)
+		assert.Equal(t, fd.GetFieldName(), columnName)
+		assert.Equal(t, schema.DataType_{{.TypeName}}, fd.GetScalars().GetArrayData().GetElementType())
+
+		c, err := FieldDataColumn(fd, 0, -1)
+		assert.NoError(t, err)
+		assert.Equal(t, column, c)
+
+		c, err = FieldDataColumn(fd, 1, 3)
+		assert.NoError(t, err)
+		assert.Equal(t, NewColumn{{.TypeName}}Array(columnName, v[1:3]), c)
+	})
+
+	t.Run("test column value by idx", func(t *testing.T) {
+		_, err := column.ValueByIdx(-1)
+		assert.NotNil(t, err)
+		_, err = column.ValueByIdx(columnLen)
+		assert.NotNil(t, err)
+		for i := 0; i < columnLen; i++ {
+			v, err := column.ValueByIdx(i)
+			assert.Nil(t, err)
+			assert.Equal(t, column.values[i], v)
+		}
+	})
+
+	t.Run("test append value", func(t *testing.T) {
+		column, err := NewArrayColumn(columnName, FieldType{{.TypeName}}, 0)
+		assert.NoError(t, err)
+		err = column.AppendValue([]{{.TypeDef}}{})
+		assert.NoError(t, err)
+		err = column.AppendValue(struct{}{})
+		assert.Error(t, err)
+		assert.Equal(t, 1, column.Len())
+	})
+}
+{{end}}{{end}}
+`))
+
 func main() {
 	scalarFieldTypes := []entity.FieldType{
 		entity.FieldTypeBool,
@@ -444,9 +701,23 @@ func main() {
 		entity.FieldTypeDouble,
 		entity.FieldTypeString,
 	}
+	// ColumnString is a distinct type defined in columns_string.go, its tests are generated as the others
+	scalarColumnTypes := scalarFieldTypes[:len(scalarFieldTypes)-1]
+	arrayElementTypes := []entity.FieldType{
+		entity.FieldTypeBool,
+		entity.FieldTypeInt8,
+		entity.FieldTypeInt16,
+		entity.FieldTypeInt32,
+		entity.FieldTypeInt64,
+		entity.FieldTypeFloat,
+		entity.FieldTypeDouble,
+		entity.FieldTypeVarChar,
+	}
 	vectorFieldTypes := []entity.FieldType{
 		entity.FieldTypeBinaryVector,
 		entity.FieldTypeFloatVector,
+		entity.FieldTypeFloat16Vector,
+		entity.FieldTypeBFloat16Vector,
 	}
 
 	pf := func(ft entity.FieldType) interface{} {
@@ -463,6 +734,24 @@ func main() {
 			PbType:   pbType,
 		}
 	}
+	// varchar elements are stored in StringArray
+	arrayPf := func(ft entity.FieldType) interface{} {
+		pbName, pbType := ft.PbFieldType()
+		if ft == entity.FieldTypeVarChar {
+			pbName = "String"
+		}
+		return struct {
+			TypeName string
+			TypeDef  string
+			PbName   string
+			PbType   string
+		}{
+			TypeName: ft.Name(),
+			TypeDef:  ft.String(),
+			PbName:   pbName,
+			PbType:   pbType,
+		}
+	}
 	fn := func(fn string, types []entity.FieldType, tmpl *template.Template, pf func(entity.FieldType) interface{}) {
 		params := struct {
 			Types []interface{}
@@ -498,8 +787,10 @@ func main() {
 		defer f.Close()
 		tmpl.Execute(f, params)
 	}
-	fn("columns_scalar_gen.go", scalarFieldTypes, scalarColumnTemplate, pf)
+	fn("columns_scalar_gen.go", scalarColumnTypes, scalarColumnTemplate, pf)
 	fn("columns_vector_gen.go", vectorFieldTypes, vectorColumnTemplate, pf)
+	fn("columns_array_gen.go", arrayElementTypes, arrayColumnTemplate, arrayPf)
 	fnTest("columns_scalar_gen_test.go", scalarFieldTypes, scalarColumnTestTemplate, pf)
 	fnTest("columns_vector_gen_test.go", vectorFieldTypes, vectorColumnTestTemplate, pf)
+	fnTest("columns_array_gen_test.go", arrayElementTypes, arrayColumnTestTemplate, arrayPf)
 }
@@ -107,7 +107,7 @@ func TestIndex{{.IdxName}}(t *testing.T){
 	{{range .ConstructParams}}{{with.}}
 	var {{.Name}} {{.ParamType}}{{end}}{{end}}
 
-	{{if SContains $idx.IdxName "Bin" }}mt := HAMMING{{else}}mt := L2{{end}}
+	{{if SContains $idx.IdxName "Bin" }}mt := HAMMING{{else if SContains $idx.IdxName "Sparse" }}mt := IP{{else}}mt := L2{{end}}
 	
 
 	t.Run("valid usage case", func(t *testing.T){
@@ -294,6 +294,7 @@ type vectorTypeSupport int8
 const (
 	floatVectorSupport  vectorTypeSupport = 1
 	binaryVectorSupport vectorTypeSupport = 1 << 1
+	sparseVectorSupport vectorTypeSupport = 1 << 2
 )
 
 func main() {
@@ -639,6 +640,74 @@ func main() {
 					"level = -1",
 				},
 			},
+			// SPARSE_INVERTED_INDEX
+			{
+				IdxName:       "SparseInverted",
+				IdxType:       entity.SparseInverted,
+				VectorSupport: int8(sparseVectorSupport),
+				ConstructParams: []idxParam{
+					{
+						Name:           "drop_ratio_build",
+						Type:           "float64",
+						ValidationRule: "[0, 1]",
+					},
+				},
+				SearchParams: []idxParam{
+					{
+						Name:           "drop_ratio_search",
+						Type:           "float64",
+						ValidationRule: "[0, 1]",
+					},
+				},
+				ValidExamples: []string{
+					"drop_ratio_build = 0.2",
+				},
+				InvalidExamples: []string{
+					"drop_ratio_build = -0.1",
+					"drop_ratio_build = 1.1",
+				},
+				ValidSearchParams: []string{
+					"drop_ratio_search = 0.2",
+				},
+				InvalidSearchParams: []string{
+					"drop_ratio_search = -0.1",
+					"drop_ratio_search = 1.1",
+				},
+			},
+			// SPARSE_WAND
+			{
+				IdxName:       "SparseWAND",
+				IdxType:       entity.SparseWAND,
+				VectorSupport: int8(sparseVectorSupport),
+				ConstructParams: []idxParam{
+					{
+						Name:           "drop_ratio_build",
+						Type:           "float64",
+						ValidationRule: "[0, 1]",
+					},
+				},
+				SearchParams: []idxParam{
+					{
+						Name:           "drop_ratio_search",
+						Type:           "float64",
+						ValidationRule: "[0, 1]",
+					},
+				},
+				ValidExamples: []string{
+					"drop_ratio_build = 0.2",
+				},
+				InvalidExamples: []string{
+					"drop_ratio_build = -0.1",
+					"drop_ratio_build = 1.1",
+				},
+				ValidSearchParams: []string{
+					"drop_ratio_search = 0.2",
+				},
+				InvalidSearchParams: []string{
+					"drop_ratio_search = -0.1",
+					"drop_ratio_search = 1.1",
+				},
+			},
 		},
 	}
 
@@ -591,3 +591,115 @@ func NewIndexAUTOINDEX(metricType MetricType, ) (*IndexAUTOINDEX, error) {
 	}, nil
 }
 
+
+var _ Index = &IndexSparseInverted{}
+
+// IndexSparseInverted idx type for SPARSE_INVERTED_INDEX
+type IndexSparseInverted struct { //auto generated fields
+	drop_ratio_build float64
+	metricType MetricType
+}
+
+// Name returns index type name, implementing Index interface
+func(i *IndexSparseInverted) Name() string {
+	return "SparseInverted"
+}
+
+// IndexType returns IndexType, implementing Index interface
+func(i *IndexSparseInverted) IndexType() IndexType {
+	return IndexType("SPARSE_INVERTED_INDEX")
+}
+
+// SupportBinary returns whether index type support binary vector
+func(i *IndexSparseInverted) SupportBinary() bool {
+	return 4 & 2 > 0
+}
+
+// Params returns index construction params, implementing Index interface
+func(i *IndexSparseInverted) Params() map[string]string {
+	params := map[string]string {//auto generated mapping 
+		"drop_ratio_build": fmt.Sprintf("%v",i.drop_ratio_build),
+	}
+	bs, _ := json.Marshal(params)
+	return map[string]string {
+		"params": string(bs),
+		"index_type": string(i.IndexType()),
+		"metric_type": string(i.metricType),
+	}
+}
+
+// NewIndexSparseInverted create index with construction parameters
+func NewIndexSparseInverted(metricType MetricType, 
+	drop_ratio_build float64,
+) (*IndexSparseInverted, error) {
+	// auto generate parameters validation code, if any
+	if drop_ratio_build < 0 {
+		return nil, errors.New("drop_ratio_build not valid")
+	}
+	if drop_ratio_build > 1 {
+		return nil, errors.New("drop_ratio_build not valid")
+	}
+	
+	return &IndexSparseInverted{ 
+	//auto generated setting
+	drop_ratio_build: drop_ratio_build,
+	metricType: metricType,
+	}, nil
+}
+
+
+var _ Index = &IndexSparseWAND{}
+
+// IndexSparseWAND idx type for SPARSE_WAND
+type IndexSparseWAND struct { //auto generated fields
+	drop_ratio_build float64
+	metricType MetricType
+}
+
+// Name returns index type name, implementing Index interface
+func(i *IndexSparseWAND) Name() string {
+	return "SparseWAND"
+}
+
+// IndexType returns IndexType, implementing Index interface
+func(i *IndexSparseWAND) IndexType() IndexType {
+	return IndexType("SPARSE_WAND")
+}
+
+// SupportBinary returns whether index type support binary vector
+func(i *IndexSparseWAND) SupportBinary() bool {
+	return 4 & 2 > 0
+}
+
+// Params returns index construction params, implementing Index interface
+func(i *IndexSparseWAND) Params() map[string]string {
+	params := map[string]string {//auto generated mapping 
+		"drop_ratio_build": fmt.Sprintf("%v",i.drop_ratio_build),
+	}
+	bs, _ := json.Marshal(params)
+	return map[string]string {
+		"params": string(bs),
+		"index_type": string(i.IndexType()),
+		"metric_type": string(i.metricType),
+	}
+}
+
+// NewIndexSparseWAND create index with construction parameters
+func NewIndexSparseWAND(metricType MetricType, 
+	drop_ratio_build float64,
+) (*IndexSparseWAND, error) {
+	// auto generate parameters validation code, if any
+	if drop_ratio_build < 0 {
+		return nil, errors.New("drop_ratio_build not valid")
+	}
+	if drop_ratio_build > 1 {
+		return nil, errors.New("drop_ratio_build not valid")
+	}
+	
+	return &IndexSparseWAND{ 
+	//auto generated setting
+	drop_ratio_build: drop_ratio_build,
+	metricType: metricType,
+	}, nil
+}
+
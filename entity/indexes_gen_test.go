@@ -462,3 +462,85 @@ func TestIndexAUTOINDEX(t *testing.T){
 	})
 }
 
+func TestIndexSparseInverted(t *testing.T){
+	
+	var drop_ratio_build float64
+
+	mt := IP
+	
+
+	t.Run("valid usage case", func(t *testing.T){
+		
+		drop_ratio_build = 0.2
+		idx0, err := NewIndexSparseInverted(mt, 
+			drop_ratio_build,
+		)
+		assert.Nil(t, err)
+		assert.NotNil(t, idx0)
+		assert.Equal(t, "SparseInverted", idx0.Name())
+		assert.EqualValues(t, "SPARSE_INVERTED_INDEX", idx0.IndexType())
+		assert.NotNil(t, idx0.Params())
+		assert.False(t, idx0.SupportBinary())
+		
+	})
+
+	t.Run("invalid usage case", func(t *testing.T){
+		
+		drop_ratio_build = -0.1
+		idx0, err := NewIndexSparseInverted(mt, 
+			drop_ratio_build,
+		)
+		assert.NotNil(t, err)
+		assert.Nil(t, idx0)
+		
+		drop_ratio_build = 1.1
+		idx1, err := NewIndexSparseInverted(mt, 
+			drop_ratio_build,
+		)
+		assert.NotNil(t, err)
+		assert.Nil(t, idx1)
+		
+	})
+}
+
+func TestIndexSparseWAND(t *testing.T){
+	
+	var drop_ratio_build float64
+
+	mt := IP
+	
+
+	t.Run("valid usage case", func(t *testing.T){
+		
+		drop_ratio_build = 0.2
+		idx0, err := NewIndexSparseWAND(mt, 
+			drop_ratio_build,
+		)
+		assert.Nil(t, err)
+		assert.NotNil(t, idx0)
+		assert.Equal(t, "SparseWAND", idx0.Name())
+		assert.EqualValues(t, "SPARSE_WAND", idx0.IndexType())
+		assert.NotNil(t, idx0.Params())
+		assert.False(t, idx0.SupportBinary())
+		
+	})
+
+	t.Run("invalid usage case", func(t *testing.T){
+		
+		drop_ratio_build = -0.1
+		idx0, err := NewIndexSparseWAND(mt, 
+			drop_ratio_build,
+		)
+		assert.NotNil(t, err)
+		assert.Nil(t, idx0)
+		
+		drop_ratio_build = 1.1
+		idx1, err := NewIndexSparseWAND(mt, 
+			drop_ratio_build,
+		)
+		assert.NotNil(t, err)
+		assert.Nil(t, idx1)
+		
+	})
+}
+
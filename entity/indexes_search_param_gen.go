@@ -328,3 +328,67 @@ func NewIndexAUTOINDEXSearchParam(
 	}, nil
 }
 
+var _ SearchParam = &IndexSparseInvertedSearchParam{}
+
+// IndexSparseInvertedSearchParam search param struct for index type SPARSE_INVERTED_INDEX
+type IndexSparseInvertedSearchParam struct { //auto generated fields
+	drop_ratio_search float64
+}
+
+// Params returns index construction params, implementing Index interface
+func(i *IndexSparseInvertedSearchParam) Params() map[string]interface{} {
+	return map[string]interface{} {//auto generated mapping 
+		"drop_ratio_search": i.drop_ratio_search,
+	}
+}
+
+// NewIndexSparseInvertedSearchParam create index search param
+func NewIndexSparseInvertedSearchParam(
+	drop_ratio_search float64,
+) (*IndexSparseInvertedSearchParam, error) {
+	// auto generate parameters validation code, if any
+	if drop_ratio_search < 0 {
+		return nil, errors.New("drop_ratio_search not valid")
+	}
+	if drop_ratio_search > 1 {
+		return nil, errors.New("drop_ratio_search not valid")
+	}
+	
+	return &IndexSparseInvertedSearchParam{ 
+	//auto generated setting
+	drop_ratio_search: drop_ratio_search,
+	}, nil
+}
+
+var _ SearchParam = &IndexSparseWANDSearchParam{}
+
+// IndexSparseWANDSearchParam search param struct for index type SPARSE_WAND
+type IndexSparseWANDSearchParam struct { //auto generated fields
+	drop_ratio_search float64
+}
+
+// Params returns index construction params, implementing Index interface
+func(i *IndexSparseWANDSearchParam) Params() map[string]interface{} {
+	return map[string]interface{} {//auto generated mapping 
+		"drop_ratio_search": i.drop_ratio_search,
+	}
+}
+
+// NewIndexSparseWANDSearchParam create index search param
+func NewIndexSparseWANDSearchParam(
+	drop_ratio_search float64,
+) (*IndexSparseWANDSearchParam, error) {
+	// auto generate parameters validation code, if any
+	if drop_ratio_search < 0 {
+		return nil, errors.New("drop_ratio_search not valid")
+	}
+	if drop_ratio_search > 1 {
+		return nil, errors.New("drop_ratio_search not valid")
+	}
+	
+	return &IndexSparseWANDSearchParam{ 
+	//auto generated setting
+	drop_ratio_search: drop_ratio_search,
+	}, nil
+}
+
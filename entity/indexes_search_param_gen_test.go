@@ -377,3 +377,75 @@ func TestIndexAUTOINDEXSearchParam(t *testing.T) {
 	
 }
 
+func TestIndexSparseInvertedSearchParam(t *testing.T) {
+	
+	var drop_ratio_search float64
+
+	t.Run("valid usage case", func(t *testing.T){
+		
+		drop_ratio_search = 0.2
+		idx0, err := NewIndexSparseInvertedSearchParam(
+			drop_ratio_search,
+		)
+		assert.Nil(t, err)
+		assert.NotNil(t, idx0)
+		assert.NotNil(t, idx0.Params())
+		
+	})
+	
+	t.Run("invalid usage case", func(t *testing.T){
+		
+		drop_ratio_search = -0.1
+		idx0, err := NewIndexSparseInvertedSearchParam(
+			drop_ratio_search,
+		)
+		assert.NotNil(t, err)
+		assert.Nil(t, idx0)
+		
+		drop_ratio_search = 1.1
+		idx1, err := NewIndexSparseInvertedSearchParam(
+			drop_ratio_search,
+		)
+		assert.NotNil(t, err)
+		assert.Nil(t, idx1)
+		
+	})
+	
+}
+
+func TestIndexSparseWANDSearchParam(t *testing.T) {
+	
+	var drop_ratio_search float64
+
+	t.Run("valid usage case", func(t *testing.T){
+		
+		drop_ratio_search = 0.2
+		idx0, err := NewIndexSparseWANDSearchParam(
+			drop_ratio_search,
+		)
+		assert.Nil(t, err)
+		assert.NotNil(t, idx0)
+		assert.NotNil(t, idx0.Params())
+		
+	})
+	
+	t.Run("invalid usage case", func(t *testing.T){
+		
+		drop_ratio_search = -0.1
+		idx0, err := NewIndexSparseWANDSearchParam(
+			drop_ratio_search,
+		)
+		assert.NotNil(t, err)
+		assert.Nil(t, idx0)
+		
+		drop_ratio_search = 1.1
+		idx1, err := NewIndexSparseWANDSearchParam(
+			drop_ratio_search,
+		)
+		assert.NotNil(t, err)
+		assert.Nil(t, idx1)
+		
+	})
+	
+}
+
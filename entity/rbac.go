@@ -4,12 +4,14 @@ import common "github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 
 // User is the model for RBAC user object.
 type User struct {
-	Name string
+	Name  string
+	Roles []string // names of the roles assigned to user, only returned by SelectUser
 }
 
 // Role is the model for RBAC role object.
 type Role struct {
-	Name string
+	Name  string
+	Users []string // names of the users assigned to role, only returned by SelectRole
 }
 
 // PriviledgeObjectType is an alias of common.ObjectType.
@@ -24,3 +26,108 @@ const (
 	// PriviledegeObjectTypeGlobal const value for Global.
 	PriviledegeObjectTypeGlobal PriviledgeObjectType = PriviledgeObjectType(common.ObjectType_Global)
 )
+
+// String returns the object type name used by server, e.g. "Collection".
+func (t PriviledgeObjectType) String() string {
+	return common.ObjectType(t).String()
+}
+
+// Privileges returns the well-known privileges which could be granted on objects of the type.
+// The list is advisory only, server may accept privileges not listed here.
+func (t PriviledgeObjectType) Privileges() []Privilege {
+	return append([]Privilege(nil), objectTypePrivileges[t]...)
+}
+
+// Supports returns whether the privilege is one of the well-known privileges of the type,
+// privileges are checked by server when granted, not by this method.
+func (t PriviledgeObjectType) Supports(privilege Privilege) bool {
+	for _, p := range objectTypePrivileges[t] {
+		if p == privilege {
+			return true
+		}
+	}
+	return false
+}
+
+// Privilege is the name of an operation which could be granted to role,
+// the value is the name of common.ObjectPrivilege without "Privilege" prefix.
+type Privilege string
+
+// Privileges granted on collection objects, the object name is the collection name or "*" for all collections.
+const (
+	PrivilegeCreateIndex        Privilege = "CreateIndex"
+	PrivilegeDropIndex          Privilege = "DropIndex"
+	PrivilegeIndexDetail        Privilege = "IndexDetail"
+	PrivilegeLoad               Privilege = "Load"
+	PrivilegeGetLoadingProgress Privilege = "GetLoadingProgress"
+	PrivilegeGetLoadState       Privilege = "GetLoadState"
+	PrivilegeRelease            Privilege = "Release"
+	PrivilegeInsert             Privilege = "Insert"
+	PrivilegeDelete             Privilege = "Delete"
+	PrivilegeUpsert             Privilege = "Upsert"
+	PrivilegeSearch             Privilege = "Search"
+	PrivilegeFlush              Privilege = "Flush"
+	PrivilegeQuery              Privilege = "Query"
+	PrivilegeGetStatistics      Privilege = "GetStatistics"
+	PrivilegeCompaction         Privilege = "Compaction"
+	PrivilegeImport             Privilege = "Import"
+	PrivilegeLoadBalance        Privilege = "LoadBalance"
+)
+
+// Privileges granted on the global object, the object name is "*".
+const (
+	PrivilegeAll                   Privilege = "All"
+	PrivilegeCreateCollection      Privilege = "CreateCollection"
+	PrivilegeDropCollection        Privilege = "DropCollection"
+	PrivilegeDescribeCollection    Privilege = "DescribeCollection"
+	PrivilegeShowCollections       Privilege = "ShowCollections"
+	PrivilegeRenameCollection      Privilege = "RenameCollection"
+	PrivilegeFlushAll              Privilege = "FlushAll"
+	PrivilegeCreateOwnership       Privilege = "CreateOwnership"
+	PrivilegeDropOwnership         Privilege = "DropOwnership"
+	PrivilegeSelectOwnership       Privilege = "SelectOwnership"
+	PrivilegeManageOwnership       Privilege = "ManageOwnership"
+	PrivilegeCreateResourceGroup   Privilege = "CreateResourceGroup"
+	PrivilegeDropResourceGroup     Privilege = "DropResourceGroup"
+	PrivilegeDescribeResourceGroup Privilege = "DescribeResourceGroup"
+	PrivilegeListResourceGroups    Privilege = "ListResourceGroups"
+	PrivilegeTransferNode          Privilege = "TransferNode"
+	PrivilegeTransferReplica       Privilege = "TransferReplica"
+	PrivilegeCreateDatabase        Privilege = "CreateDatabase"
+	PrivilegeDropDatabase          Privilege = "DropDatabase"
+	PrivilegeListDatabases         Privilege = "ListDatabases"
+)
+
+// Privileges granted on user objects, the object name is the user name or "*" for all users.
+const (
+	PrivilegeUpdateUser Privilege = "UpdateUser"
+	PrivilegeSelectUser Privilege = "SelectUser"
+)
+
+var objectTypePrivileges = map[PriviledgeObjectType][]Privilege{
+	PriviledegeObjectTypeCollection: {
+		PrivilegeCreateIndex, PrivilegeDropIndex, PrivilegeIndexDetail, PrivilegeLoad, PrivilegeGetLoadingProgress,
+		PrivilegeGetLoadState, PrivilegeRelease, PrivilegeInsert, PrivilegeDelete, PrivilegeUpsert, PrivilegeSearch,
+		PrivilegeFlush, PrivilegeQuery, PrivilegeGetStatistics, PrivilegeCompaction, PrivilegeImport, PrivilegeLoadBalance,
+	},
+	PriviledegeObjectTypeGlobal: {
+		PrivilegeAll, PrivilegeCreateCollection, PrivilegeDropCollection, PrivilegeDescribeCollection,
+		PrivilegeShowCollections, PrivilegeRenameCollection, PrivilegeFlushAll, PrivilegeCreateOwnership,
+		PrivilegeDropOwnership, PrivilegeSelectOwnership, PrivilegeManageOwnership, PrivilegeCreateResourceGroup,
+		PrivilegeDropResourceGroup, PrivilegeDescribeResourceGroup, PrivilegeListResourceGroups, PrivilegeTransferNode,
+		PrivilegeTransferReplica, PrivilegeCreateDatabase, PrivilegeDropDatabase, PrivilegeListDatabases,
+	},
+	PriviledegeObjectTypeUser: {
+		PrivilegeUpdateUser, PrivilegeSelectUser,
+	},
+}
+
+// Grant is the model for a privilege granted to role on an object.
+type Grant struct {
+	Role       string
+	ObjectType PriviledgeObjectType
+	Object     string // object name, "*" for all objects of the type
+	Privilege  Privilege
+	Grantor    string // name of the user who granted the privilege
+	DBName     string
+}
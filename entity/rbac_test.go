@@ -0,0 +1,30 @@
+package entity
+
+import (
+	"testing"
+
+	common "github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPriviledgeObjectType(t *testing.T) {
+	assert.Equal(t, "Collection", PriviledegeObjectTypeCollection.String())
+	assert.Equal(t, "Global", PriviledegeObjectTypeGlobal.String())
+	assert.Equal(t, "User", PriviledegeObjectTypeUser.String())
+
+	assert.True(t, PriviledegeObjectTypeCollection.Supports(PrivilegeSearch))
+	assert.False(t, PriviledegeObjectTypeCollection.Supports(PrivilegeCreateCollection))
+	assert.True(t, PriviledegeObjectTypeGlobal.Supports(PrivilegeAll))
+	assert.True(t, PriviledegeObjectTypeUser.Supports(PrivilegeSelectUser))
+	assert.False(t, PriviledegeObjectTypeUser.Supports(Privilege("Unknown")))
+
+	// privileges are the names of common.ObjectPrivilege
+	for _, objectType := range []PriviledgeObjectType{PriviledegeObjectTypeCollection, PriviledegeObjectTypeGlobal, PriviledegeObjectTypeUser} {
+		privileges := objectType.Privileges()
+		assert.NotEmpty(t, privileges)
+		for _, privilege := range privileges {
+			_, ok := common.ObjectPrivilege_value["Privilege"+string(privilege)]
+			assert.True(t, ok, privilege)
+		}
+	}
+}
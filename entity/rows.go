@@ -43,6 +43,15 @@ const (
 	// MilvusAutoID struct tag const for auto id indicator
 	MilvusAutoID = `AUTO_ID`
 
+	// MilvusArray struct tag const for array field indicator
+	MilvusArray = `ARRAY`
+
+	// ArrayMaxCapacityTag struct tag const for array field maximal capacity
+	ArrayMaxCapacityTag = `MAX_CAPACITY`
+
+	// MaxLengthTag struct tag const for varchar maximal length
+	MaxLengthTag = `MAX_LENGTH`
+
 	// DimMax dimension max value
 	DimMax = 65535
 )
@@ -176,6 +185,12 @@ func ParseSchema(r Row) (*Schema, error) {
 				return nil, fmt.Errorf("field %s is array of %v, which is not supported", f.Name, elemType)
 			}
 		case reflect.Slice:
+			if _, has := tagSettings[MilvusArray]; has {
+				if err := parseArrayField(field, ft.Elem(), tagSettings); err != nil {
+					return nil, err
+				}
+				break
+			}
 			dimStr, has := tagSettings[VectorDimTag]
 			if !has {
 				return nil, fmt.Errorf("field %s is slice but dim not provided", f.Name)
@@ -193,12 +208,24 @@ func ParseSchema(r Row) (*Schema, error) {
 			elemType := ft.Elem()
 			switch elemType.Kind() {
 			case reflect.Uint8: // []byte!
-				field.DataType = FieldTypeBinaryVector
+				switch ft {
+				case reflect.TypeOf(Float16Vector(nil)):
+					field.DataType = FieldTypeFloat16Vector
+				case reflect.TypeOf(BFloat16Vector(nil)):
+					field.DataType = FieldTypeBFloat16Vector
+				default:
+					field.DataType = FieldTypeBinaryVector
+				}
 			case reflect.Float32:
 				field.DataType = FieldTypeFloatVector
 			default:
 				return nil, fmt.Errorf("field %s is slice of %v, which is not supported", f.Name, elemType)
 			}
+		case reflect.Struct:
+			if ft != reflect.TypeOf(SparseFloatVector{}) {
+				return nil, fmt.Errorf("field %s is %v, which is not supported", field.Name, ft)
+			}
+			field.DataType = FieldTypeSparseFloatVector
 		default:
 			return nil, fmt.Errorf("field %s is %v, which is not supported", field.Name, ft)
 		}
@@ -208,6 +235,55 @@ func ParseSchema(r Row) (*Schema, error) {
 	return sch, nil
 }
 
+// parseArrayField sets the element type and params of array field from tag settings,
+// e.g. `milvus:"ARRAY;MAX_CAPACITY:16;MAX_LENGTH:64"` for []string field.
+func parseArrayField(field *Field, elemType reflect.Type, tagSettings map[string]string) error {
+	switch elemType.Kind() {
+	case reflect.Bool:
+		field.ElementType = FieldTypeBool
+	case reflect.Int8:
+		field.ElementType = FieldTypeInt8
+	case reflect.Int16:
+		field.ElementType = FieldTypeInt16
+	case reflect.Int32:
+		field.ElementType = FieldTypeInt32
+	case reflect.Int64:
+		field.ElementType = FieldTypeInt64
+	case reflect.Float32:
+		field.ElementType = FieldTypeFloat
+	case reflect.Float64:
+		field.ElementType = FieldTypeDouble
+	case reflect.String:
+		field.ElementType = FieldTypeVarChar
+	default:
+		return fmt.Errorf("field %s is array of %v, which is not supported", field.Name, elemType)
+	}
+	field.DataType = FieldTypeArray
+
+	capStr, has := tagSettings[ArrayMaxCapacityTag]
+	if !has {
+		return fmt.Errorf("field %s is array but max capacity not provided", field.Name)
+	}
+	if capacity, err := strconv.ParseInt(capStr, 10, 64); err != nil || capacity < 1 {
+		return fmt.Errorf("max capacity value %s is not valid", capStr)
+	}
+	field.TypeParams = map[string]string{
+		TypeParamMaxCapacity: capStr,
+	}
+
+	if field.ElementType == FieldTypeVarChar {
+		maxLenStr, has := tagSettings[MaxLengthTag]
+		if !has {
+			return fmt.Errorf("field %s is array of varchar but max length not provided", field.Name)
+		}
+		if maxLen, err := strconv.ParseInt(maxLenStr, 10, 64); err != nil || maxLen < 1 {
+			return fmt.Errorf("max length value %s is not valid", maxLenStr)
+		}
+		field.TypeParams[TypeParamMaxLength] = maxLenStr
+	}
+	return nil
+}
+
 // ParseTagSetting parses struct tag into map settings
 func ParseTagSetting(str string, sep string) map[string]string {
 	settings := map[string]string{}
@@ -306,6 +382,12 @@ func RowsToColumns(rows []Row, schemas ...*Schema) ([]Column, error) {
 			data := make([][]byte, 0, rowsLen)
 			col := NewColumnJSONBytes(field.Name, data)
 			nameColumns[field.Name] = col
+		case FieldTypeArray:
+			col, err := NewArrayColumn(field.Name, field.ElementType, rowsLen)
+			if err != nil {
+				return []Column{}, err
+			}
+			nameColumns[field.Name] = col
 		case FieldTypeFloatVector:
 			data := make([][]float32, 0, rowsLen)
 			dimStr, has := field.TypeParams[TypeParamDim]
@@ -330,6 +412,34 @@ func RowsToColumns(rows []Row, schemas ...*Schema) ([]Column, error) {
 			}
 			col := NewColumnBinaryVector(field.Name, int(dim), data)
 			nameColumns[field.Name] = col
+		case FieldTypeFloat16Vector:
+			data := make([][]byte, 0, rowsLen)
+			dimStr, has := field.TypeParams[TypeParamDim]
+			if !has {
+				return []Column{}, errors.New("vector field with no dim")
+			}
+			dim, err := strconv.ParseInt(dimStr, 10, 64)
+			if err != nil {
+				return []Column{}, fmt.Errorf("vector field with bad format dim: %s", err.Error())
+			}
+			col := NewColumnFloat16Vector(field.Name, int(dim), data)
+			nameColumns[field.Name] = col
+		case FieldTypeBFloat16Vector:
+			data := make([][]byte, 0, rowsLen)
+			dimStr, has := field.TypeParams[TypeParamDim]
+			if !has {
+				return []Column{}, errors.New("vector field with no dim")
+			}
+			dim, err := strconv.ParseInt(dimStr, 10, 64)
+			if err != nil {
+				return []Column{}, fmt.Errorf("vector field with bad format dim: %s", err.Error())
+			}
+			col := NewColumnBFloat16Vector(field.Name, int(dim), data)
+			nameColumns[field.Name] = col
+		case FieldTypeSparseFloatVector:
+			data := make([]SparseFloatVector, 0, rowsLen)
+			col := NewColumnSparseFloatVector(field.Name, data)
+			nameColumns[field.Name] = col
 		}
 	}
 
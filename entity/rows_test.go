@@ -56,6 +56,21 @@ type SliceBadDimStruct2 struct {
 	Vector []float32 `milvus:"dim:0"`
 }
 
+type ArrayNoCapacityStruct struct {
+	RowBase
+	Tags []int64 `milvus:"array"`
+}
+
+type ArrayNoMaxLengthStruct struct {
+	RowBase
+	Tags []string `milvus:"array;max_capacity:8"`
+}
+
+type ArrayUnsupportedStruct struct {
+	RowBase
+	Tags [][]int64 `milvus:"array;max_capacity:8"`
+}
+
 func TestParseSchema(t *testing.T) {
 
 	t.Run("invalid cases", func(t *testing.T) {
@@ -109,6 +124,21 @@ func TestParseSchema(t *testing.T) {
 		assert.Nil(t, sch)
 		assert.NotNil(t, err)
 
+		// array with no max capacity
+		sch, err = ParseSchema(&ArrayNoCapacityStruct{})
+		assert.Nil(t, sch)
+		assert.NotNil(t, err)
+
+		// varchar array with no max length
+		sch, err = ParseSchema(&ArrayNoMaxLengthStruct{})
+		assert.Nil(t, sch)
+		assert.NotNil(t, err)
+
+		// array of slice not supported
+		sch, err = ParseSchema(&ArrayUnsupportedStruct{})
+		assert.Nil(t, sch)
+		assert.NotNil(t, err)
+
 	})
 
 	t.Run("valid cases", func(t *testing.T) {
@@ -181,6 +211,54 @@ func TestParseSchema(t *testing.T) {
 
 		assert.True(t, i64f)
 		assert.True(t, vecf)
+
+		type ValidArrayFieldStruct struct {
+			RowBase
+			ID     int64     `milvus:"primary_key"`
+			Tags   []string  `milvus:"array;max_capacity:16;max_length:64"`
+			Scores []float32 `milvus:"array;max_capacity:8"`
+			Vector [16]float32
+		}
+		sch, err = ParseSchema(&ValidArrayFieldStruct{})
+		assert.Nil(t, err)
+		assert.Equal(t, FieldTypeArray, sch.Fields[1].DataType)
+		assert.Equal(t, FieldTypeVarChar, sch.Fields[1].ElementType)
+		assert.Equal(t, map[string]string{TypeParamMaxCapacity: "16", TypeParamMaxLength: "64"}, sch.Fields[1].TypeParams)
+		assert.Equal(t, FieldTypeArray, sch.Fields[2].DataType)
+		assert.Equal(t, FieldTypeFloat, sch.Fields[2].ElementType)
+		assert.Equal(t, map[string]string{TypeParamMaxCapacity: "8"}, sch.Fields[2].TypeParams)
+
+		type ValidHalfVectorStruct struct {
+			RowBase
+			ID     int64          `milvus:"primary_key"`
+			Fp16   Float16Vector  `milvus:"dim:8"`
+			Bf16   BFloat16Vector `milvus:"dim:8"`
+			Binary []byte         `milvus:"dim:8"`
+		}
+		sch, err = ParseSchema(&ValidHalfVectorStruct{})
+		assert.Nil(t, err)
+		assert.Equal(t, FieldTypeFloat16Vector, sch.Fields[1].DataType)
+		assert.Equal(t, FieldTypeBFloat16Vector, sch.Fields[2].DataType)
+		assert.Equal(t, FieldTypeBinaryVector, sch.Fields[3].DataType)
+		assert.Equal(t, "8", sch.Fields[1].TypeParams[TypeParamDim])
+
+		type ValidSparseVectorStruct struct {
+			RowBase
+			ID     int64 `milvus:"primary_key"`
+			Sparse SparseFloatVector
+		}
+		sch, err = ParseSchema(&ValidSparseVectorStruct{})
+		assert.Nil(t, err)
+		assert.Equal(t, FieldTypeSparseFloatVector, sch.Fields[1].DataType)
+		assert.Empty(t, sch.Fields[1].TypeParams)
+
+		type InvalidStructFieldStruct struct {
+			RowBase
+			ID     int64 `milvus:"primary_key"`
+			Nested struct{ V float32 }
+		}
+		_, err = ParseSchema(&InvalidStructFieldStruct{})
+		assert.Error(t, err)
 	})
 }
 
@@ -228,6 +306,71 @@ func (s *RowsSuite) TestRowsToColumns() {
 		s.Equal(3, len(columns))
 	})
 
+	s.Run("array_field", func() {
+		type ArrayFieldRow struct {
+			RowBase
+			ID     int64    `milvus:"primary_key"`
+			Tags   []string `milvus:"array;max_capacity:16;max_length:64"`
+			Vector [4]float32
+		}
+		columns, err := RowsToColumns([]Row{
+			&ArrayFieldRow{ID: 1, Tags: []string{"a", "b"}},
+			&ArrayFieldRow{ID: 2},
+		})
+		s.Require().NoError(err)
+		s.Require().Equal(3, len(columns))
+		for _, column := range columns {
+			if column.Name() == "Tags" {
+				s.Equal(FieldTypeArray, column.Type())
+				s.Equal([][]string{{"a", "b"}, nil}, column.(*ColumnVarCharArray).Data())
+			}
+		}
+	})
+
+	s.Run("half_vector_field", func() {
+		type HalfVectorRow struct {
+			RowBase
+			ID   int64          `milvus:"primary_key"`
+			Fp16 Float16Vector  `milvus:"dim:2"`
+			Bf16 BFloat16Vector `milvus:"dim:2"`
+		}
+		fv := FloatVector{1, 2}
+		columns, err := RowsToColumns([]Row{
+			&HalfVectorRow{ID: 1, Fp16: fv.ToFloat16Vector(), Bf16: fv.ToBFloat16Vector()},
+		})
+		s.Require().NoError(err)
+		s.Require().Equal(3, len(columns))
+		for _, column := range columns {
+			switch column.Name() {
+			case "Fp16":
+				s.Equal(FieldTypeFloat16Vector, column.Type())
+				s.Equal([][]byte{fv.ToFloat16Vector()}, column.(*ColumnFloat16Vector).Data())
+			case "Bf16":
+				s.Equal(FieldTypeBFloat16Vector, column.Type())
+				s.Equal([][]byte{fv.ToBFloat16Vector()}, column.(*ColumnBFloat16Vector).Data())
+			}
+		}
+	})
+
+	s.Run("sparse_vector_field", func() {
+		type SparseVectorRow struct {
+			RowBase
+			ID     int64 `milvus:"primary_key"`
+			Sparse SparseFloatVector
+		}
+		vector, err := NewSparseFloatVector([]uint32{3, 1}, []float32{0.3, 0.1})
+		s.Require().NoError(err)
+		columns, err := RowsToColumns([]Row{&SparseVectorRow{ID: 1, Sparse: vector}})
+		s.Require().NoError(err)
+		s.Require().Equal(2, len(columns))
+		for _, column := range columns {
+			if column.Name() == "Sparse" {
+				s.Equal(FieldTypeSparseFloatVector, column.Type())
+				s.Equal([]SparseFloatVector{vector}, column.(*ColumnSparseFloatVector).Data())
+			}
+		}
+	})
+
 	s.Run("auto_id_pk", func() {
 		type AutoPK struct {
 			RowBase
@@ -25,6 +25,9 @@ const (
 	// TypeParamMaxLength is the const for varchar type maximal length
 	TypeParamMaxLength = "max_length"
 
+	// TypeParamMaxCapacity is the const for array type maximal capacity
+	TypeParamMaxCapacity = "max_capacity"
+
 	// ClStrong strong consistency level
 	ClStrong ConsistencyLevel = ConsistencyLevel(common.ConsistencyLevel_Strong)
 	// ClBounded bounded consistency level with default tolerance of 5 seconds
@@ -137,11 +140,14 @@ type Field struct {
 	IndexParams    map[string]string
 	IsDynamic      bool
 	IsPartitionKey bool
+	ElementType    FieldType   // element type of array field
+	Nullable       bool        // whether field accepts null value
+	DefaultValue   interface{} // value filled by server when inserted value is absent
 }
 
 // ProtoMessage generates corresponding FieldSchema
 func (f *Field) ProtoMessage() *schema.FieldSchema {
-	return &schema.FieldSchema{
+	p := &schema.FieldSchema{
 		FieldID:        f.ID,
 		Name:           f.Name,
 		Description:    f.Description,
@@ -152,6 +158,77 @@ func (f *Field) ProtoMessage() *schema.FieldSchema {
 		IndexParams:    MapKvPairs(f.IndexParams),
 		IsDynamic:      f.IsDynamic,
 		IsPartitionKey: f.IsPartitionKey,
+		ElementType:    schema.DataType(f.ElementType),
+		DefaultValue:   defaultValueProto(f.DataType, f.DefaultValue),
+		Nullable:       f.Nullable,
+	}
+	return p
+}
+
+// defaultValueProto converts default value into schema.ValueField,
+// returns nil when value is absent or does not match the field type.
+func defaultValueProto(fieldType FieldType, value interface{}) *schema.ValueField {
+	switch v := value.(type) {
+	case bool:
+		if fieldType == FieldTypeBool {
+			return &schema.ValueField{Data: &schema.ValueField_BoolData{BoolData: v}}
+		}
+	case int8:
+		if fieldType == FieldTypeInt8 {
+			return &schema.ValueField{Data: &schema.ValueField_IntData{IntData: int32(v)}}
+		}
+	case int16:
+		if fieldType == FieldTypeInt16 {
+			return &schema.ValueField{Data: &schema.ValueField_IntData{IntData: int32(v)}}
+		}
+	case int32:
+		if fieldType == FieldTypeInt32 {
+			return &schema.ValueField{Data: &schema.ValueField_IntData{IntData: v}}
+		}
+	case int64:
+		if fieldType == FieldTypeInt64 {
+			return &schema.ValueField{Data: &schema.ValueField_LongData{LongData: v}}
+		}
+	case float32:
+		if fieldType == FieldTypeFloat {
+			return &schema.ValueField{Data: &schema.ValueField_FloatData{FloatData: v}}
+		}
+	case float64:
+		if fieldType == FieldTypeDouble {
+			return &schema.ValueField{Data: &schema.ValueField_DoubleData{DoubleData: v}}
+		}
+	case string:
+		if fieldType == FieldTypeVarChar || fieldType == FieldTypeString {
+			return &schema.ValueField{Data: &schema.ValueField_StringData{StringData: v}}
+		}
+	}
+	return nil
+}
+
+// defaultValueFromProto converts schema.ValueField back into the go type of field type.
+func defaultValueFromProto(fieldType FieldType, value *schema.ValueField) interface{} {
+	if value == nil {
+		return nil
+	}
+	switch fieldType {
+	case FieldTypeBool:
+		return value.GetBoolData()
+	case FieldTypeInt8:
+		return int8(value.GetIntData())
+	case FieldTypeInt16:
+		return int16(value.GetIntData())
+	case FieldTypeInt32:
+		return value.GetIntData()
+	case FieldTypeInt64:
+		return value.GetLongData()
+	case FieldTypeFloat:
+		return value.GetFloatData()
+	case FieldTypeDouble:
+		return value.GetDoubleData()
+	case FieldTypeString, FieldTypeVarChar:
+		return value.GetStringData()
+	default:
+		return nil
 	}
 }
 
@@ -222,6 +299,35 @@ func (f *Field) WithMaxLength(maxLen int64) *Field {
 	return f
 }
 
+// WithElementType sets the element type of array field.
+func (f *Field) WithElementType(eleType FieldType) *Field {
+	f.ElementType = eleType
+	return f
+}
+
+// WithNullable sets whether field accepts null value,
+// only scalar fields except JSON and Array could be nullable.
+func (f *Field) WithNullable(nullable bool) *Field {
+	f.Nullable = nullable
+	return f
+}
+
+// WithDefaultValue sets the value server fills when inserted value is absent,
+// the value go type shall match the field data type, for example int64 for Int64 field.
+func (f *Field) WithDefaultValue(value interface{}) *Field {
+	f.DefaultValue = value
+	return f
+}
+
+// WithMaxCapacity sets the maximal number of elements of array field.
+func (f *Field) WithMaxCapacity(maxCap int64) *Field {
+	if f.TypeParams == nil {
+		f.TypeParams = make(map[string]string)
+	}
+	f.TypeParams[TypeParamMaxCapacity] = strconv.FormatInt(maxCap, 10)
+	return f
+}
+
 // ReadProto parses FieldSchema
 func (f *Field) ReadProto(p *schema.FieldSchema) *Field {
 	f.ID = p.GetFieldID()
@@ -234,6 +340,9 @@ func (f *Field) ReadProto(p *schema.FieldSchema) *Field {
 	f.IndexParams = KvPairsMap(p.GetIndexParams())
 	f.IsDynamic = p.GetIsDynamic()
 	f.IsPartitionKey = p.GetIsPartitionKey()
+	f.ElementType = FieldType(p.GetElementType())
+	f.Nullable = p.GetNullable()
+	f.DefaultValue = defaultValueFromProto(f.DataType, p.GetDefaultValue())
 
 	return f
 }
@@ -284,12 +393,20 @@ func (t FieldType) Name() string {
 		return "String"
 	case FieldTypeVarChar:
 		return "VarChar"
+	case FieldTypeArray:
+		return "Array"
 	case FieldTypeJSON:
 		return "JSON"
 	case FieldTypeBinaryVector:
 		return "BinaryVector"
 	case FieldTypeFloatVector:
 		return "FloatVector"
+	case FieldTypeFloat16Vector:
+		return "Float16Vector"
+	case FieldTypeBFloat16Vector:
+		return "BFloat16Vector"
+	case FieldTypeSparseFloatVector:
+		return "SparseFloatVector"
 	default:
 		return "undefined"
 	}
@@ -316,12 +433,20 @@ func (t FieldType) String() string {
 		return "string"
 	case FieldTypeVarChar:
 		return "string"
+	case FieldTypeArray:
+		return "Array"
 	case FieldTypeJSON:
 		return "JSON"
 	case FieldTypeBinaryVector:
 		return "[]byte"
 	case FieldTypeFloatVector:
 		return "[]float32"
+	case FieldTypeFloat16Vector:
+		return "[]byte"
+	case FieldTypeBFloat16Vector:
+		return "[]byte"
+	case FieldTypeSparseFloatVector:
+		return "SparseFloatVector"
 	default:
 		return "undefined"
 	}
@@ -348,12 +473,20 @@ func (t FieldType) PbFieldType() (string, string) {
 		return "String", "string"
 	case FieldTypeVarChar:
 		return "VarChar", "string"
+	case FieldTypeArray:
+		return "Array", "Array"
 	case FieldTypeJSON:
 		return "JSON", "JSON"
 	case FieldTypeBinaryVector:
 		return "[]byte", ""
 	case FieldTypeFloatVector:
 		return "[]float32", ""
+	case FieldTypeFloat16Vector:
+		return "[]byte", ""
+	case FieldTypeBFloat16Vector:
+		return "[]byte", ""
+	case FieldTypeSparseFloatVector:
+		return "SparseFloatVector", ""
 	default:
 		return "undefined", ""
 	}
@@ -381,11 +514,18 @@ const (
 	FieldTypeString FieldType = 20
 	// FieldTypeVarChar field type varchar
 	FieldTypeVarChar FieldType = 21 // variable-length strings with a specified maximum length
-	// FieldTypeArray FieldType = 22
+	// FieldTypeArray field type Array
+	FieldTypeArray FieldType = 22
 	// FieldTypeJSON field type JSON
 	FieldTypeJSON FieldType = 23
 	// FieldTypeBinaryVector field type binary vector
 	FieldTypeBinaryVector FieldType = 100
 	// FieldTypeFloatVector field type float vector
 	FieldTypeFloatVector FieldType = 101
+	// FieldTypeFloat16Vector field type float16 vector
+	FieldTypeFloat16Vector FieldType = 102
+	// FieldTypeBFloat16Vector field type bfloat16 vector
+	FieldTypeBFloat16Vector FieldType = 103
+	// FieldTypeSparseFloatVector field type sparse float vector
+	FieldTypeSparseFloatVector FieldType = 104
 )
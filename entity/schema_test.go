@@ -3,8 +3,11 @@ package entity
 import (
 	"testing"
 
+	"github.com/golang/protobuf/proto"
 	common "github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	schema "github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -25,6 +28,9 @@ func TestFieldSchema(t *testing.T) {
 		NewField().WithName("int_field").WithDataType(FieldTypeInt64).WithIsAutoID(true).WithIsPrimaryKey(true).WithDescription("int_field desc"),
 		NewField().WithName("string_field").WithDataType(FieldTypeString).WithIsAutoID(false).WithIsPrimaryKey(true).WithIsDynamic(false).WithTypeParams("max_len", "32").WithDescription("string_field desc"),
 		NewField().WithName("partition_key").WithDataType(FieldTypeInt32).WithIsPartitionKey(true),
+		NewField().WithName("array_field").WithDataType(FieldTypeArray).WithElementType(FieldTypeVarChar).WithMaxCapacity(16).WithMaxLength(32),
+		NewField().WithName("nullable_field").WithDataType(FieldTypeInt16).WithNullable(true),
+		NewField().WithName("default_field").WithDataType(FieldTypeVarChar).WithMaxLength(32).WithDefaultValue("default"),
 	}
 
 	for _, field := range fields {
@@ -38,6 +44,8 @@ func TestFieldSchema(t *testing.T) {
 		assert.Equal(t, field.IsDynamic, fieldSchema.GetIsDynamic())
 		assert.Equal(t, field.Description, fieldSchema.GetDescription())
 		assert.Equal(t, field.TypeParams, KvPairsMap(fieldSchema.GetTypeParams()))
+		assert.EqualValues(t, field.ElementType, fieldSchema.GetElementType())
+		assert.Equal(t, field.DefaultValue != nil, fieldSchema.GetDefaultValue() != nil)
 		// marshal & unmarshal, still equals
 		nf := &Field{}
 		nf = nf.ReadProto(fieldSchema)
@@ -50,6 +58,9 @@ func TestFieldSchema(t *testing.T) {
 		assert.Equal(t, field.IsDynamic, nf.IsDynamic)
 		assert.Equal(t, field.IsPartitionKey, nf.IsPartitionKey)
 		assert.EqualValues(t, field.TypeParams, nf.TypeParams)
+		assert.EqualValues(t, field.ElementType, nf.ElementType)
+		assert.Equal(t, field.Nullable, nf.Nullable)
+		assert.Equal(t, field.DefaultValue, nf.DefaultValue)
 	}
 
 	assert.NotPanics(t, func() {
@@ -57,6 +68,48 @@ func TestFieldSchema(t *testing.T) {
 	})
 }
 
+func TestFieldSchemaDefaultValue(t *testing.T) {
+	cases := []struct {
+		dataType FieldType
+		value    interface{}
+	}{
+		{FieldTypeBool, true},
+		{FieldTypeInt8, int8(8)},
+		{FieldTypeInt16, int16(16)},
+		{FieldTypeInt32, int32(32)},
+		{FieldTypeInt64, int64(64)},
+		{FieldTypeFloat, float32(0.5)},
+		{FieldTypeDouble, 0.25},
+		{FieldTypeVarChar, "varchar"},
+	}
+	for _, c := range cases {
+		t.Run(c.dataType.Name(), func(t *testing.T) {
+			field := NewField().WithName("field").WithDataType(c.dataType).WithNullable(true).WithDefaultValue(c.value)
+
+			// nullable is carried as unknown field, shall survive wire marshal & unmarshal
+			bs, err := proto.Marshal(field.ProtoMessage())
+			require.NoError(t, err)
+			fieldSchema := &schema.FieldSchema{}
+			require.NoError(t, proto.Unmarshal(bs, fieldSchema))
+
+			nf := (&Field{}).ReadProto(fieldSchema)
+			assert.True(t, nf.Nullable)
+			assert.Equal(t, c.value, nf.DefaultValue)
+		})
+	}
+
+	t.Run("type_not_match", func(t *testing.T) {
+		field := NewField().WithName("field").WithDataType(FieldTypeInt64).WithDefaultValue(1)
+		assert.Nil(t, field.ProtoMessage().GetDefaultValue())
+	})
+
+	t.Run("not_nullable", func(t *testing.T) {
+		fieldSchema := NewField().WithName("field").WithDataType(FieldTypeInt64).WithNullable(false).ProtoMessage()
+		assert.False(t, fieldSchema.GetNullable())
+		assert.False(t, (&Field{}).ReadProto(fieldSchema).Nullable)
+	})
+}
+
 type SchemaSuite struct {
 	suite.Suite
 }
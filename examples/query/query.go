@@ -169,21 +169,12 @@ func printResult(sRet *client.SearchResult) {
 	randoms := make([]float64, 0, sRet.ResultCount)
 	scores := make([]float32, 0, sRet.ResultCount)
 
-	var randCol *entity.ColumnDouble
-	for _, field := range sRet.Fields {
-		if field.Name() == randomCol {
-			c, ok := field.(*entity.ColumnDouble)
-			if ok {
-				randCol = c
-			}
-		}
+	randCol, err := client.GetColumnOf[float64](sRet.Fields, randomCol)
+	if err != nil {
+		log.Fatal(err)
 	}
 	for i := 0; i < sRet.ResultCount; i++ {
-		val, err := randCol.ValueByIdx(i)
-		if err != nil {
-			log.Fatal(err)
-		}
-		randoms = append(randoms, val)
+		randoms = append(randoms, randCol.Value(i))
 		scores = append(scores, sRet.Scores[i])
 	}
 	fmt.Printf("\trandoms: %v, scores: %v\n", randoms, scores)
@@ -3,7 +3,6 @@ package main
 import (
 	"context"
 	"crypto/tls"
-	"crypto/x509"
 	"encoding/csv"
 	"fmt"
 	"log"
@@ -12,11 +11,8 @@ import (
 	"strings"
 	"time"
 
-	"google.golang.org/grpc"
-
 	"github.com/milvus-io/milvus-sdk-go/v2/client"
 	"github.com/milvus-io/milvus-sdk-go/v2/entity"
-	"google.golang.org/grpc/credentials"
 )
 
 func main() {
@@ -28,32 +24,16 @@ func main() {
 	ctx, cancel := context.WithTimeout(ctx, 2*time.Second)
 	defer cancel()
 
-	// setup tls
-	cert, err := tls.LoadX509KeyPair("../cert/client.pem", "../cert/client.key") // 注意这里是 client 的证书
-	if err != nil {
-		log.Fatalf("tls.LoadX509KeyPair err: %v", err)
-	}
-
-	certPool := x509.NewCertPool()
-	ca, err := os.ReadFile("../cert/ca.pem")
-	if err != nil {
-		log.Fatalf("ioutil.ReadFile err: %v", err)
-	}
-
-	if ok := certPool.AppendCertsFromPEM(ca); !ok {
-		log.Fatalf("certPool.AppendCertsFromPEM err")
-	}
-
-	creds := credentials.NewTLS(&tls.Config{
-		Certificates: []tls.Certificate{cert},
-		ServerName:   "localhost",
-		RootCAs:      certPool,
-		MinVersion:   tls.VersionTLS13,
-	})
-
+	// setup mutual tls with the certificates in examples/cert
 	c, err := client.NewClient(ctx, client.Config{
-		Address:     milvusAddr,
-		DialOptions: []grpc.DialOption{grpc.WithTransportCredentials(creds)},
+		Address: milvusAddr,
+		TLS: &client.TLSConfig{
+			CACertFile: "../cert/ca.pem",
+			CertFile:   "../cert/client.pem",
+			KeyFile:    "../cert/client.key",
+			ServerName: "localhost",
+			MinVersion: tls.VersionTLS13,
+		},
 	})
 	if err != nil {
 		// handling error and exit, to make example simple here
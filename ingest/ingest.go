@@ -0,0 +1,408 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package ingest reads CSV or JSON Lines data into batches of columns of collection schema.
+package ingest
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/milvus-io/milvus-sdk-go/v2/entity"
+	"github.com/milvus-io/milvus-sdk-go/v2/internal/utils/fieldutil"
+)
+
+const (
+	defaultBatchSize = 1024
+	// dynamicFieldName is the name of dynamic field when schema does not define it
+	dynamicFieldName = "$meta"
+)
+
+// RowError is the error of a row failed to parse, Line is 1-based line number in the input.
+type RowError struct {
+	Line int
+	Err  error
+}
+
+func (e *RowError) Error() string {
+	return fmt.Sprintf("line %d: %s", e.Line, e.Err.Error())
+}
+
+func (e *RowError) Unwrap() error {
+	return e.Err
+}
+
+type options struct {
+	batchSize       int
+	mappings        map[string]string
+	columns         []string
+	comma           rune
+	vectorDelimiter string
+	skipBadRows     bool
+}
+
+// Option is the option to setup Reader.
+type Option func(opt *options)
+
+// WithBatchSize sets the max row count of each batch, 1024 by default.
+func WithBatchSize(size int) Option {
+	return func(opt *options) {
+		opt.batchSize = size
+	}
+}
+
+// WithMapping maps the CSV header or JSON key source to field, empty field means the source is ignored.
+// Sources without mapping are mapped to the field with the same name.
+func WithMapping(source string, field string) Option {
+	return func(opt *options) {
+		opt.mappings[source] = field
+	}
+}
+
+// WithColumns sets the CSV column names in order, the first line is data instead of header then.
+func WithColumns(names ...string) Option {
+	return func(opt *options) {
+		opt.columns = names
+	}
+}
+
+// WithComma sets the CSV field delimiter, ',' by default.
+func WithComma(comma rune) Option {
+	return func(opt *options) {
+		opt.comma = comma
+	}
+}
+
+// WithVectorDelimiter sets the delimiter of vector elements in string form, such as "0.1;0.2",
+// ',' by default. Vectors in json array form are always accepted.
+func WithVectorDelimiter(delimiter string) Option {
+	return func(opt *options) {
+		opt.vectorDelimiter = delimiter
+	}
+}
+
+// WithSkipBadRows makes Reader skip rows failed to parse instead of returning error,
+// the skipped rows are reported by Reader.BadRows.
+func WithSkipBadRows(skip bool) Option {
+	return func(opt *options) {
+		opt.skipBadRows = skip
+	}
+}
+
+// Reader reads rows from CSV or JSON Lines input and converts them into columns of schema fields,
+// auto id primary key is not expected in input.
+type Reader struct {
+	sch  *entity.Schema
+	opts options
+
+	fields       []*entity.Field // fields of output columns
+	nameFields   map[string]*entity.Field
+	dynamicField *entity.Field
+
+	// next reads next row of source key to value, values are strings for CSV
+	next    func() (line int, row map[string]interface{}, err error)
+	fromCSV bool
+	badRows []*RowError
+}
+
+func newReader(sch *entity.Schema, opts []Option) (*Reader, error) {
+	if sch == nil {
+		return nil, errors.New("schema is nil")
+	}
+	r := &Reader{
+		sch: sch,
+		opts: options{
+			batchSize:       defaultBatchSize,
+			mappings:        make(map[string]string),
+			comma:           ',',
+			vectorDelimiter: ",",
+		},
+		nameFields: make(map[string]*entity.Field),
+	}
+	for _, opt := range opts {
+		opt(&r.opts)
+	}
+	if r.opts.batchSize <= 0 {
+		return nil, fmt.Errorf("invalid batch size %d", r.opts.batchSize)
+	}
+
+	for _, field := range sch.Fields {
+		r.nameFields[field.Name] = field
+		if field.IsDynamic {
+			r.dynamicField = field
+			continue
+		}
+		if field.PrimaryKey && field.AutoID {
+			continue
+		}
+		column, err := fieldutil.NewColumn(field)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := column.(entity.NullableColumn); !ok && (field.Nullable || field.DefaultValue != nil) {
+			return nil, fmt.Errorf("field %s of type %s does not support null value", field.Name, field.DataType.Name())
+		}
+		r.fields = append(r.fields, field)
+	}
+	if sch.EnableDynamicField {
+		if r.dynamicField == nil {
+			r.dynamicField = entity.NewField().WithName(dynamicFieldName).WithDataType(entity.FieldTypeJSON).WithIsDynamic(true)
+		}
+		r.fields = append(r.fields, r.dynamicField)
+	} else {
+		r.dynamicField = nil
+	}
+	return r, nil
+}
+
+// NewCSVReader creates Reader of CSV input, the first line is header unless WithColumns is used.
+// Empty values of non-string fields are null.
+func NewCSVReader(input io.Reader, sch *entity.Schema, opts ...Option) (*Reader, error) {
+	r, err := newReader(sch, opts)
+	if err != nil {
+		return nil, err
+	}
+	r.fromCSV = true
+	csvReader := csv.NewReader(input)
+	csvReader.Comma = r.opts.comma
+	csvReader.ReuseRecord = true
+	// column count is checked for each row with line number
+	csvReader.FieldsPerRecord = -1
+
+	header := r.opts.columns
+	if header == nil {
+		record, err := csvReader.Read()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read csv header")
+		}
+		header = append([]string(nil), record...)
+	}
+	if err := r.checkSources(header); err != nil {
+		return nil, err
+	}
+
+	r.next = func() (int, map[string]interface{}, error) {
+		record, err := csvReader.Read()
+		if err != nil {
+			var parseErr *csv.ParseError
+			if errors.As(err, &parseErr) {
+				return parseErr.StartLine, nil, &RowError{Line: parseErr.StartLine, Err: parseErr.Err}
+			}
+			return 0, nil, err
+		}
+		line, _ := csvReader.FieldPos(0)
+		if len(record) != len(header) {
+			return line, nil, &RowError{Line: line, Err: fmt.Errorf("%d values not match %d columns", len(record), len(header))}
+		}
+		row := make(map[string]interface{}, len(record))
+		for i, v := range record {
+			row[header[i]] = v
+		}
+		return line, row, nil
+	}
+	return r, nil
+}
+
+// NewJSONLReader creates Reader of JSON Lines input, each non-empty line is a json object.
+func NewJSONLReader(input io.Reader, sch *entity.Schema, opts ...Option) (*Reader, error) {
+	r, err := newReader(sch, opts)
+	if err != nil {
+		return nil, err
+	}
+	reader := bufio.NewReader(input)
+	lineNum := 0
+	r.next = func() (int, map[string]interface{}, error) {
+		for {
+			line, err := reader.ReadBytes('\n')
+			if len(line) == 0 && err != nil {
+				return 0, nil, err
+			}
+			if err != nil && err != io.EOF {
+				return 0, nil, err
+			}
+			lineNum++
+			line = bytes.TrimSpace(line)
+			if len(line) == 0 {
+				continue
+			}
+			decoder := json.NewDecoder(bytes.NewReader(line))
+			decoder.UseNumber()
+			var row map[string]interface{}
+			if err := decoder.Decode(&row); err != nil {
+				return lineNum, nil, &RowError{Line: lineNum, Err: err}
+			}
+			if decoder.More() {
+				return lineNum, nil, &RowError{Line: lineNum, Err: errors.New("unexpected data after json object")}
+			}
+			return lineNum, row, nil
+		}
+	}
+	return r, nil
+}
+
+// checkSources checks the CSV columns could be mapped.
+func (r *Reader) checkSources(sources []string) error {
+	for _, source := range sources {
+		if _, _, err := r.sourceField(source); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// sourceField returns the field which source is mapped to, nil for ignored source.
+// dynamic is true when source is a key of dynamic field.
+func (r *Reader) sourceField(source string) (field *entity.Field, dynamic bool, err error) {
+	name, mapped := r.opts.mappings[source]
+	if mapped && name == "" {
+		return nil, false, nil
+	}
+	if !mapped {
+		name = source
+	}
+	field, ok := r.nameFields[name]
+	if !ok || (field.IsDynamic && r.dynamicField == nil) {
+		if mapped {
+			return nil, false, fmt.Errorf("%s is mapped to field %s, which does not exist", source, name)
+		}
+		if r.dynamicField == nil {
+			return nil, false, fmt.Errorf("field %s does not exist in collection %s", source, r.sch.CollectionName)
+		}
+		return nil, true, nil
+	}
+	if field.PrimaryKey && field.AutoID {
+		return nil, false, fmt.Errorf("auto id primary key %s shall not be provided", field.Name)
+	}
+	return field, false, nil
+}
+
+// Next returns the next batch of columns, one column per field, io.EOF returned when no more rows.
+// A *RowError is returned for the first bad row unless WithSkipBadRows is used,
+// the rows before it are returned in the next call.
+func (r *Reader) Next() ([]entity.Column, error) {
+	columns := make([]entity.Column, 0, len(r.fields))
+	for _, field := range r.fields {
+		column, err := fieldutil.NewColumn(field)
+		if err != nil {
+			return nil, err
+		}
+		columns = append(columns, column)
+	}
+
+	rows := 0
+	for rows < r.opts.batchSize {
+		values, err := r.nextValues()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			var rowErr *RowError
+			if !errors.As(err, &rowErr) {
+				return nil, err
+			}
+			if r.opts.skipBadRows {
+				r.badRows = append(r.badRows, rowErr)
+				continue
+			}
+			if rows > 0 {
+				// return rows read, the bad row is reported by next call
+				r.next = r.pending(rowErr, r.next)
+				break
+			}
+			return nil, rowErr
+		}
+		for i, column := range columns {
+			if err := column.AppendValue(values[i]); err != nil {
+				return nil, errors.Wrapf(err, "field %s", column.Name())
+			}
+		}
+		rows++
+	}
+	if rows == 0 {
+		return nil, io.EOF
+	}
+	return columns, nil
+}
+
+// nextValues reads next row and converts it into values of fields.
+func (r *Reader) nextValues() ([]interface{}, error) {
+	line, row, err := r.next()
+	if err != nil {
+		return nil, err
+	}
+	values, err := r.rowValues(row)
+	if err != nil {
+		return nil, &RowError{Line: line, Err: err}
+	}
+	return values, nil
+}
+
+// rowValues converts row of source to value into values of fields,
+// sources not in schema are merged into dynamic field.
+func (r *Reader) rowValues(row map[string]interface{}) ([]interface{}, error) {
+	fieldValues := make(map[string]interface{}, len(row))
+	dynamicValues := make(map[string]interface{})
+	for source, v := range row {
+		field, dynamic, err := r.sourceField(source)
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case dynamic:
+			dynamicValues[source] = v
+		case field != nil:
+			fieldValues[field.Name] = v
+		}
+	}
+
+	values := make([]interface{}, 0, len(r.fields))
+	for _, field := range r.fields {
+		var value interface{}
+		var err error
+		switch {
+		case field == r.dynamicField:
+			value, err = fieldutil.DynamicValue(fieldValues[field.Name], dynamicValues)
+		case r.fromCSV:
+			value, err = csvValue(field, fieldValues[field.Name], r.opts.vectorDelimiter)
+		default:
+			value, err = fieldValue(field, fieldValues[field.Name], r.opts.vectorDelimiter)
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "field %s", field.Name)
+		}
+		values = append(values, value)
+	}
+	return values, nil
+}
+
+// pending returns next func which returns err first and then calls next.
+func (r *Reader) pending(err error, next func() (int, map[string]interface{}, error)) func() (int, map[string]interface{}, error) {
+	return func() (int, map[string]interface{}, error) {
+		r.next = next
+		return 0, nil, err
+	}
+}
+
+// BadRows returns the rows skipped with WithSkipBadRows.
+func (r *Reader) BadRows() []*RowError {
+	return r.badRows
+}
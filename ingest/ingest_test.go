@@ -0,0 +1,246 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ingest
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-sdk-go/v2/entity"
+)
+
+func filmSchema() *entity.Schema {
+	return entity.NewSchema().WithName("films").WithDynamicFieldEnabled(true).
+		WithField(entity.NewField().WithName("ID").WithDataType(entity.FieldTypeInt64).WithIsPrimaryKey(true)).
+		WithField(entity.NewField().WithName("Year").WithDataType(entity.FieldTypeInt32)).
+		WithField(entity.NewField().WithName("Vector").WithDataType(entity.FieldTypeFloatVector).WithDim(8))
+}
+
+// readAll reads all batches of r.
+func readAll(t *testing.T, r *Reader) [][]entity.Column {
+	var batches [][]entity.Column
+	for {
+		columns, err := r.Next()
+		if err == io.EOF {
+			return batches
+		}
+		require.NoError(t, err)
+		batches = append(batches, columns)
+	}
+}
+
+func dynamicObject(t *testing.T, column entity.Column, idx int) map[string]interface{} {
+	v, err := column.Get(idx)
+	require.NoError(t, err)
+	object := make(map[string]interface{})
+	require.NoError(t, json.Unmarshal(v.([]byte), &object))
+	return object
+}
+
+func TestCSVReaderFilms(t *testing.T) {
+	f, err := os.Open("../examples/films.csv")
+	require.NoError(t, err)
+	defer f.Close()
+
+	r, err := NewCSVReader(f, filmSchema(), WithColumns("ID", "Title", "Year", "Vector"), WithBatchSize(1000))
+	require.NoError(t, err)
+	batches := readAll(t, r)
+	require.Len(t, batches, 9)
+
+	rows := 0
+	for _, columns := range batches {
+		require.Len(t, columns, 4)
+		assert.LessOrEqual(t, columns[0].Len(), 1000)
+		rows += columns[0].Len()
+	}
+	assert.Equal(t, 8657, rows)
+	assert.Empty(t, r.BadRows())
+
+	columns := batches[0]
+	assert.Equal(t, []int64{0, 1, 2}, columns[0].(*entity.ColumnInt64).Data()[:3])
+	assert.Equal(t, int32(1995), columns[1].(*entity.ColumnInt32).Data()[0])
+	vectors := columns[2].(*entity.ColumnFloatVector)
+	assert.Equal(t, 8, vectors.Dim())
+	assert.InDelta(t, 0.1292651, vectors.Data()[0][0], 1e-6)
+	assert.Equal(t, "$meta", columns[3].Name())
+	assert.Equal(t, map[string]interface{}{"Title": "Toy Story"}, dynamicObject(t, columns[3], 0))
+}
+
+func TestCSVReaderHeader(t *testing.T) {
+	sch := entity.NewSchema().WithName("films").
+		WithField(entity.NewField().WithName("id").WithDataType(entity.FieldTypeInt64).WithIsPrimaryKey(true)).
+		WithField(entity.NewField().WithName("title").WithDataType(entity.FieldTypeVarChar).WithMaxLength(16)).
+		WithField(entity.NewField().WithName("rating").WithDataType(entity.FieldTypeFloat).WithNullable(true)).
+		WithField(entity.NewField().WithName("tags").WithDataType(entity.FieldTypeArray).WithElementType(entity.FieldTypeVarChar).WithMaxCapacity(4)).
+		WithField(entity.NewField().WithName("vector").WithDataType(entity.FieldTypeFloatVector).WithDim(3))
+	input := "film_id;name;rating;tags;vector;comment\n" +
+		"1;Heat;8.3;\"[\"\"crime\"\"]\";0.1|0.2|0.3;good\n" +
+		"2;Up;;[];\"[1, 2, 3]\";\n"
+
+	r, err := NewCSVReader(strings.NewReader(input), sch, WithComma(';'), WithVectorDelimiter("|"),
+		WithMapping("film_id", "id"), WithMapping("name", "title"), WithMapping("comment", ""))
+	require.NoError(t, err)
+	batches := readAll(t, r)
+	require.Len(t, batches, 1)
+	columns := batches[0]
+	require.Len(t, columns, 5)
+
+	assert.Equal(t, []int64{1, 2}, columns[0].(*entity.ColumnInt64).Data())
+	assert.Equal(t, []string{"Heat", "Up"}, columns[1].(*entity.ColumnVarChar).Data())
+	rating := columns[2].(*entity.ColumnFloat)
+	assert.Equal(t, []bool{true, false}, rating.ValidData())
+	assert.Equal(t, [][]string{{"crime"}, {}}, columns[3].(*entity.ColumnVarCharArray).Data())
+	assert.Equal(t, [][]float32{{0.1, 0.2, 0.3}, {1, 2, 3}}, columns[4].(*entity.ColumnFloatVector).Data())
+
+	// unknown column without dynamic field
+	_, err = NewCSVReader(strings.NewReader("id,unknown\n"), sch)
+	assert.Error(t, err)
+	// mapped to field not exists
+	_, err = NewCSVReader(strings.NewReader("id\n"), sch, WithMapping("id", "pk"))
+	assert.Error(t, err)
+	_, err = NewCSVReader(strings.NewReader("id\n"), sch, WithBatchSize(0))
+	assert.Error(t, err)
+	_, err = NewCSVReader(strings.NewReader(""), sch)
+	assert.Error(t, err)
+	_, err = NewCSVReader(strings.NewReader("id\n"), nil)
+	assert.Error(t, err)
+}
+
+func TestCSVReaderBadRows(t *testing.T) {
+	sch := entity.NewSchema().WithName("films").
+		WithField(entity.NewField().WithName("id").WithDataType(entity.FieldTypeInt64).WithIsPrimaryKey(true).WithIsAutoID(true)).
+		WithField(entity.NewField().WithName("year").WithDataType(entity.FieldTypeInt16)).
+		WithField(entity.NewField().WithName("vector").WithDataType(entity.FieldTypeFloatVector).WithDim(2))
+	input := "year,vector\n" +
+		"1995,\"[1, 2]\"\n" +
+		"1996,\"[1, 2, 3]\"\n" +
+		"99999,\"[1, 2]\"\n" +
+		"1997\n" +
+		",\"[1, 2]\"\n" +
+		"1998,\"[3, 4]\"\n"
+
+	r, err := NewCSVReader(strings.NewReader(input), sch)
+	require.NoError(t, err)
+	columns, err := r.Next()
+	require.NoError(t, err)
+	assert.Equal(t, 1, columns[0].Len())
+
+	_, err = r.Next()
+	var rowErr *RowError
+	require.True(t, errors.As(err, &rowErr))
+	assert.Equal(t, 3, rowErr.Line)
+	assert.Contains(t, err.Error(), "line 3: field vector")
+
+	r, err = NewCSVReader(strings.NewReader(input), sch, WithSkipBadRows(true))
+	require.NoError(t, err)
+	batches := readAll(t, r)
+	require.Len(t, batches, 1)
+	assert.Equal(t, []int16{1995, 1998}, batches[0][0].(*entity.ColumnInt16).Data())
+	lines := make([]int, 0)
+	for _, badRow := range r.BadRows() {
+		lines = append(lines, badRow.Line)
+	}
+	assert.Equal(t, []int{3, 4, 5, 6}, lines)
+
+	// auto id primary key shall not be provided
+	_, err = NewCSVReader(strings.NewReader("id,year,vector\n"), sch)
+	assert.Error(t, err)
+}
+
+func TestJSONLReader(t *testing.T) {
+	sch := entity.NewSchema().WithName("items").WithDynamicFieldEnabled(true).
+		WithField(entity.NewField().WithName("id").WithDataType(entity.FieldTypeVarChar).WithIsPrimaryKey(true).WithMaxLength(8)).
+		WithField(entity.NewField().WithName("flag").WithDataType(entity.FieldTypeBool)).
+		WithField(entity.NewField().WithName("score").WithDataType(entity.FieldTypeDouble).WithDefaultValue(float64(1))).
+		WithField(entity.NewField().WithName("attrs").WithDataType(entity.FieldTypeJSON)).
+		WithField(entity.NewField().WithName("codes").WithDataType(entity.FieldTypeArray).WithElementType(entity.FieldTypeInt32).WithMaxCapacity(2)).
+		WithField(entity.NewField().WithName("binary").WithDataType(entity.FieldTypeBinaryVector).WithDim(16)).
+		WithField(entity.NewField().WithName("half").WithDataType(entity.FieldTypeFloat16Vector).WithDim(2)).
+		WithField(entity.NewField().WithName("sparse").WithDataType(entity.FieldTypeSparseFloatVector))
+	input := `{"id": "a", "flag": true, "score": 0.5, "attrs": {"k": [1]}, "codes": [1, 2], "binary": [1, 255], "half": [0.5, 1], "sparse": {"indices": [3, 10], "values": [0.1, 0.2]}, "extra": 7}
+
+{"id": "b", "flag": false, "attrs": [], "codes": [], "binary": "0,1", "half": "[2, 4]", "sparse": {"5": 0.5}}
+{"id": "c", "flag": "no", "attrs": 1, "codes": [1], "binary": [0, 0], "half": [1, 1], "sparse": {}}
+{"id": "d", "flag": true, "attrs": 1, "codes": [1, 2, 3], "binary": [0, 0], "half": [1, 1], "sparse": {}}
+{"id": "e", "flag": true, "attrs": 1, "codes": [1], "binary": [256, 0], "half": [1, 1], "sparse": {}}
+{"id": "f",
+`
+
+	r, err := NewJSONLReader(strings.NewReader(input), sch, WithBatchSize(1), WithSkipBadRows(true))
+	require.NoError(t, err)
+	batches := readAll(t, r)
+	require.Len(t, batches, 2)
+
+	columns := batches[0]
+	require.Len(t, columns, 9)
+	assert.Equal(t, []string{"a"}, columns[0].(*entity.ColumnVarChar).Data())
+	assert.Equal(t, []bool{true}, columns[1].(*entity.ColumnBool).Data())
+	assert.Equal(t, []float64{0.5}, columns[2].(*entity.ColumnDouble).Data())
+	assert.Equal(t, [][]byte{[]byte(`{"k":[1]}`)}, columns[3].(*entity.ColumnJSONBytes).Data())
+	assert.Equal(t, [][]int32{{1, 2}}, columns[4].(*entity.ColumnInt32Array).Data())
+	assert.Equal(t, [][]byte{{1, 255}}, columns[5].(*entity.ColumnBinaryVector).Data())
+	assert.Equal(t, entity.FloatVector{0.5, 1}, entity.Float16Vector(columns[6].(*entity.ColumnFloat16Vector).Data()[0]).ToFloat32Vector())
+	sparse := columns[7].(*entity.ColumnSparseFloatVector).Data()[0]
+	position, value, ok := sparse.Get(1)
+	assert.True(t, ok)
+	assert.Equal(t, uint32(10), position)
+	assert.Equal(t, float32(0.2), value)
+	assert.Equal(t, map[string]interface{}{"extra": float64(7)}, dynamicObject(t, columns[8], 0))
+
+	columns = batches[1]
+	assert.Equal(t, []string{"b"}, columns[0].(*entity.ColumnVarChar).Data())
+	assert.Equal(t, []bool{false}, columns[2].(*entity.ColumnDouble).ValidData())
+	assert.Equal(t, [][]byte{[]byte("[]")}, columns[3].(*entity.ColumnJSONBytes).Data())
+	assert.Equal(t, [][]byte{{0, 1}}, columns[5].(*entity.ColumnBinaryVector).Data())
+	assert.Equal(t, entity.FloatVector{2, 4}, entity.Float16Vector(columns[6].(*entity.ColumnFloat16Vector).Data()[0]).ToFloat32Vector())
+	assert.Equal(t, map[string]interface{}{}, dynamicObject(t, columns[8], 0))
+
+	lines := make([]int, 0)
+	for _, badRow := range r.BadRows() {
+		lines = append(lines, badRow.Line)
+	}
+	assert.Equal(t, []int{4, 5, 6, 7}, lines)
+}
+
+func TestJSONLReaderDynamicField(t *testing.T) {
+	sch := entity.NewSchema().WithName("items").WithDynamicFieldEnabled(true).
+		WithField(entity.NewField().WithName("id").WithDataType(entity.FieldTypeInt64).WithIsPrimaryKey(true)).
+		WithField(entity.NewField().WithName("meta").WithDataType(entity.FieldTypeJSON).WithIsDynamic(true))
+	input := `{"id": 1, "meta": {"a": 1}, "b": "x"}` + "\n" + `{"id": 2, "meta": [1]}`
+
+	r, err := NewJSONLReader(strings.NewReader(input), sch)
+	require.NoError(t, err)
+	columns, err := r.Next()
+	require.NoError(t, err)
+	require.Len(t, columns, 2)
+	assert.Equal(t, "meta", columns[1].Name())
+	assert.Equal(t, map[string]interface{}{"a": float64(1), "b": "x"}, dynamicObject(t, columns[1], 0))
+
+	_, err = r.Next()
+	var rowErr *RowError
+	require.True(t, errors.As(err, &rowErr))
+	assert.Equal(t, 2, rowErr.Line)
+	_, err = r.Next()
+	assert.Equal(t, io.EOF, err)
+}
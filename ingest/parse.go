@@ -0,0 +1,390 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ingest
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/milvus-io/milvus-sdk-go/v2/entity"
+	"github.com/milvus-io/milvus-sdk-go/v2/internal/utils/fieldutil"
+)
+
+// csvValue converts CSV text of field into the value type of field column, nil returned for null value.
+// Empty text of non-string field is null, JSON field takes the text as json.
+func csvValue(field *entity.Field, v interface{}, delimiter string) (interface{}, error) {
+	s, ok := v.(string)
+	if !ok {
+		return fieldValue(field, nil, delimiter)
+	}
+	switch field.DataType {
+	case entity.FieldTypeString, entity.FieldTypeVarChar:
+		return fieldValue(field, s, delimiter)
+	}
+	if s == "" {
+		return fieldValue(field, nil, delimiter)
+	}
+	if field.DataType == entity.FieldTypeJSON {
+		if !json.Valid([]byte(s)) {
+			return nil, errors.New("value is not valid json")
+		}
+		return []byte(s), nil
+	}
+	return fieldValue(field, s, delimiter)
+}
+
+// fieldValue converts decoded json value, or text, of field into the value type of field column,
+// nil returned for null value.
+func fieldValue(field *entity.Field, v interface{}, delimiter string) (interface{}, error) {
+	if v == nil {
+		if field.Nullable || field.DefaultValue != nil {
+			return nil, nil
+		}
+		return nil, errors.New("value is missing")
+	}
+	switch field.DataType {
+	case entity.FieldTypeBool:
+		return parseBool(v)
+	case entity.FieldTypeInt8:
+		n, err := parseInt(v, 8)
+		return int8(n), err
+	case entity.FieldTypeInt16:
+		n, err := parseInt(v, 16)
+		return int16(n), err
+	case entity.FieldTypeInt32:
+		n, err := parseInt(v, 32)
+		return int32(n), err
+	case entity.FieldTypeInt64:
+		return parseInt(v, 64)
+	case entity.FieldTypeFloat:
+		f, err := parseFloat(v, 32)
+		return float32(f), err
+	case entity.FieldTypeDouble:
+		return parseFloat(v, 64)
+	case entity.FieldTypeString, entity.FieldTypeVarChar:
+		return stringValue(field, v)
+	case entity.FieldTypeJSON:
+		return json.Marshal(v)
+	case entity.FieldTypeFloatVector:
+		return floatVector(field, v, delimiter)
+	case entity.FieldTypeFloat16Vector:
+		vector, err := floatVector(field, v, delimiter)
+		if err != nil {
+			return nil, err
+		}
+		return []byte(entity.FloatVector(vector).ToFloat16Vector()), nil
+	case entity.FieldTypeBFloat16Vector:
+		vector, err := floatVector(field, v, delimiter)
+		if err != nil {
+			return nil, err
+		}
+		return []byte(entity.FloatVector(vector).ToBFloat16Vector()), nil
+	case entity.FieldTypeBinaryVector:
+		return binaryVector(field, v, delimiter)
+	case entity.FieldTypeSparseFloatVector:
+		return sparseVector(v)
+	case entity.FieldTypeArray:
+		return arrayValue(field, v)
+	default:
+		return nil, fmt.Errorf("type %s not supported", field.DataType.Name())
+	}
+}
+
+// numberText returns the text of json number, or number in string form.
+func numberText(v interface{}) (string, error) {
+	switch v := v.(type) {
+	case json.Number:
+		return string(v), nil
+	case string:
+		return strings.TrimSpace(v), nil
+	}
+	return "", fmt.Errorf("value type %T is not number", v)
+}
+
+// numberError returns error of bad number text s, without the verbose strconv prefix.
+func numberError(s string, err error) error {
+	var numErr *strconv.NumError
+	if errors.As(err, &numErr) {
+		err = numErr.Err
+	}
+	return fmt.Errorf("bad number %q: %w", s, err)
+}
+
+func parseBool(v interface{}) (bool, error) {
+	switch v := v.(type) {
+	case bool:
+		return v, nil
+	case string:
+		b, err := strconv.ParseBool(strings.TrimSpace(v))
+		if err != nil {
+			return false, fmt.Errorf("bad bool %q", v)
+		}
+		return b, nil
+	}
+	return false, fmt.Errorf("value type %T is not bool", v)
+}
+
+func parseInt(v interface{}, bitSize int) (int64, error) {
+	s, err := numberText(v)
+	if err != nil {
+		return 0, err
+	}
+	n, err := strconv.ParseInt(s, 10, bitSize)
+	if err != nil {
+		return 0, numberError(s, err)
+	}
+	return n, nil
+}
+
+func parseFloat(v interface{}, bitSize int) (float64, error) {
+	s, err := numberText(v)
+	if err != nil {
+		return 0, err
+	}
+	f, err := strconv.ParseFloat(s, bitSize)
+	if err != nil {
+		return 0, numberError(s, err)
+	}
+	return f, nil
+}
+
+// stringValue checks the value is string within max length of field.
+func stringValue(field *entity.Field, v interface{}) (string, error) {
+	s, ok := v.(string)
+	if !ok {
+		return "", fmt.Errorf("value type %T is not string", v)
+	}
+	if maxLength := fieldutil.TypeParamLimit(field, entity.TypeParamMaxLength); maxLength >= 0 && len(s) > maxLength {
+		return "", fmt.Errorf("value length %d exceeds max length %d", len(s), maxLength)
+	}
+	return s, nil
+}
+
+// vectorElements returns elements of vector in json array, or string of json array or delimited numbers.
+func vectorElements(v interface{}, delimiter string) ([]interface{}, error) {
+	switch v := v.(type) {
+	case []interface{}:
+		return v, nil
+	case string:
+		s := strings.TrimSpace(v)
+		if strings.HasPrefix(s, "[") {
+			var elements []interface{}
+			if err := fieldutil.DecodeJSON([]byte(s), &elements); err != nil {
+				return nil, fmt.Errorf("bad vector: %w", err)
+			}
+			return elements, nil
+		}
+		if s == "" {
+			return nil, nil
+		}
+		parts := strings.Split(s, delimiter)
+		elements := make([]interface{}, 0, len(parts))
+		for _, part := range parts {
+			elements = append(elements, part)
+		}
+		return elements, nil
+	}
+	return nil, fmt.Errorf("value type %T is not vector", v)
+}
+
+// checkDim checks the dim of vector value.
+func checkDim(field *entity.Field, n int) error {
+	dim, err := fieldutil.Dim(field)
+	if err != nil {
+		return err
+	}
+	if n != dim {
+		return fmt.Errorf("vector dim %d not match field dim %d", n, dim)
+	}
+	return nil
+}
+
+func floatVector(field *entity.Field, v interface{}, delimiter string) ([]float32, error) {
+	elements, err := vectorElements(v, delimiter)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkDim(field, len(elements)); err != nil {
+		return nil, err
+	}
+	vector := make([]float32, 0, len(elements))
+	for _, element := range elements {
+		f, err := parseFloat(element, 32)
+		if err != nil {
+			return nil, err
+		}
+		vector = append(vector, float32(f))
+	}
+	return vector, nil
+}
+
+// binaryVector converts vector of byte values, each byte holds 8 dims.
+func binaryVector(field *entity.Field, v interface{}, delimiter string) ([]byte, error) {
+	elements, err := vectorElements(v, delimiter)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkDim(field, len(elements)*8); err != nil {
+		return nil, err
+	}
+	vector := make([]byte, 0, len(elements))
+	for _, element := range elements {
+		s, err := numberText(element)
+		if err != nil {
+			return nil, err
+		}
+		b, err := strconv.ParseUint(s, 10, 8)
+		if err != nil {
+			return nil, numberError(s, err)
+		}
+		vector = append(vector, byte(b))
+	}
+	return vector, nil
+}
+
+// sparseVector converts json object of {"indices": [...], "values": [...]} or {"index": value, ...}.
+func sparseVector(v interface{}) (interface{}, error) {
+	if s, ok := v.(string); ok {
+		var object map[string]interface{}
+		if err := fieldutil.DecodeJSON([]byte(s), &object); err != nil {
+			return nil, fmt.Errorf("bad sparse vector: %w", err)
+		}
+		v = object
+	}
+	object, ok := v.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("value type %T is not sparse vector", v)
+	}
+
+	var positions []uint32
+	var values []float32
+	indices, hasIndices := object["indices"].([]interface{})
+	elements, hasValues := object["values"].([]interface{})
+	if hasIndices && hasValues && len(object) == 2 {
+		if len(indices) != len(elements) {
+			return nil, fmt.Errorf("sparse vector indices length %d not match values length %d", len(indices), len(elements))
+		}
+		for i := range indices {
+			s, err := numberText(indices[i])
+			if err != nil {
+				return nil, err
+			}
+			position, err := strconv.ParseUint(s, 10, 32)
+			if err != nil {
+				return nil, numberError(s, err)
+			}
+			f, err := parseFloat(elements[i], 32)
+			if err != nil {
+				return nil, err
+			}
+			positions = append(positions, uint32(position))
+			values = append(values, float32(f))
+		}
+	} else {
+		for key, element := range object {
+			position, err := strconv.ParseUint(key, 10, 32)
+			if err != nil {
+				return nil, fmt.Errorf("bad sparse vector index %q", key)
+			}
+			f, err := parseFloat(element, 32)
+			if err != nil {
+				return nil, err
+			}
+			positions = append(positions, uint32(position))
+			values = append(values, float32(f))
+		}
+	}
+	return entity.NewSparseFloatVector(positions, values)
+}
+
+// arrayValue converts json array, or string of json array, into slice of field element type.
+func arrayValue(field *entity.Field, v interface{}) (interface{}, error) {
+	if s, ok := v.(string); ok {
+		var elements []interface{}
+		if err := fieldutil.DecodeJSON([]byte(s), &elements); err != nil {
+			return nil, fmt.Errorf("bad array: %w", err)
+		}
+		v = elements
+	}
+	elements, ok := v.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("value type %T is not array", v)
+	}
+	if err := fieldutil.CheckCapacity(field, len(elements)); err != nil {
+		return nil, err
+	}
+
+	elementField := &entity.Field{Name: field.Name, DataType: field.ElementType, TypeParams: field.TypeParams}
+	switch field.ElementType {
+	case entity.FieldTypeBool:
+		return arrayOf(elementField, elements, parseBool)
+	case entity.FieldTypeInt8:
+		return arrayOf(elementField, elements, func(v interface{}) (int8, error) {
+			n, err := parseInt(v, 8)
+			return int8(n), err
+		})
+	case entity.FieldTypeInt16:
+		return arrayOf(elementField, elements, func(v interface{}) (int16, error) {
+			n, err := parseInt(v, 16)
+			return int16(n), err
+		})
+	case entity.FieldTypeInt32:
+		return arrayOf(elementField, elements, func(v interface{}) (int32, error) {
+			n, err := parseInt(v, 32)
+			return int32(n), err
+		})
+	case entity.FieldTypeInt64:
+		return arrayOf(elementField, elements, func(v interface{}) (int64, error) {
+			return parseInt(v, 64)
+		})
+	case entity.FieldTypeFloat:
+		return arrayOf(elementField, elements, func(v interface{}) (float32, error) {
+			f, err := parseFloat(v, 32)
+			return float32(f), err
+		})
+	case entity.FieldTypeDouble:
+		return arrayOf(elementField, elements, func(v interface{}) (float64, error) {
+			return parseFloat(v, 64)
+		})
+	case entity.FieldTypeString, entity.FieldTypeVarChar:
+		return arrayOf(elementField, elements, func(v interface{}) (string, error) {
+			return stringValue(elementField, v)
+		})
+	default:
+		return nil, fmt.Errorf("array element type %s not supported", field.ElementType.Name())
+	}
+}
+
+// arrayOf converts each element with parse, null element is not allowed.
+func arrayOf[T any](field *entity.Field, elements []interface{}, parse func(v interface{}) (T, error)) ([]T, error) {
+	values := make([]T, 0, len(elements))
+	for i, element := range elements {
+		if element == nil {
+			return nil, fmt.Errorf("array element %d is null", i)
+		}
+		value, err := parse(element)
+		if err != nil {
+			return nil, errors.Wrapf(err, "array element %d of type %s", i, field.DataType.Name())
+		}
+		values = append(values, value)
+	}
+	return values, nil
+}
@@ -0,0 +1,150 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package fieldutil provides helpers to build columns of schema fields from row values.
+package fieldutil
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/milvus-io/milvus-sdk-go/v2/entity"
+)
+
+// Dim returns the dim of vector field.
+func Dim(field *entity.Field) (int, error) {
+	dimStr, ok := field.TypeParams[entity.TypeParamDim]
+	if !ok {
+		return 0, fmt.Errorf("vector field %s with no dim", field.Name)
+	}
+	dim, err := strconv.Atoi(dimStr)
+	if err != nil || dim <= 0 {
+		return 0, fmt.Errorf("vector field %s with bad format dim: %s", field.Name, dimStr)
+	}
+	if field.DataType == entity.FieldTypeBinaryVector && dim%8 != 0 {
+		return 0, fmt.Errorf("binary vector field %s dim %d is not multiple of 8", field.Name, dim)
+	}
+	return dim, nil
+}
+
+// TypeParamLimit returns the int type param of field, or -1 if not set.
+func TypeParamLimit(field *entity.Field, key string) int {
+	v, err := strconv.Atoi(field.TypeParams[key])
+	if err != nil {
+		return -1
+	}
+	return v
+}
+
+// CheckCapacity checks the array length n against the max capacity of array field.
+func CheckCapacity(field *entity.Field, n int) error {
+	if capacity := TypeParamLimit(field, entity.TypeParamMaxCapacity); capacity >= 0 && n > capacity {
+		return fmt.Errorf("array length %d of field %s exceeds max capacity %d", n, field.Name, capacity)
+	}
+	return nil
+}
+
+// NewColumn creates empty column of field.
+func NewColumn(field *entity.Field) (entity.Column, error) {
+	switch field.DataType {
+	case entity.FieldTypeBool:
+		return entity.NewColumnBool(field.Name, nil), nil
+	case entity.FieldTypeInt8:
+		return entity.NewColumnInt8(field.Name, nil), nil
+	case entity.FieldTypeInt16:
+		return entity.NewColumnInt16(field.Name, nil), nil
+	case entity.FieldTypeInt32:
+		return entity.NewColumnInt32(field.Name, nil), nil
+	case entity.FieldTypeInt64:
+		return entity.NewColumnInt64(field.Name, nil), nil
+	case entity.FieldTypeFloat:
+		return entity.NewColumnFloat(field.Name, nil), nil
+	case entity.FieldTypeDouble:
+		return entity.NewColumnDouble(field.Name, nil), nil
+	case entity.FieldTypeString:
+		return entity.NewColumnString(field.Name, nil), nil
+	case entity.FieldTypeVarChar:
+		return entity.NewColumnVarChar(field.Name, nil), nil
+	case entity.FieldTypeJSON:
+		return entity.NewColumnJSONBytes(field.Name, nil).WithIsDynamic(field.IsDynamic), nil
+	case entity.FieldTypeArray:
+		return entity.NewArrayColumn(field.Name, field.ElementType, 0)
+	case entity.FieldTypeSparseFloatVector:
+		return entity.NewColumnSparseFloatVector(field.Name, nil), nil
+	}
+	dim, err := Dim(field)
+	if err != nil {
+		return nil, err
+	}
+	switch field.DataType {
+	case entity.FieldTypeFloatVector:
+		return entity.NewColumnFloatVector(field.Name, dim, nil), nil
+	case entity.FieldTypeBinaryVector:
+		return entity.NewColumnBinaryVector(field.Name, dim, nil), nil
+	case entity.FieldTypeFloat16Vector:
+		return entity.NewColumnFloat16Vector(field.Name, dim, nil), nil
+	case entity.FieldTypeBFloat16Vector:
+		return entity.NewColumnBFloat16Vector(field.Name, dim, nil), nil
+	default:
+		return nil, fmt.Errorf("field %s of type %s not supported", field.Name, field.DataType.Name())
+	}
+}
+
+// DecodeJSON decodes single json value in data into v, numbers are kept as json.Number.
+func DecodeJSON(data []byte, v interface{}) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(v); err != nil {
+		return err
+	}
+	if decoder.More() {
+		return errors.New("unexpected data after json value")
+	}
+	return nil
+}
+
+// DynamicValue merges the value of dynamic field, json object or its text, and the keys not in schema into json object.
+func DynamicValue(v interface{}, extra map[string]interface{}) ([]byte, error) {
+	object := make(map[string]interface{})
+	switch value := v.(type) {
+	case string:
+		v = []byte(value)
+	case json.RawMessage:
+		v = []byte(value)
+	}
+	switch v := v.(type) {
+	case nil:
+	case []byte:
+		if len(bytes.TrimSpace(v)) > 0 {
+			if err := DecodeJSON(v, &object); err != nil {
+				return nil, fmt.Errorf("dynamic field value is not json object: %w", err)
+			}
+		}
+	case map[string]interface{}:
+		for key, value := range v {
+			object[key] = value
+		}
+	default:
+		return nil, fmt.Errorf("dynamic field value of type %T is not json object", v)
+	}
+	for key, value := range extra {
+		object[key] = value
+	}
+	return json.Marshal(object)
+}
@@ -0,0 +1,80 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fieldutil
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-sdk-go/v2/entity"
+)
+
+func TestDim(t *testing.T) {
+	dim, err := Dim(entity.NewField().WithName("vector").WithDataType(entity.FieldTypeFloatVector).WithDim(4))
+	assert.NoError(t, err)
+	assert.Equal(t, 4, dim)
+
+	_, err = Dim(entity.NewField().WithName("vector").WithDataType(entity.FieldTypeFloatVector))
+	assert.Error(t, err)
+	_, err = Dim(entity.NewField().WithName("vector").WithDataType(entity.FieldTypeBinaryVector).WithDim(12))
+	assert.Error(t, err)
+}
+
+func TestNewColumn(t *testing.T) {
+	column, err := NewColumn(entity.NewField().WithName("vector").WithDataType(entity.FieldTypeBinaryVector).WithDim(16))
+	require.NoError(t, err)
+	assert.Equal(t, entity.FieldTypeBinaryVector, column.Type())
+	assert.Equal(t, 0, column.Len())
+
+	_, err = NewColumn(entity.NewField().WithName("vector").WithDataType(entity.FieldTypeFloatVector))
+	assert.Error(t, err)
+}
+
+func TestCheckCapacity(t *testing.T) {
+	field := entity.NewField().WithName("array").WithDataType(entity.FieldTypeArray).
+		WithElementType(entity.FieldTypeInt64).WithMaxCapacity(2)
+	assert.NoError(t, CheckCapacity(field, 2))
+	assert.Error(t, CheckCapacity(field, 3))
+	assert.Equal(t, -1, TypeParamLimit(field, entity.TypeParamMaxLength))
+}
+
+func TestDynamicValue(t *testing.T) {
+	extra := map[string]interface{}{"b": 2}
+	for _, v := range []interface{}{`{"a": 12345678901234567890}`, []byte(`{"a": 12345678901234567890}`),
+		json.RawMessage(`{"a": 12345678901234567890}`), map[string]interface{}{"a": json.Number("12345678901234567890")}} {
+		bs, err := DynamicValue(v, extra)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"a": 12345678901234567890, "b": 2}`, string(bs))
+	}
+
+	bs, err := DynamicValue(nil, extra)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"b": 2}`, string(bs))
+	bs, err = DynamicValue(" ", nil)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{}`, string(bs))
+
+	_, err = DynamicValue(`[1]`, nil)
+	assert.Error(t, err)
+	_, err = DynamicValue(`{} {}`, nil)
+	assert.Error(t, err)
+	_, err = DynamicValue(1, nil)
+	assert.Error(t, err)
+}
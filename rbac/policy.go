@@ -0,0 +1,176 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package rbac manages the users, roles and privileges of Milvus declaratively.
+package rbac
+
+import (
+	"fmt"
+	"os"
+
+	common "github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"gopkg.in/yaml.v3"
+
+	"github.com/milvus-io/milvus-sdk-go/v2/entity"
+)
+
+const (
+	// RoleAdmin is the built-in role with all privileges.
+	RoleAdmin = "admin"
+	// RolePublic is the built-in role every user has.
+	RolePublic = "public"
+	// UserRoot is the built-in super user.
+	UserRoot = "root"
+	// DefaultDatabase is the built-in database, where the grants not specifying database are made.
+	DefaultDatabase = "default"
+)
+
+// Policy describes the desired users, roles, memberships and privileges, e.g.
+//
+//	users:
+//	  - name: alice
+//	    password: Milvus123   # only used when the user is created
+//	    roles: [reader]
+//	roles:
+//	  - name: reader
+//	    grants:
+//	      - objectType: Collection
+//	        object: book
+//	        privileges: [Search, Query]
+//	      - objectType: Collection
+//	        object: book
+//	        dbName: archive       # "default" database if not set
+//	        privileges: [Query]
+//	prune: true
+type Policy struct {
+	Users []UserPolicy `json:"users,omitempty" yaml:"users,omitempty"`
+	Roles []RolePolicy `json:"roles,omitempty" yaml:"roles,omitempty"`
+	// Prune drops the users and roles not described by policy, built-in ones are always kept.
+	Prune bool `json:"prune,omitempty" yaml:"prune,omitempty"`
+}
+
+// UserPolicy describes a user and the roles assigned to it.
+type UserPolicy struct {
+	Name string `json:"name" yaml:"name"`
+	// Password is used to create the user if not exists, the password of existing user is left unchanged.
+	Password string   `json:"password,omitempty" yaml:"password,omitempty"`
+	Roles    []string `json:"roles,omitempty" yaml:"roles,omitempty"`
+}
+
+// RolePolicy describes a role and the privileges granted to it.
+type RolePolicy struct {
+	Name   string        `json:"name" yaml:"name"`
+	Grants []GrantPolicy `json:"grants,omitempty" yaml:"grants,omitempty"`
+}
+
+// GrantPolicy describes the privileges granted on an object.
+type GrantPolicy struct {
+	// ObjectType is one of "Collection", "Global" and "User".
+	ObjectType string `json:"objectType" yaml:"objectType"`
+	// Object is the object name, "*" for all objects of the type.
+	Object string `json:"object" yaml:"object"`
+	// DBName is the database of the object, the "default" database if empty.
+	DBName     string             `json:"dbName,omitempty" yaml:"dbName,omitempty"`
+	Privileges []entity.Privilege `json:"privileges" yaml:"privileges"`
+}
+
+// dbName returns the database of grant.
+func (g GrantPolicy) dbName() string {
+	if g.DBName == "" {
+		return DefaultDatabase
+	}
+	return g.DBName
+}
+
+// ParsePolicy parses policy from YAML or JSON document and validates it.
+func ParsePolicy(data []byte) (*Policy, error) {
+	policy := &Policy{}
+	// JSON is a subset of YAML
+	if err := yaml.Unmarshal(data, policy); err != nil {
+		return nil, fmt.Errorf("failed to parse rbac policy: %w", err)
+	}
+	if err := policy.Validate(); err != nil {
+		return nil, err
+	}
+	return policy, nil
+}
+
+// LoadPolicy reads policy from YAML or JSON file.
+func LoadPolicy(path string) (*Policy, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePolicy(data)
+}
+
+// objectType returns the entity object type of grant.
+func (g GrantPolicy) objectType() (entity.PriviledgeObjectType, error) {
+	value, ok := common.ObjectType_value[g.ObjectType]
+	if !ok {
+		return 0, fmt.Errorf("unknown object type %q", g.ObjectType)
+	}
+	return entity.PriviledgeObjectType(value), nil
+}
+
+// Validate checks the names are unique, the roles of users are defined
+// and the privileges are known.
+func (p *Policy) Validate() error {
+	roles := map[string]bool{RoleAdmin: true, RolePublic: true}
+	for _, role := range p.Roles {
+		if role.Name == "" {
+			return fmt.Errorf("role name is empty")
+		}
+		if role.Name == RoleAdmin || role.Name == RolePublic {
+			return fmt.Errorf("built-in role %s could not be declared", role.Name)
+		}
+		if roles[role.Name] {
+			return fmt.Errorf("duplicated role %s", role.Name)
+		}
+		roles[role.Name] = true
+		for _, grant := range role.Grants {
+			if _, err := grant.objectType(); err != nil {
+				return fmt.Errorf("role %s: %w", role.Name, err)
+			}
+			if grant.Object == "" {
+				return fmt.Errorf("role %s: object of %s grant is empty", role.Name, grant.ObjectType)
+			}
+			for _, privilege := range grant.Privileges {
+				// whether the privilege could be granted on the object type is checked by server
+				if _, ok := common.ObjectPrivilege_value["Privilege"+string(privilege)]; !ok {
+					return fmt.Errorf("role %s: unknown privilege %s", role.Name, privilege)
+				}
+			}
+		}
+	}
+
+	users := map[string]bool{}
+	for _, user := range p.Users {
+		if user.Name == "" {
+			return fmt.Errorf("user name is empty")
+		}
+		if users[user.Name] {
+			return fmt.Errorf("duplicated user %s", user.Name)
+		}
+		users[user.Name] = true
+		for _, role := range user.Roles {
+			if !roles[role] {
+				return fmt.Errorf("user %s: role %s is not declared", user.Name, role)
+			}
+		}
+	}
+	return nil
+}
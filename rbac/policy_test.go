@@ -0,0 +1,94 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rbac
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-sdk-go/v2/entity"
+)
+
+func TestParsePolicy(t *testing.T) {
+	yamlPolicy := `
+users:
+  - name: alice
+    password: Milvus123
+    roles: [reader]
+roles:
+  - name: reader
+    grants:
+      - objectType: Collection
+        object: book
+        privileges: [Search, Query]
+prune: true
+`
+	jsonPolicy := `{
+  "users": [{"name": "alice", "password": "Milvus123", "roles": ["reader"]}],
+  "roles": [{"name": "reader", "grants": [{"objectType": "Collection", "object": "book", "privileges": ["Search", "Query"]}]}],
+  "prune": true
+}`
+	expected := &Policy{
+		Users: []UserPolicy{{Name: "alice", Password: "Milvus123", Roles: []string{"reader"}}},
+		Roles: []RolePolicy{{Name: "reader", Grants: []GrantPolicy{{
+			ObjectType: "Collection",
+			Object:     "book",
+			Privileges: []entity.Privilege{entity.PrivilegeSearch, entity.PrivilegeQuery},
+		}}}},
+		Prune: true,
+	}
+	for _, doc := range []string{yamlPolicy, jsonPolicy} {
+		policy, err := ParsePolicy([]byte(doc))
+		require.NoError(t, err)
+		assert.Equal(t, expected, policy)
+	}
+
+	_, err := ParsePolicy([]byte("users: {"))
+	assert.Error(t, err)
+}
+
+func TestPolicyValidate(t *testing.T) {
+	invalid := map[string]*Policy{
+		"empty role":      {Roles: []RolePolicy{{}}},
+		"built-in role":   {Roles: []RolePolicy{{Name: RoleAdmin}}},
+		"duplicated role": {Roles: []RolePolicy{{Name: "r"}, {Name: "r"}}},
+		"object type":     {Roles: []RolePolicy{{Name: "r", Grants: []GrantPolicy{{ObjectType: "Table", Object: "*"}}}}},
+		"empty object":    {Roles: []RolePolicy{{Name: "r", Grants: []GrantPolicy{{ObjectType: "Global"}}}}},
+		"privilege": {Roles: []RolePolicy{{Name: "r", Grants: []GrantPolicy{{
+			ObjectType: "Collection", Object: "*", Privileges: []entity.Privilege{"Serach"},
+		}}}}},
+		"empty user":      {Users: []UserPolicy{{}}},
+		"duplicated user": {Users: []UserPolicy{{Name: "u"}, {Name: "u"}}},
+		"undeclared role": {Users: []UserPolicy{{Name: "u", Roles: []string{"r"}}}},
+	}
+	for name, policy := range invalid {
+		assert.Error(t, policy.Validate(), name)
+	}
+
+	valid := &Policy{
+		Users: []UserPolicy{{Name: "u", Roles: []string{"r", RolePublic, RoleAdmin}}},
+		Roles: []RolePolicy{{Name: "r", Grants: []GrantPolicy{{
+			ObjectType: "Global", Object: "*", Privileges: []entity.Privilege{entity.PrivilegeAll},
+		}, {
+			// not listed by entity but known by server
+			ObjectType: "Collection", Object: "*", Privileges: []entity.Privilege{"CreatePartition", "GetFlushState"},
+		}}}},
+	}
+	assert.NoError(t, valid.Validate())
+}
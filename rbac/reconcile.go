@@ -0,0 +1,335 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rbac
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/milvus-io/milvus-sdk-go/v2/client"
+	"github.com/milvus-io/milvus-sdk-go/v2/entity"
+)
+
+// ActionType is the kind of change made by Reconcile.
+type ActionType string
+
+const (
+	ActionCreateRole     ActionType = "create_role"
+	ActionCreateUser     ActionType = "create_user"
+	ActionGrant          ActionType = "grant"
+	ActionAddUserRole    ActionType = "add_user_role"
+	ActionRemoveUserRole ActionType = "remove_user_role"
+	ActionRevoke         ActionType = "revoke"
+	ActionDropUser       ActionType = "drop_user"
+	ActionDropRole       ActionType = "drop_role"
+)
+
+// Action is a single change to make the live state match the policy.
+type Action struct {
+	Type       ActionType
+	User       string
+	Role       string
+	ObjectType entity.PriviledgeObjectType
+	Object     string
+	DBName     string
+	Privilege  entity.Privilege
+
+	password string // password to create user with, never printed
+}
+
+// String returns the human readable description of action.
+func (a Action) String() string {
+	switch a.Type {
+	case ActionCreateRole:
+		return fmt.Sprintf("create role %s", a.Role)
+	case ActionCreateUser:
+		return fmt.Sprintf("create user %s", a.User)
+	case ActionGrant:
+		return fmt.Sprintf("grant %s on %s %s in database %s to role %s", a.Privilege, a.ObjectType, a.Object, a.DBName, a.Role)
+	case ActionAddUserRole:
+		return fmt.Sprintf("add role %s to user %s", a.Role, a.User)
+	case ActionRemoveUserRole:
+		return fmt.Sprintf("remove role %s from user %s", a.Role, a.User)
+	case ActionRevoke:
+		return fmt.Sprintf("revoke %s on %s %s in database %s from role %s", a.Privilege, a.ObjectType, a.Object, a.DBName, a.Role)
+	case ActionDropUser:
+		return fmt.Sprintf("drop user %s", a.User)
+	case ActionDropRole:
+		return fmt.Sprintf("drop role %s", a.Role)
+	default:
+		return string(a.Type)
+	}
+}
+
+func (a Action) apply(ctx context.Context, c client.Client) error {
+	switch a.Type {
+	case ActionCreateRole:
+		return c.CreateRole(ctx, a.Role)
+	case ActionCreateUser:
+		return c.CreateCredential(ctx, a.User, a.password)
+	case ActionGrant:
+		return c.Grant(client.ContextWithDatabase(ctx, a.DBName), a.Role, a.ObjectType, a.Object, a.Privilege)
+	case ActionAddUserRole:
+		return c.AddUserRole(ctx, a.User, a.Role)
+	case ActionRemoveUserRole:
+		return c.RemoveUserRole(ctx, a.User, a.Role)
+	case ActionRevoke:
+		return c.Revoke(client.ContextWithDatabase(ctx, a.DBName), a.Role, a.ObjectType, a.Object, a.Privilege)
+	case ActionDropUser:
+		return c.DeleteCredential(ctx, a.User)
+	case ActionDropRole:
+		return c.DropRole(ctx, a.Role)
+	default:
+		return fmt.Errorf("unknown action type %s", a.Type)
+	}
+}
+
+type grantKey struct {
+	dbName     string
+	objectType entity.PriviledgeObjectType
+	object     string
+	privilege  entity.Privilege
+}
+
+// plan collects the actions by type, so that they could be applied in dependency order.
+type plan struct {
+	createRoles, createUsers, grants, addUserRoles []Action
+	removeUserRoles, revokes, dropUsers, dropRoles []Action
+
+	databases []string // databases to read live grants from
+}
+
+func (p *plan) actions() []Action {
+	var actions []Action
+	for _, group := range [][]Action{p.createRoles, p.createUsers, p.grants, p.addUserRoles,
+		p.removeUserRoles, p.revokes, p.dropUsers, p.dropRoles} {
+		actions = append(actions, group...)
+	}
+	return actions
+}
+
+// Reconcile diffs policy against the live users, roles and grants, and returns the minimal actions
+// making the live state match the policy. The actions are applied in order unless dryRun is true,
+// on failure the error reports the failed action and the actions before it are left applied.
+//
+// Live grants are read from all the databases, and each grant is made or revoked in its own database.
+func Reconcile(ctx context.Context, c client.Client, policy *Policy, dryRun bool) ([]Action, error) {
+	if err := policy.Validate(); err != nil {
+		return nil, err
+	}
+
+	liveRoles, err := c.ListRoles(ctx)
+	if err != nil {
+		return nil, err
+	}
+	liveUsers, err := c.ListUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	roleExists := make(map[string]bool, len(liveRoles))
+	for _, role := range liveRoles {
+		roleExists[role.Name] = true
+	}
+	userExists := make(map[string]bool, len(liveUsers))
+	for _, user := range liveUsers {
+		userExists[user.Name] = true
+	}
+	databases, err := c.ListDatabases(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &plan{}
+	for _, db := range databases {
+		p.databases = append(p.databases, db.Name)
+	}
+	declaredRoles := map[string]bool{}
+	for _, role := range policy.Roles {
+		declaredRoles[role.Name] = true
+		if err := p.diffRole(ctx, c, role, roleExists[role.Name]); err != nil {
+			return nil, err
+		}
+	}
+	declaredUsers := map[string]bool{}
+	for _, user := range policy.Users {
+		declaredUsers[user.Name] = true
+		if err := p.diffUser(ctx, c, user, userExists[user.Name]); err != nil {
+			return nil, err
+		}
+	}
+
+	if policy.Prune {
+		for _, user := range liveUsers {
+			if !declaredUsers[user.Name] && user.Name != UserRoot {
+				p.dropUsers = append(p.dropUsers, Action{Type: ActionDropUser, User: user.Name})
+			}
+		}
+		for _, role := range liveRoles {
+			if !declaredRoles[role.Name] && role.Name != RoleAdmin && role.Name != RolePublic {
+				if err := p.dropRole(ctx, c, role.Name, declaredUsers); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	actions := p.actions()
+	if dryRun {
+		return actions, nil
+	}
+	for _, action := range actions {
+		if err := action.apply(ctx, c); err != nil {
+			return actions, fmt.Errorf("failed to %s: %w", action, err)
+		}
+	}
+	return actions, nil
+}
+
+// diffRole plans the creation of role and the grants and revokes of its privileges.
+func (p *plan) diffRole(ctx context.Context, c client.Client, role RolePolicy, exists bool) error {
+	live := map[grantKey]bool{}
+	var liveGrants []grantKey
+	if exists {
+		var err error
+		liveGrants, err = p.listGrants(ctx, c, role.Name)
+		if err != nil {
+			return err
+		}
+		for _, key := range liveGrants {
+			live[key] = true
+		}
+	} else {
+		p.createRoles = append(p.createRoles, Action{Type: ActionCreateRole, Role: role.Name})
+	}
+
+	desired := map[grantKey]bool{}
+	for _, grant := range role.Grants {
+		objectType, _ := grant.objectType() // validated
+		for _, privilege := range grant.Privileges {
+			key := grantKey{dbName: grant.dbName(), objectType: objectType, object: grant.Object, privilege: privilege}
+			if desired[key] {
+				continue
+			}
+			desired[key] = true
+			if !live[key] {
+				p.grants = append(p.grants, grantAction(ActionGrant, role.Name, key))
+			}
+		}
+	}
+	for _, key := range liveGrants {
+		if !desired[key] {
+			p.revokes = append(p.revokes, grantAction(ActionRevoke, role.Name, key))
+		}
+	}
+	return nil
+}
+
+// diffUser plans the creation of user and the changes of its roles.
+func (p *plan) diffUser(ctx context.Context, c client.Client, user UserPolicy, exists bool) error {
+	var liveRoles []string
+	if exists {
+		u, err := c.SelectUser(ctx, user.Name)
+		if err != nil {
+			return err
+		}
+		liveRoles = u.Roles
+	} else {
+		if user.Password == "" {
+			return fmt.Errorf("user %s does not exist and no password provided to create it", user.Name)
+		}
+		p.createUsers = append(p.createUsers, Action{Type: ActionCreateUser, User: user.Name, password: user.Password})
+	}
+
+	live := make(map[string]bool, len(liveRoles))
+	for _, role := range liveRoles {
+		live[role] = true
+	}
+	desired := make(map[string]bool, len(user.Roles))
+	for _, role := range user.Roles {
+		if desired[role] {
+			continue
+		}
+		desired[role] = true
+		if !live[role] {
+			p.addUserRoles = append(p.addUserRoles, Action{Type: ActionAddUserRole, User: user.Name, Role: role})
+		}
+	}
+	for _, role := range liveRoles {
+		// every user has public role implicitly
+		if !desired[role] && role != RolePublic {
+			p.removeUserRoles = append(p.removeUserRoles, Action{Type: ActionRemoveUserRole, User: user.Name, Role: role})
+		}
+	}
+	return nil
+}
+
+// dropRole plans the drop of role not declared, its grants and memberships are removed beforehand.
+func (p *plan) dropRole(ctx context.Context, c client.Client, role string, declaredUsers map[string]bool) error {
+	grants, err := p.listGrants(ctx, c, role)
+	if err != nil {
+		return err
+	}
+	for _, key := range grants {
+		p.revokes = append(p.revokes, grantAction(ActionRevoke, role, key))
+	}
+	r, err := c.SelectRole(ctx, role)
+	if err != nil {
+		return err
+	}
+	for _, user := range r.Users {
+		// memberships of declared users are removed by diffUser, and the others are dropped with user
+		if !declaredUsers[user] && user == UserRoot {
+			p.removeUserRoles = append(p.removeUserRoles, Action{Type: ActionRemoveUserRole, User: user, Role: role})
+		}
+	}
+	p.dropRoles = append(p.dropRoles, Action{Type: ActionDropRole, Role: role})
+	return nil
+}
+
+// listGrants lists the distinct grants of role in all the databases.
+func (p *plan) listGrants(ctx context.Context, c client.Client, role string) ([]grantKey, error) {
+	seen := map[grantKey]bool{}
+	var keys []grantKey
+	for _, dbName := range p.databases {
+		grants, err := c.ListGrants(client.ContextWithDatabase(ctx, dbName), role)
+		if err != nil {
+			return nil, err
+		}
+		for _, grant := range grants {
+			key := grantKey{dbName: grant.DBName, objectType: grant.ObjectType, object: grant.Object, privilege: grant.Privilege}
+			if key.dbName == "" {
+				key.dbName = dbName
+			}
+			if !seen[key] {
+				seen[key] = true
+				keys = append(keys, key)
+			}
+		}
+	}
+	return keys, nil
+}
+
+func grantAction(actionType ActionType, role string, key grantKey) Action {
+	return Action{
+		Type:       actionType,
+		Role:       role,
+		ObjectType: key.objectType,
+		Object:     key.object,
+		DBName:     key.dbName,
+		Privilege:  key.privilege,
+	}
+}
@@ -0,0 +1,375 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rbac
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-sdk-go/v2/client"
+	"github.com/milvus-io/milvus-sdk-go/v2/entity"
+)
+
+// fakeClient keeps rbac state in memory, calling other methods of client.Client panics.
+type fakeClient struct {
+	client.Client
+
+	users     map[string][]string // user => roles
+	passwords map[string]string
+	roles     map[string][]entity.Grant // grants without DBName are in default database
+	databases []string
+	calls     []string
+	failOn    string
+}
+
+func newFakeClient() *fakeClient {
+	return &fakeClient{
+		users:     map[string][]string{UserRoot: nil},
+		passwords: map[string]string{},
+		roles:     map[string][]entity.Grant{RoleAdmin: nil, RolePublic: nil},
+		databases: []string{DefaultDatabase},
+	}
+}
+
+// databaseOf returns the database of request made with ctx, or of grant if ctx is nil.
+func databaseOf(ctx context.Context, grant entity.Grant) string {
+	if ctx != nil {
+		if dbName, ok := client.DatabaseFromContext(ctx); ok {
+			return dbName
+		}
+		return DefaultDatabase
+	}
+	if grant.DBName == "" {
+		return DefaultDatabase
+	}
+	return grant.DBName
+}
+
+func (c *fakeClient) call(name string) error {
+	c.calls = append(c.calls, name)
+	if name == c.failOn {
+		return errors.New("mocked failure")
+	}
+	return nil
+}
+
+func (c *fakeClient) ListRoles(_ context.Context) ([]entity.Role, error) {
+	var roles []entity.Role
+	for name := range c.roles {
+		roles = append(roles, entity.Role{Name: name})
+	}
+	return roles, nil
+}
+
+func (c *fakeClient) ListUsers(_ context.Context) ([]entity.User, error) {
+	var users []entity.User
+	for name := range c.users {
+		users = append(users, entity.User{Name: name})
+	}
+	return users, nil
+}
+
+func (c *fakeClient) SelectUser(_ context.Context, name string) (entity.User, error) {
+	return entity.User{Name: name, Roles: c.users[name]}, nil
+}
+
+func (c *fakeClient) SelectRole(_ context.Context, name string) (entity.Role, error) {
+	role := entity.Role{Name: name}
+	for user, roles := range c.users {
+		for _, r := range roles {
+			if r == name {
+				role.Users = append(role.Users, user)
+			}
+		}
+	}
+	return role, nil
+}
+
+func (c *fakeClient) ListDatabases(_ context.Context) ([]entity.Database, error) {
+	var databases []entity.Database
+	for _, name := range c.databases {
+		databases = append(databases, entity.Database{Name: name})
+	}
+	return databases, nil
+}
+
+func (c *fakeClient) ListGrants(ctx context.Context, role string) ([]entity.Grant, error) {
+	var grants []entity.Grant
+	for _, g := range c.roles[role] {
+		if databaseOf(nil, g) == databaseOf(ctx, g) {
+			grants = append(grants, g)
+		}
+	}
+	return grants, nil
+}
+
+func (c *fakeClient) CreateRole(_ context.Context, name string) error {
+	if err := c.call("CreateRole"); err != nil {
+		return err
+	}
+	c.roles[name] = nil
+	return nil
+}
+
+func (c *fakeClient) DropRole(_ context.Context, name string) error {
+	if err := c.call("DropRole"); err != nil {
+		return err
+	}
+	delete(c.roles, name)
+	return nil
+}
+
+func (c *fakeClient) CreateCredential(_ context.Context, name, password string) error {
+	if err := c.call("CreateCredential"); err != nil {
+		return err
+	}
+	c.users[name] = nil
+	c.passwords[name] = password
+	return nil
+}
+
+func (c *fakeClient) DeleteCredential(_ context.Context, name string) error {
+	if err := c.call("DeleteCredential"); err != nil {
+		return err
+	}
+	delete(c.users, name)
+	return nil
+}
+
+func (c *fakeClient) AddUserRole(_ context.Context, user, role string) error {
+	if err := c.call("AddUserRole"); err != nil {
+		return err
+	}
+	c.users[user] = append(c.users[user], role)
+	return nil
+}
+
+func (c *fakeClient) RemoveUserRole(_ context.Context, user, role string) error {
+	if err := c.call("RemoveUserRole"); err != nil {
+		return err
+	}
+	var roles []string
+	for _, r := range c.users[user] {
+		if r != role {
+			roles = append(roles, r)
+		}
+	}
+	c.users[user] = roles
+	return nil
+}
+
+func (c *fakeClient) Grant(ctx context.Context, role string, objectType entity.PriviledgeObjectType, object string, privilege entity.Privilege) error {
+	if err := c.call("Grant"); err != nil {
+		return err
+	}
+	c.roles[role] = append(c.roles[role], entity.Grant{Role: role, ObjectType: objectType, Object: object, Privilege: privilege,
+		DBName: databaseOf(ctx, entity.Grant{})})
+	return nil
+}
+
+func (c *fakeClient) Revoke(ctx context.Context, role string, objectType entity.PriviledgeObjectType, object string, privilege entity.Privilege) error {
+	if err := c.call("Revoke"); err != nil {
+		return err
+	}
+	var grants []entity.Grant
+	for _, g := range c.roles[role] {
+		if g.ObjectType != objectType || g.Object != object || g.Privilege != privilege || databaseOf(nil, g) != databaseOf(ctx, g) {
+			grants = append(grants, g)
+		}
+	}
+	c.roles[role] = grants
+	return nil
+}
+
+func actionStrings(actions []Action) []string {
+	result := make([]string, 0, len(actions))
+	for _, action := range actions {
+		result = append(result, action.String())
+	}
+	return result
+}
+
+func TestReconcile(t *testing.T) {
+	ctx := context.Background()
+	policy, err := ParsePolicy([]byte(`
+users:
+  - name: alice
+    password: Milvus123
+    roles: [reader]
+  - name: bob
+    roles: [reader, writer]
+roles:
+  - name: reader
+    grants:
+      - objectType: Collection
+        object: book
+        privileges: [Search, Query]
+  - name: writer
+    grants:
+      - objectType: Collection
+        object: book
+        privileges: [Insert]
+prune: true
+`))
+	require.NoError(t, err)
+
+	c := newFakeClient()
+	c.users["bob"] = []string{"legacy", "reader"}
+	c.users["carol"] = []string{"legacy"}
+	c.roles["reader"] = []entity.Grant{
+		{ObjectType: entity.PriviledegeObjectTypeCollection, Object: "book", Privilege: entity.PrivilegeSearch},
+		{ObjectType: entity.PriviledegeObjectTypeCollection, Object: "book", Privilege: entity.PrivilegeDelete},
+	}
+	c.roles["legacy"] = []entity.Grant{
+		{ObjectType: entity.PriviledegeObjectTypeGlobal, Object: "*", Privilege: entity.PrivilegeAll},
+	}
+
+	expected := []string{
+		"create role writer",
+		"create user alice",
+		"grant Query on Collection book in database default to role reader",
+		"grant Insert on Collection book in database default to role writer",
+		"add role reader to user alice",
+		"add role writer to user bob",
+		"remove role legacy from user bob",
+		"revoke Delete on Collection book in database default from role reader",
+		"revoke All on Global * in database default from role legacy",
+		"drop user carol",
+		"drop role legacy",
+	}
+
+	t.Run("dry_run", func(t *testing.T) {
+		actions, err := Reconcile(ctx, c, policy, true)
+		require.NoError(t, err)
+		assert.Equal(t, expected, actionStrings(actions))
+		assert.Empty(t, c.calls)
+	})
+
+	t.Run("apply", func(t *testing.T) {
+		actions, err := Reconcile(ctx, c, policy, false)
+		require.NoError(t, err)
+		assert.Equal(t, expected, actionStrings(actions))
+		assert.Len(t, c.calls, len(expected))
+		assert.Equal(t, "Milvus123", c.passwords["alice"])
+
+		// nothing to do once reconciled
+		c.calls = nil
+		actions, err = Reconcile(ctx, c, policy, false)
+		require.NoError(t, err)
+		assert.Empty(t, actions)
+		assert.Empty(t, c.calls)
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		c := newFakeClient()
+		c.users["bob"] = nil
+		c.failOn = "Grant"
+		_, err := Reconcile(ctx, c, policy, false)
+		assert.ErrorContains(t, err, "failed to grant Search on Collection book in database default to role reader")
+		assert.Equal(t, []string{"CreateRole", "CreateRole", "CreateCredential", "Grant"}, c.calls)
+	})
+
+	t.Run("unlisted_privilege", func(t *testing.T) {
+		// privileges not listed by entity are granted and revoked as the others
+		policy, err := ParsePolicy([]byte(`
+roles:
+  - name: reader
+    grants:
+      - objectType: Collection
+        object: book
+        privileges: [ShowPartitions]
+`))
+		require.NoError(t, err)
+		c := newFakeClient()
+		c.roles["reader"] = []entity.Grant{
+			{ObjectType: entity.PriviledegeObjectTypeCollection, Object: "book", Privilege: "GetFlushState"},
+		}
+		actions, err := Reconcile(ctx, c, policy, false)
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			"grant ShowPartitions on Collection book in database default to role reader",
+			"revoke GetFlushState on Collection book in database default from role reader",
+		}, actionStrings(actions))
+		assert.Equal(t, []entity.Grant{
+			{Role: "reader", ObjectType: entity.PriviledegeObjectTypeCollection, Object: "book", Privilege: "ShowPartitions", DBName: DefaultDatabase},
+		}, c.roles["reader"])
+	})
+
+	t.Run("databases", func(t *testing.T) {
+		policy, err := ParsePolicy([]byte(`
+roles:
+  - name: reader
+    grants:
+      - objectType: Collection
+        object: book
+        privileges: [Search]
+      - objectType: Collection
+        object: book
+        dbName: archive
+        privileges: [Search, Query]
+prune: true
+`))
+		require.NoError(t, err)
+		c := newFakeClient()
+		c.databases = append(c.databases, "archive", "staging")
+		c.roles["reader"] = []entity.Grant{
+			{ObjectType: entity.PriviledegeObjectTypeCollection, Object: "book", Privilege: entity.PrivilegeSearch, DBName: "archive"},
+			{ObjectType: entity.PriviledegeObjectTypeCollection, Object: "book", Privilege: entity.PrivilegeQuery, DBName: DefaultDatabase},
+		}
+		c.roles["legacy"] = []entity.Grant{
+			{ObjectType: entity.PriviledegeObjectTypeGlobal, Object: "*", Privilege: entity.PrivilegeAll, DBName: "staging"},
+		}
+		actions, err := Reconcile(ctx, c, policy, false)
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			"grant Search on Collection book in database default to role reader",
+			"grant Query on Collection book in database archive to role reader",
+			"revoke Query on Collection book in database default from role reader",
+			"revoke All on Global * in database staging from role legacy",
+			"drop role legacy",
+		}, actionStrings(actions))
+		assert.ElementsMatch(t, []entity.Grant{
+			{ObjectType: entity.PriviledegeObjectTypeCollection, Object: "book", Privilege: entity.PrivilegeSearch, DBName: "archive"},
+			{Role: "reader", ObjectType: entity.PriviledegeObjectTypeCollection, Object: "book", Privilege: entity.PrivilegeSearch, DBName: DefaultDatabase},
+			{Role: "reader", ObjectType: entity.PriviledegeObjectTypeCollection, Object: "book", Privilege: entity.PrivilegeQuery, DBName: "archive"},
+		}, c.roles["reader"])
+
+		// nothing to do once reconciled
+		actions, err = Reconcile(ctx, c, policy, true)
+		require.NoError(t, err)
+		assert.Empty(t, actions)
+	})
+
+	t.Run("missing_password", func(t *testing.T) {
+		c := newFakeClient()
+		_, err := Reconcile(ctx, c, &Policy{Users: []UserPolicy{{Name: "dave"}}}, true)
+		assert.Error(t, err)
+	})
+
+	t.Run("no_prune", func(t *testing.T) {
+		c := newFakeClient()
+		c.users["carol"] = nil
+		c.roles["legacy"] = nil
+		actions, err := Reconcile(ctx, c, &Policy{}, true)
+		require.NoError(t, err)
+		assert.Empty(t, actions)
+	})
+}